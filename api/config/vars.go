@@ -61,7 +61,7 @@ func SetupVars() {
 		}
 	}
 
-	if SearchEnabled() {
+	if SearchEnabled() && SearchBackend() == SearchMeilisearch {
 		if !viper.IsSet("meili_url") {
 			log.Fatal("please provide meili_url config param")
 		}
@@ -82,6 +82,61 @@ func SearchEnabled() bool {
 	return viper.IsSet("enable_search_indexing") && viper.GetBool("enable_search_indexing")
 }
 
+// Search backends
+const (
+	SearchMeilisearch = "meilisearch"
+	SearchPostgres    = "postgres"
+)
+
+// SearchBackend returns the backend searched, meilisearch unless search_backend
+// is set to postgres
+func SearchBackend() string {
+	if viper.GetString("search_backend") == SearchPostgres {
+		return SearchPostgres
+	}
+	return SearchMeilisearch
+}
+
+// SearchLanguage returns the postgres text search configuration used for
+// queries
+func SearchLanguage() string {
+	if viper.IsSet("search_language") {
+		return viper.GetString("search_language")
+	}
+	return "english"
+}
+
+// QueryComplexityLimit returns the maximum complexity of a query
+func QueryComplexityLimit() int {
+	if viper.IsSet("query_complexity_limit") {
+		return viper.GetInt("query_complexity_limit")
+	}
+	return 5000
+}
+
+// QueryDepthLimit returns the maximum number of nested fields in a query
+func QueryDepthLimit() int {
+	if viper.IsSet("query_depth_limit") {
+		return viper.GetInt("query_depth_limit")
+	}
+	return 10
+}
+
+// PersistedQueryCacheSize returns the number of automatic persisted queries
+// kept in memory
+func PersistedQueryCacheSize() int {
+	if viper.IsSet("persisted_query_cache_size") {
+		return viper.GetInt("persisted_query_cache_size")
+	}
+	return 1000
+}
+
+// AllowListEnabled returns whether organisations can restrict the queries to
+// their persisted queries
+func AllowListEnabled() bool {
+	return viper.IsSet("enable_query_allowlist") && viper.GetBool("enable_query_allowlist")
+}
+
 func Sqlite() bool {
 	return viper.IsSet("use_sqlite") && viper.GetBool("use_sqlite")
 }
@@ -15,6 +15,7 @@ require (
 	github.com/hashicorp/golang-lru v0.5.4 // indirect
 	github.com/jinzhu/gorm v1.9.16
 	github.com/meilisearch/meilisearch-go v0.12.0
+	github.com/nats-io/nats.go v1.10.0
 	github.com/prometheus/client_golang v1.9.0
 	github.com/spf13/viper v1.8.1
 	github.com/vektah/gqlparser/v2 v2.1.0
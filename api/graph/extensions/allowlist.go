@@ -0,0 +1,71 @@
+package extensions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/models"
+	"github.com/factly/dega-api/graph/validator"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+// AllowList rejects the queries missing from the persisted queries of the
+// organisation. Organisations without persisted queries are not restricted.
+// It runs after the automatic persisted queries, so the queries sent by their
+// hash are checked too.
+type AllowList struct{}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = AllowList{}
+
+// ExtensionName returns the name of the extension
+func (a AllowList) ExtensionName() string {
+	return "AllowList"
+}
+
+// Validate checks the extension
+func (a AllowList) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters checks the hash of the query
+func (a AllowList) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	oID, err := validator.GetOrganisation(ctx)
+	if err != nil {
+		return gqlerror.Errorf(err.Error())
+	}
+
+	sum := sha256.Sum256([]byte(rawParams.Query))
+
+	var allowed int64
+	config.DB.Model(&models.PersistedQuery{}).Where(&models.PersistedQuery{
+		Hash:           hex.EncodeToString(sum[:]),
+		OrganisationID: uint(oID),
+	}).Count(&allowed)
+
+	if allowed > 0 {
+		return nil
+	}
+
+	var total int64
+	config.DB.Model(&models.PersistedQuery{}).Where(&models.PersistedQuery{
+		OrganisationID: uint(oID),
+	}).Count(&total)
+
+	if total == 0 {
+		return nil
+	}
+
+	RejectedQueries.WithLabelValues(ReasonNotAllowed).Inc()
+	gqlErr := gqlerror.Errorf("query is not in the persisted queries of the organisation")
+	errcode.Set(gqlErr, errNotAllowed)
+	return gqlErr
+}
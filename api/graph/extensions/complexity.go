@@ -0,0 +1,33 @@
+package extensions
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ComplexityLimit rejects the operations whose complexity is above the limit
+type ComplexityLimit struct {
+	*extension.ComplexityLimit
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = ComplexityLimit{}
+
+// NewComplexityLimit returns a ComplexityLimit with a fixed limit
+func NewComplexityLimit(limit int) ComplexityLimit {
+	return ComplexityLimit{extension.FixedComplexityLimit(limit)}
+}
+
+// MutateOperationContext computes the complexity of the operation
+func (c ComplexityLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	err := c.ComplexityLimit.MutateOperationContext(ctx, rc)
+	if err != nil {
+		RejectedQueries.WithLabelValues(ReasonComplexity).Inc()
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+package extensions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects the operations nesting fields deeper than the limit.
+// Introspection fields are not counted so that the playground keeps working.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = DepthLimit{}
+
+// ExtensionName returns the name of the extension
+func (d DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+// Validate checks the extension
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext computes the depth of the operation
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth := selectionDepth(op.SelectionSet)
+	if depth > d.Limit {
+		RejectedQueries.WithLabelValues(ReasonDepth).Inc()
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// selectionDepth returns the number of nested fields of a selection set,
+// fragments are already validated against cycles
+func selectionDepth(set ast.SelectionSet) int {
+	max := 0
+	for _, selection := range set {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+		if depth > max {
+			max = depth
+		}
+	}
+	return max
+}
@@ -0,0 +1,19 @@
+package extensions
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons for rejecting a query
+const (
+	ReasonComplexity = "complexity"
+	ReasonDepth      = "depth"
+	ReasonNotAllowed = "not_allowed"
+)
+
+// RejectedQueries counts the queries rejected by the limits of the server
+var RejectedQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dega_api_rejected_queries_total",
+	Help: "Number of graphql queries rejected, by reason",
+}, []string{"reason"})
@@ -0,0 +1,42 @@
+package extensions
+
+import (
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/factly/dega-api/config"
+)
+
+// NewServer returns the graphql server of the schema with automatic
+// persisted queries, the allow list and the complexity and depth limits
+func NewServer(es graphql.ExecutableSchema) *handler.Server {
+	srv := handler.New(es)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(config.PersistedQueryCacheSize()),
+	})
+
+	if config.AllowListEnabled() {
+		srv.Use(AllowList{})
+	}
+
+	srv.Use(NewComplexityLimit(config.QueryComplexityLimit()))
+	srv.Use(DepthLimit{Limit: config.QueryDepthLimit()})
+
+	return srv
+}
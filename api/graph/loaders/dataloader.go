@@ -28,6 +28,9 @@ const claimLoaderKey = "claimloader"
 const categoryLoaderKey = "categoryloader"
 const tagLoaderKey = "tagloader"
 const userLoaderKey = "userloader"
+const podcastLoaderKey = "podcastloader"
+const podcastCategoriesLoaderKey = "podcastcategoriesloader"
+const episodeAuthorsLoaderKey = "episodeauthorsloader"
 
 type values struct {
 	m map[string]interface{}
@@ -353,6 +356,156 @@ func DataloaderMiddleware(next http.Handler) http.Handler {
 			},
 		}
 
+		podcastloader := PodcastLoader{
+			maxBatch: 100,
+			wait:     1 * time.Second,
+			fetch: func(ids []string) ([]*models.Podcast, []error) {
+				keys := util.Converter(ids)
+
+				podcasts := make([]*models.Podcast, 0)
+
+				err := config.DB.Model(&models.Podcast{}).Where(keys).Find(&podcasts).Error
+
+				if err != nil {
+					logger.Error(err)
+					return nil, nil
+				}
+
+				podcastsMap := map[uint]*models.Podcast{}
+
+				for _, podcast := range podcasts {
+					podcastsMap[podcast.ID] = podcast
+				}
+
+				result := make([]*models.Podcast, 0)
+
+				for _, key := range keys {
+					m, found := podcastsMap[key]
+					if found {
+						result = append(result, m)
+					} else {
+						result = append(result, nil)
+					}
+				}
+
+				return result, nil
+			},
+		}
+
+		podcastcategoriesloader := PodcastCategoriesLoader{
+			maxBatch: 100,
+			wait:     1 * time.Second,
+			fetch: func(ids []string) ([][]*models.Category, []error) {
+				keys := util.Converter(ids)
+
+				podcastCategories := make([]models.PodcastCategory, 0)
+
+				err := config.DB.Model(&models.PodcastCategory{}).Where("podcast_id IN (?)", keys).Find(&podcastCategories).Error
+
+				if err != nil {
+					logger.Error(err)
+					return nil, nil
+				}
+
+				categoryIDs := make([]uint, 0)
+				for _, podcastCategory := range podcastCategories {
+					categoryIDs = append(categoryIDs, podcastCategory.CategoryID)
+				}
+
+				categories := make([]*models.Category, 0)
+				if len(categoryIDs) > 0 {
+					err = config.DB.Model(&models.Category{}).Where(categoryIDs).Find(&categories).Error
+
+					if err != nil {
+						logger.Error(err)
+						return nil, nil
+					}
+				}
+
+				categoriesMap := map[uint]*models.Category{}
+
+				for _, category := range categories {
+					categoriesMap[category.ID] = category
+				}
+
+				podcastsMap := map[uint][]*models.Category{}
+
+				for _, podcastCategory := range podcastCategories {
+					if category, found := categoriesMap[podcastCategory.CategoryID]; found {
+						podcastsMap[podcastCategory.PodcastID] = append(podcastsMap[podcastCategory.PodcastID], category)
+					}
+				}
+
+				result := make([][]*models.Category, 0)
+
+				for _, key := range keys {
+					m, found := podcastsMap[key]
+					if found {
+						result = append(result, m)
+					} else {
+						result = append(result, make([]*models.Category, 0))
+					}
+				}
+
+				return result, nil
+			},
+		}
+
+		episodeauthorsloader := EpisodeAuthorsLoader{
+			maxBatch: 100,
+			wait:     1 * time.Second,
+			fetch: func(ids []string) ([][]*models.User, []error) {
+				keys := util.Converter(ids)
+
+				episodeAuthors := make([]models.EpisodeAuthor, 0)
+
+				err := config.DB.Model(&models.EpisodeAuthor{}).Where("episode_id IN (?)", keys).Where("deleted_at IS NULL").Find(&episodeAuthors).Error
+
+				if err != nil {
+					logger.Error(err)
+					return nil, nil
+				}
+
+				authorIDs := make([]string, 0)
+				for _, episodeAuthor := range episodeAuthors {
+					authorIDs = append(authorIDs, fmt.Sprint(episodeAuthor.AuthorID))
+				}
+
+				usersMap := map[uint]*models.User{}
+
+				if len(authorIDs) > 0 {
+					// authors are fetched from kavach through the user loader
+					users, _ := userloader.LoadAll(authorIDs)
+					for _, user := range users {
+						if user != nil {
+							usersMap[user.ID] = user
+						}
+					}
+				}
+
+				episodesMap := map[uint][]*models.User{}
+
+				for _, episodeAuthor := range episodeAuthors {
+					if user, found := usersMap[episodeAuthor.AuthorID]; found {
+						episodesMap[episodeAuthor.EpisodeID] = append(episodesMap[episodeAuthor.EpisodeID], user)
+					}
+				}
+
+				result := make([][]*models.User, 0)
+
+				for _, key := range keys {
+					m, found := episodesMap[key]
+					if found {
+						result = append(result, m)
+					} else {
+						result = append(result, make([]*models.User, 0))
+					}
+				}
+
+				return result, nil
+			},
+		}
+
 		v := values{map[string]interface{}{
 			claimantLoaderKey: &claimantloader,
 			ratingLoaderKey:   &ratingloader,
@@ -362,6 +515,10 @@ func DataloaderMiddleware(next http.Handler) http.Handler {
 			categoryLoaderKey: &categoryloader,
 			tagLoaderKey:      &tagloader,
 			userLoaderKey:     &userloader,
+
+			podcastLoaderKey:           &podcastloader,
+			podcastCategoriesLoaderKey: &podcastcategoriesloader,
+			episodeAuthorsLoaderKey:    &episodeauthorsloader,
 		}}
 
 		ctx := context.WithValue(r.Context(), loadersKey, v)
@@ -410,3 +567,18 @@ func GetTagLoader(ctx context.Context) *TagLoader {
 func GetUserLoader(ctx context.Context) *UserLoader {
 	return ctx.Value(loadersKey).(values).Get(userLoaderKey).(*UserLoader)
 }
+
+// GetPodcastLoader batches and caches podcast
+func GetPodcastLoader(ctx context.Context) *PodcastLoader {
+	return ctx.Value(loadersKey).(values).Get(podcastLoaderKey).(*PodcastLoader)
+}
+
+// GetPodcastCategoriesLoader batches and caches the categories of podcasts
+func GetPodcastCategoriesLoader(ctx context.Context) *PodcastCategoriesLoader {
+	return ctx.Value(loadersKey).(values).Get(podcastCategoriesLoaderKey).(*PodcastCategoriesLoader)
+}
+
+// GetEpisodeAuthorsLoader batches and caches the authors of episodes
+func GetEpisodeAuthorsLoader(ctx context.Context) *EpisodeAuthorsLoader {
+	return ctx.Value(loadersKey).(values).Get(episodeAuthorsLoaderKey).(*EpisodeAuthorsLoader)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/factly/dega-api/util"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"gorm.io/gorm"
 )
@@ -26,11 +27,41 @@ type Category struct {
 	Meta             postgres.Jsonb  `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
 	HeaderCode       string          `gorm:"column:header_code" json:"header_code"`
 	FooterCode       string          `gorm:"column:footer_code" json:"footer_code"`
+	Locale           string          `gorm:"column:locale" json:"locale"`
 	IsFeatured       bool            `gorm:"column:is_featured" json:"is_featured"`
 }
 
 // CategoriesPaging model
 type CategoriesPaging struct {
-	Nodes []*Category `json:"nodes"`
-	Total int         `json:"total"`
+	Nodes      []*Category `json:"nodes"`
+	Total      int         `json:"total"`
+	DeletedIds []string    `json:"deleted_ids"`
+	Cursors
+}
+
+// CategoryEdge model
+type CategoryEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Category `json:"node"`
+}
+
+// Edges of the page
+func (p *CategoriesPaging) Edges() []*CategoryEdge {
+	edges := make([]*CategoryEdge, 0)
+	for _, node := range p.Nodes {
+		edges = append(edges, &CategoryEdge{
+			Cursor: util.EncodeCursor(node.ID),
+			Node:   node,
+		})
+	}
+	return edges
+}
+
+// PageInfo of the page
+func (p *CategoriesPaging) PageInfo() *PageInfo {
+	ids := make([]uint, 0)
+	for _, node := range p.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return p.Cursors.pageInfo(ids)
 }
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/factly/dega-api/util"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"gorm.io/gorm"
 )
@@ -32,6 +33,7 @@ type Claim struct {
 	Meta            postgres.Jsonb  `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
 	HeaderCode      string          `gorm:"column:header_code" json:"header_code"`
 	FooterCode      string          `gorm:"column:footer_code" json:"footer_code"`
+	Locale          string          `gorm:"column:locale" json:"locale"`
 	EndTime         int             `gorm:"column:end_time" json:"end_time"`
 	StartTime       int             `gorm:"column:start_time" json:"start_time"`
 	SpaceID         uint            `gorm:"column:space_id" json:"space_id"`
@@ -39,6 +41,35 @@ type Claim struct {
 
 // ClaimsPaging model
 type ClaimsPaging struct {
-	Nodes []*Claim `json:"nodes"`
-	Total int      `json:"total"`
+	Nodes      []*Claim `json:"nodes"`
+	Total      int      `json:"total"`
+	DeletedIds []string `json:"deleted_ids"`
+	Cursors
+}
+
+// ClaimEdge model
+type ClaimEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Claim `json:"node"`
+}
+
+// Edges of the page
+func (p *ClaimsPaging) Edges() []*ClaimEdge {
+	edges := make([]*ClaimEdge, 0)
+	for _, node := range p.Nodes {
+		edges = append(edges, &ClaimEdge{
+			Cursor: util.EncodeCursor(node.ID),
+			Node:   node,
+		})
+	}
+	return edges
+}
+
+// PageInfo of the page
+func (p *ClaimsPaging) PageInfo() *PageInfo {
+	ids := make([]uint, 0)
+	for _, node := range p.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return p.Cursors.pageInfo(ids)
 }
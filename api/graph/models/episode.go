@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Episode model
+type Episode struct {
+	ID              uint            `gorm:"primary_key" json:"id"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	DeletedAt       *gorm.DeletedAt `sql:"index" json:"deleted_at"`
+	Title           string          `gorm:"column:title" json:"title"`
+	Slug            string          `gorm:"column:slug" json:"slug"`
+	Season          int             `gorm:"column:season" json:"season"`
+	Episode         int             `gorm:"column:episode" json:"episode"`
+	AudioURL        string          `gorm:"column:audio_url" json:"audio_url"`
+	Duration        int             `gorm:"column:duration" json:"duration"`
+	FileSize        int64           `gorm:"column:file_size" json:"file_size"`
+	MIMEType        string          `gorm:"column:mime_type" json:"mime_type"`
+	Explicit        bool            `gorm:"column:explicit" json:"explicit"`
+	EpisodeType     string          `gorm:"column:episode_type" json:"episode_type"`
+	TranscriptURL   string          `gorm:"column:transcript_url" json:"transcript_url"`
+	ChaptersURL     string          `gorm:"column:chapters_url" json:"chapters_url"`
+	PodcastID       *uint           `gorm:"column:podcast_id" json:"podcast_id"`
+	Description     postgres.Jsonb  `gorm:"column:description" json:"description"`
+	HTMLDescription string          `gorm:"column:html_description" json:"html_description"`
+	Status          string          `gorm:"column:status" json:"status"`
+	PublishedDate   *time.Time      `gorm:"column:published_date" json:"published_date"`
+	MediumID        *uint           `gorm:"column:medium_id" json:"medium_id" sql:"DEFAULT:NULL"`
+	MetaFields      postgres.Jsonb  `gorm:"column:meta_fields" json:"meta_fields"`
+	Meta            postgres.Jsonb  `gorm:"column:meta" json:"meta"`
+	HeaderCode      string          `gorm:"column:header_code" json:"header_code"`
+	FooterCode      string          `gorm:"column:footer_code" json:"footer_code"`
+	SpaceID         uint            `gorm:"column:space_id" json:"space_id"`
+}
+
+// EpisodesPaging model
+type EpisodesPaging struct {
+	Nodes []*Episode `json:"nodes"`
+	Total int        `json:"total"`
+}
+
+// EpisodeAuthor model
+type EpisodeAuthor struct {
+	AuthorID  uint `gorm:"column:author_id" json:"author_id"`
+	EpisodeID uint `gorm:"column:episode_id" json:"episode_id"`
+}
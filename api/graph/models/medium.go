@@ -2,10 +2,12 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"time"
 
+	"github.com/factly/dega-api/util"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/spf13/viper"
 	"gorm.io/gorm"
@@ -31,6 +33,115 @@ type Medium struct {
 	SpaceID     uint            `gorm:"column:space_id" json:"space_id"`
 }
 
+// MediumRendition is a copy of an uploaded image resized to a width
+type MediumRendition struct {
+	Width  int     `json:"width"`
+	Height int     `json:"height"`
+	URL    string  `json:"url"`
+	Webp   *string `json:"webp"`
+}
+
+// mediumUpload is the data dega-server keeps in the url of an uploaded file
+type mediumUpload struct {
+	MimeType   *string            `json:"mime_type"`
+	Width      *int               `json:"width"`
+	Height     *int               `json:"height"`
+	Blurhash   *string            `json:"blurhash"`
+	Webp       *string            `json:"webp"`
+	Renditions []*MediumRendition `json:"renditions"`
+	Exif       interface{}        `json:"exif"`
+}
+
+func (media *Medium) upload() mediumUpload {
+	upload := mediumUpload{}
+	if media.URL.RawMessage != nil {
+		_ = json.Unmarshal(media.URL.RawMessage, &upload)
+	}
+
+	// media created before uploads only have the free text dimensions
+	if upload.Width == nil || upload.Height == nil {
+		var width, height int
+		if n, _ := fmt.Sscanf(media.Dimensions, "%dx%d", &width, &height); n == 2 {
+			upload.Width, upload.Height = &width, &height
+		}
+	}
+	return upload
+}
+
+// MimeType of the uploaded file
+func (media *Medium) MimeType() *string {
+	return media.upload().MimeType
+}
+
+// Width of the image
+func (media *Medium) Width() *int {
+	return media.upload().Width
+}
+
+// Height of the image
+func (media *Medium) Height() *int {
+	return media.upload().Height
+}
+
+// Blurhash placeholder of the image
+func (media *Medium) Blurhash() *string {
+	return media.upload().Blurhash
+}
+
+// Webp variant of the image
+func (media *Medium) Webp() *string {
+	return media.upload().Webp
+}
+
+// Renditions of the image, narrowest first
+func (media *Medium) Renditions() []*MediumRendition {
+	renditions := media.upload().Renditions
+	if renditions == nil {
+		return make([]*MediumRendition, 0)
+	}
+	return renditions
+}
+
+// Exif data of the image
+func (media *Medium) Exif() interface{} {
+	return media.upload().Exif
+}
+
+// MediaPaging model
+type MediaPaging struct {
+	Nodes      []*Medium `json:"nodes"`
+	Total      int       `json:"total"`
+	DeletedIds []string  `json:"deleted_ids"`
+	Cursors
+}
+
+// MediumEdge model
+type MediumEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Medium `json:"node"`
+}
+
+// Edges of the page
+func (p *MediaPaging) Edges() []*MediumEdge {
+	edges := make([]*MediumEdge, 0)
+	for _, node := range p.Nodes {
+		edges = append(edges, &MediumEdge{
+			Cursor: util.EncodeCursor(node.ID),
+			Node:   node,
+		})
+	}
+	return edges
+}
+
+// PageInfo of the page
+func (p *MediaPaging) PageInfo() *PageInfo {
+	ids := make([]uint, 0)
+	for _, node := range p.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return p.Cursors.pageInfo(ids)
+}
+
 // AfterFind hook
 func (media *Medium) AfterFind(tx *gorm.DB) (err error) {
 	resurl := map[string]interface{}{}
@@ -7,12 +7,38 @@ type PostFilter struct {
 	Ids   []int    `json:"ids"`
 }
 
+type SearchFacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type SearchFacets struct {
+	Kinds      []*SearchFacetCount `json:"kinds"`
+	Categories []*SearchFacetCount `json:"categories"`
+	Tags       []*SearchFacetCount `json:"tags"`
+	Formats    []*SearchFacetCount `json:"formats"`
+}
+
+type SearchHighlight struct {
+	Field   string `json:"field"`
+	Snippet string `json:"snippet"`
+}
+
+type SearchHit struct {
+	ID         int                `json:"id"`
+	Kind       string             `json:"kind"`
+	Highlights []*SearchHighlight `json:"highlights"`
+}
+
 type SearchResult struct {
-	Posts      []*Post     `json:"posts"`
-	Categories []*Category `json:"categories"`
-	Tags       []*Tag      `json:"tags"`
-	Claims     []*Claim    `json:"claims"`
-	Claimants  []*Claimant `json:"claimants"`
-	Ratings    []*Rating   `json:"ratings"`
-	Media      []*Medium   `json:"media"`
+	Total      int           `json:"total"`
+	Hits       []*SearchHit  `json:"hits"`
+	Facets     *SearchFacets `json:"facets"`
+	Posts      []*Post       `json:"posts"`
+	Categories []*Category   `json:"categories"`
+	Tags       []*Tag        `json:"tags"`
+	Claims     []*Claim      `json:"claims"`
+	Claimants  []*Claimant   `json:"claimants"`
+	Ratings    []*Rating     `json:"ratings"`
+	Media      []*Medium     `json:"media"`
 }
@@ -0,0 +1,33 @@
+package models
+
+import "github.com/factly/dega-api/util"
+
+// PageInfo of a list paginated with cursors
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// Cursors of a page of a list
+type Cursors struct {
+	HasNextPage     bool `json:"-"`
+	HasPreviousPage bool `json:"-"`
+}
+
+func (c Cursors) pageInfo(ids []uint) *PageInfo {
+	info := &PageInfo{
+		HasNextPage:     c.HasNextPage,
+		HasPreviousPage: c.HasPreviousPage,
+	}
+
+	if len(ids) > 0 {
+		start := util.EncodeCursor(ids[0])
+		end := util.EncodeCursor(ids[len(ids)-1])
+		info.StartCursor = &start
+		info.EndCursor = &end
+	}
+
+	return info
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PersistedQuery model, a query an organisation allows on the api
+type PersistedQuery struct {
+	ID             uint            `gorm:"primary_key" json:"id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      *gorm.DeletedAt `sql:"index" json:"deleted_at"`
+	Name           string          `gorm:"column:name" json:"name"`
+	Hash           string          `gorm:"column:hash" json:"hash"`
+	Query          string          `gorm:"column:query" json:"query"`
+	OrganisationID uint            `gorm:"column:organisation_id" json:"organisation_id"`
+}
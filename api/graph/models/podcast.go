@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Podcast model
+type Podcast struct {
+	ID                uint            `gorm:"primary_key" json:"id"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	DeletedAt         *gorm.DeletedAt `sql:"index" json:"deleted_at"`
+	Title             string          `gorm:"column:title" json:"title"`
+	Slug              string          `gorm:"column:slug" json:"slug"`
+	Description       postgres.Jsonb  `gorm:"column:description" json:"description"`
+	HTMLDescription   string          `gorm:"column:html_description" json:"html_description"`
+	Language          string          `gorm:"column:language" json:"language"`
+	PrimaryCategoryID *uint           `gorm:"column:primary_category_id" json:"primary_category_id" sql:"DEFAULT:NULL"`
+	MediumID          *uint           `gorm:"column:medium_id" json:"medium_id" sql:"DEFAULT:NULL"`
+	HeaderCode        string          `gorm:"column:header_code" json:"header_code"`
+	FooterCode        string          `gorm:"column:footer_code" json:"footer_code"`
+	MetaFields        postgres.Jsonb  `gorm:"column:meta_fields" json:"meta_fields"`
+	Meta              postgres.Jsonb  `gorm:"column:meta" json:"meta"`
+	SpaceID           uint            `gorm:"column:space_id" json:"space_id"`
+}
+
+// PodcastsPaging model
+type PodcastsPaging struct {
+	Nodes []*Podcast `json:"nodes"`
+	Total int        `json:"total"`
+}
+
+// PodcastCategory model
+type PodcastCategory struct {
+	CategoryID uint `gorm:"column:category_id" json:"category_id"`
+	PodcastID  uint `gorm:"column:podcast_id" json:"podcast_id"`
+}
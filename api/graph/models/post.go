@@ -3,46 +3,78 @@ package models
 import (
 	"time"
 
+	"github.com/factly/dega-api/util"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"gorm.io/gorm"
 )
 
 // Post model
 type Post struct {
-	ID               uint            `gorm:"primary_key" json:"id"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
-	DeletedAt        *gorm.DeletedAt `sql:"index" json:"deleted_at"`
-	Title            string          `gorm:"column:title" json:"title"`
-	Subtitle         string          `gorm:"column:subtitle" json:"subtitle"`
-	Slug             string          `gorm:"column:slug" json:"slug"`
-	Status           string          `gorm:"column:status" json:"status"`
-	Excerpt          string          `gorm:"column:excerpt" json:"excerpt"`
-	Description      postgres.Jsonb  `gorm:"column:description" json:"description" sql:"jsonb"`
-	HTMLDescription  string          `gorm:"column:html_description" json:"html_description"`
-	IsPage           bool            `gorm:"column:is_page" json:"is_page"`
-	IsFeatured       bool            `gorm:"column:is_featured" json:"is_featured"`
-	IsSticky         bool            `gorm:"column:is_sticky" json:"is_sticky"`
-	IsHighlighted    bool            `gorm:"column:is_highlighted" json:"is_highlighted"`
-	FeaturedMediumID uint            `gorm:"column:featured_medium_id" json:"featured_medium_id" sql:"DEFAULT:NULL"`
-	FormatID         uint            `gorm:"column:format_id" json:"format_id" sql:"DEFAULT:NULL"`
-	PublishedDate    *time.Time      `gorm:"column:published_date" json:"published_date"`
-	Schemas          postgres.Jsonb  `gorm:"column:schemas" json:"schemas"`
-	Meta             postgres.Jsonb  `gorm:"column:meta" json:"meta"`
-	HeaderCode       string          `gorm:"column:header_code" json:"header_code"`
-	FooterCode       string          `gorm:"column:footer_code" json:"footer_code"`
-	MetaFields       postgres.Jsonb  `gorm:"column:meta_fields" json:"meta_fields"`
-	Tags             []Tag           `gorm:"many2many:post_tags;" json:"tags,omitempty"`
-	Categories       []Category      `gorm:"many2many:post_categories;" json:"categories,omitempty"`
-	Format           *Format         `gorm:"foreignKey:format_id" json:"format,omitempty"`
-	Medium           *Medium         `gorm:"foreignKey:featured_medium_id" json:"medium,omitempty"`
-	SpaceID          uint            `gorm:"column:space_id" json:"space_id"`
+	ID                 uint            `gorm:"primary_key" json:"id"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+	DeletedAt          *gorm.DeletedAt `sql:"index" json:"deleted_at"`
+	Title              string          `gorm:"column:title" json:"title"`
+	Subtitle           string          `gorm:"column:subtitle" json:"subtitle"`
+	Slug               string          `gorm:"column:slug" json:"slug"`
+	Status             string          `gorm:"column:status" json:"status"`
+	Excerpt            string          `gorm:"column:excerpt" json:"excerpt"`
+	Description        postgres.Jsonb  `gorm:"column:description" json:"description" sql:"jsonb"`
+	HTMLDescription    string          `gorm:"column:html_description" json:"html_description"`
+	IsPage             bool            `gorm:"column:is_page" json:"is_page"`
+	IsFeatured         bool            `gorm:"column:is_featured" json:"is_featured"`
+	IsSticky           bool            `gorm:"column:is_sticky" json:"is_sticky"`
+	IsHighlighted      bool            `gorm:"column:is_highlighted" json:"is_highlighted"`
+	FeaturedMediumID   uint            `gorm:"column:featured_medium_id" json:"featured_medium_id" sql:"DEFAULT:NULL"`
+	FormatID           uint            `gorm:"column:format_id" json:"format_id" sql:"DEFAULT:NULL"`
+	PublishedDate      *time.Time      `gorm:"column:published_date" json:"published_date"`
+	Schemas            postgres.Jsonb  `gorm:"column:schemas" json:"schemas"`
+	Meta               postgres.Jsonb  `gorm:"column:meta" json:"meta"`
+	HeaderCode         string          `gorm:"column:header_code" json:"header_code"`
+	FooterCode         string          `gorm:"column:footer_code" json:"footer_code"`
+	MetaFields         postgres.Jsonb  `gorm:"column:meta_fields" json:"meta_fields"`
+	Locale             string          `gorm:"column:locale" json:"locale"`
+	TranslationGroupID *uint           `gorm:"column:translation_group_id" json:"translation_group_id"`
+	Tags               []Tag           `gorm:"many2many:post_tags;" json:"tags,omitempty"`
+	Categories         []Category      `gorm:"many2many:post_categories;" json:"categories,omitempty"`
+	Format             *Format         `gorm:"foreignKey:format_id" json:"format,omitempty"`
+	Medium             *Medium         `gorm:"foreignKey:featured_medium_id" json:"medium,omitempty"`
+	SpaceID            uint            `gorm:"column:space_id" json:"space_id"`
 }
 
 // PostsPaging model
 type PostsPaging struct {
-	Nodes []*Post `json:"nodes"`
-	Total int     `json:"total"`
+	Nodes      []*Post  `json:"nodes"`
+	Total      int      `json:"total"`
+	DeletedIds []string `json:"deleted_ids"`
+	Cursors
+}
+
+// PostEdge model
+type PostEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Post  `json:"node"`
+}
+
+// Edges of the page
+func (p *PostsPaging) Edges() []*PostEdge {
+	edges := make([]*PostEdge, 0)
+	for _, node := range p.Nodes {
+		edges = append(edges, &PostEdge{
+			Cursor: util.EncodeCursor(node.ID),
+			Node:   node,
+		})
+	}
+	return edges
+}
+
+// PageInfo of the page
+func (p *PostsPaging) PageInfo() *PageInfo {
+	ids := make([]uint, 0)
+	for _, node := range p.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return p.Cursors.pageInfo(ids)
 }
 
 // PostTag model
@@ -7,6 +7,7 @@ type Sitemap struct {
 	Slug      string    `json:"slug"`
 	ID        string    `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
+	URL       *string   `json:"url"`
 }
 
 // Sitemaps model
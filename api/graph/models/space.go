@@ -33,5 +33,8 @@ type Space struct {
 	HeaderCode        string          `gorm:"column:header_code" json:"header_code"`
 	FooterCode        string          `gorm:"column:footer_code" json:"footer_code"`
 	MetaFields        postgres.Jsonb  `gorm:"column:meta_fields" json:"meta_fields"`
+	DefaultLocale     string          `gorm:"column:default_locale" json:"default_locale"`
+	SupportedLocales  postgres.Jsonb  `gorm:"column:supported_locales" json:"supported_locales"`
+	Permalinks        postgres.Jsonb  `gorm:"column:permalinks" json:"permalinks"`
 	OrganisationID    int             `gorm:"column:organisation_id" json:"organisation_id"`
 }
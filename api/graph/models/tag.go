@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/factly/dega-api/util"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"gorm.io/gorm"
 )
@@ -22,6 +23,7 @@ type Tag struct {
 	Meta             postgres.Jsonb  `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
 	HeaderCode       string          `gorm:"column:header_code" json:"header_code"`
 	FooterCode       string          `gorm:"column:footer_code" json:"footer_code"`
+	Locale           string          `gorm:"column:locale" json:"locale"`
 	SpaceID          uint            `gorm:"column:space_id" json:"space_id"`
 	MediumID         uint            `gorm:"column:medium_id" json:"medium_id" sql:"DEFAULT:NULL"`
 	Medium           *Medium         `json:"medium"`
@@ -30,6 +32,35 @@ type Tag struct {
 
 // TagsPaging model
 type TagsPaging struct {
-	Nodes []*Tag `json:"nodes"`
-	Total int    `json:"total"`
+	Nodes      []*Tag   `json:"nodes"`
+	Total      int      `json:"total"`
+	DeletedIds []string `json:"deleted_ids"`
+	Cursors
+}
+
+// TagEdge model
+type TagEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Tag   `json:"node"`
+}
+
+// Edges of the page
+func (p *TagsPaging) Edges() []*TagEdge {
+	edges := make([]*TagEdge, 0)
+	for _, node := range p.Nodes {
+		edges = append(edges, &TagEdge{
+			Cursor: util.EncodeCursor(node.ID),
+			Node:   node,
+		})
+	}
+	return edges
+}
+
+// PageInfo of the page
+func (p *TagsPaging) PageInfo() *PageInfo {
+	ids := make([]uint, 0)
+	for _, node := range p.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return p.Cursors.pageInfo(ids)
 }
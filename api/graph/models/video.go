@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Video model
+type Video struct {
+	ID            uint            `gorm:"primary_key" json:"id"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	DeletedAt     *gorm.DeletedAt `sql:"index" json:"deleted_at"`
+	URL           string          `gorm:"column:url" json:"url"`
+	Title         string          `gorm:"column:title" json:"title"`
+	Slug          string          `gorm:"column:slug" json:"slug"`
+	Summary       string          `gorm:"column:summary" json:"summary"`
+	VideoType     string          `gorm:"column:video_type" json:"video_type"`
+	Status        string          `gorm:"column:status" json:"status"`
+	TotalDuration int             `gorm:"column:total_duration" json:"total_duration"`
+	ThumbnailURL  string          `gorm:"column:thumbnail_url" json:"thumbnail_url"`
+	PublishedDate *time.Time      `gorm:"column:published_date" json:"published_date"`
+	Schemas       postgres.Jsonb  `gorm:"column:schemas" json:"schemas"`
+	SpaceID       uint            `gorm:"column:space_id" json:"space_id"`
+}
+
+// VideosPaging model
+type VideosPaging struct {
+	Nodes []*Video `json:"nodes"`
+	Total int      `json:"total"`
+}
+
+// VideoTag model
+type VideoTag struct {
+	TagID   uint `gorm:"column:tag_id" json:"tag_id"`
+	VideoID uint `gorm:"column:video_id" json:"video_id"`
+}
+
+// VideoCategory model
+type VideoCategory struct {
+	CategoryID uint `gorm:"column:category_id" json:"category_id"`
+	VideoID    uint `gorm:"column:video_id" json:"video_id"`
+}
+
+// VideoAuthor model
+type VideoAuthor struct {
+	AuthorID uint `gorm:"column:author_id" json:"author_id"`
+	VideoID  uint `gorm:"column:video_id" json:"video_id"`
+}
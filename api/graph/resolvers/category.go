@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -145,7 +146,7 @@ func (r *queryResolver) FeaturedCategories(ctx context.Context, featuredCount in
 	return result, nil
 }
 
-func (r *queryResolver) Categories(ctx context.Context, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string) (*models.CategoriesPaging, error) {
+func (r *queryResolver) Categories(ctx context.Context, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) (*models.CategoriesPaging, error) {
 
 	log.Println(" categories resolver entry")
 	sID, err := validator.GetSpace(ctx)
@@ -153,6 +154,11 @@ func (r *queryResolver) Categories(ctx context.Context, ids []int, spaces []int,
 		return nil, err
 	}
 
+	conn, err := newConnection(first, after, updatedSince)
+	if err != nil {
+		return nil, err
+	}
+
 	columns := []string{"created_at", "updated_at", "name", "slug"}
 	pageSortBy := "created_at"
 	pageSortOrder := "desc"
@@ -180,12 +186,28 @@ func (r *queryResolver) Categories(ctx context.Context, ids []int, spaces []int,
 		tx = config.DB.Model(&models.Category{})
 	}
 
-	var total int64
 	tx.Where(&models.Category{
 		SpaceID: uint(sID),
-	}).Preload("Medium").Count(&total).Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}).Preload("Medium")
+	conn.filter(tx, "categories")
+
+	var total int64
+	tx.Count(&total)
+
+	if conn.cursor {
+		conn.find(tx, "categories", &result.Nodes)
+
+		if len(result.Nodes) > conn.first {
+			result.Nodes = result.Nodes[:conn.first]
+			result.HasNextPage = true
+		}
+		result.HasPreviousPage = conn.after > 0
+	} else {
+		tx.Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}
 
 	result.Total = int(total)
+	result.DeletedIds = conn.deletedIDs(&models.Category{}, sID)
 
 	return result, nil
 }
@@ -82,11 +82,17 @@ func (r *claimResolver) Medium(ctx context.Context, obj *models.Claim) (*models.
 	return loaders.GetMediumLoader(ctx).Load(fmt.Sprint(obj.MediumID))
 }
 
-func (r *queryResolver) Claims(ctx context.Context, spaces []int, ratings []int, claimants []int, page *int, limit *int, sortBy *string, sortOrder *string) (*models.ClaimsPaging, error) {
+func (r *queryResolver) Claims(ctx context.Context, spaces []int, ratings []int, claimants []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) (*models.ClaimsPaging, error) {
 	sID, err := validator.GetSpace(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	conn, err := newConnection(first, after, updatedSince)
+	if err != nil {
+		return nil, err
+	}
+
 	columns := []string{"created_at", "updated_at", "name", "slug"}
 	pageSortBy := "created_at"
 	pageSortOrder := "desc"
@@ -120,12 +126,28 @@ func (r *queryResolver) Claims(ctx context.Context, spaces []int, ratings []int,
 
 	filterStr = strings.Trim(filterStr, " AND")
 
-	var total int64
 	tx.Where(&models.Claim{
 		SpaceID: uint(sID),
-	}).Where(filterStr).Preload("Claimant").Preload("Rating").Count(&total).Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}).Where(filterStr).Preload("Claimant").Preload("Rating")
+	conn.filter(tx, "claims")
+
+	var total int64
+	tx.Count(&total)
+
+	if conn.cursor {
+		conn.find(tx, "claims", &result.Nodes)
+
+		if len(result.Nodes) > conn.first {
+			result.Nodes = result.Nodes[:conn.first]
+			result.HasNextPage = true
+		}
+		result.HasPreviousPage = conn.after > 0
+	} else {
+		tx.Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}
 
 	result.Total = int(total)
+	result.DeletedIds = conn.deletedIDs(&models.Claim{}, sID)
 
 	return result, nil
 }
@@ -0,0 +1,95 @@
+package resolvers
+
+import (
+	"time"
+
+	"github.com/factly/dega-api/graph/generated"
+	"github.com/factly/dega-api/graph/models"
+	"github.com/factly/dega-api/util"
+)
+
+// Complexity returns the cost of the list fields of the schema, a list costs
+// its child fields for every entity it can return
+func Complexity() generated.ComplexityRoot {
+	c := generated.ComplexityRoot{}
+
+	c.Query.Categories = func(childComplexity int, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) int {
+		return connectionCost(childComplexity, page, limit, first, after)
+	}
+	c.Query.Claimants = func(childComplexity int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Claims = func(childComplexity int, spaces []int, ratings []int, claimants []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) int {
+		return connectionCost(childComplexity, page, limit, first, after)
+	}
+	c.Query.Episodes = func(childComplexity int, podcast *int, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.FeaturedCategories = func(childComplexity int, featuredCount int, postLimit int) int {
+		return featuredCost(childComplexity, featuredCount, postLimit)
+	}
+	c.Query.FeaturedTags = func(childComplexity int, featuredCount int, tagLimit int) int {
+		return featuredCost(childComplexity, featuredCount, tagLimit)
+	}
+	c.Query.Pages = func(childComplexity int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Podcasts = func(childComplexity int, categories []int, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Posts = func(childComplexity int, spaces []int, formats *models.PostFilter, categories *models.PostFilter, tags *models.PostFilter, users *models.PostFilter, status *string, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time, locale *string) int {
+		if first != nil || after != nil {
+			return connectionCost(childComplexity, page, limit, first, after)
+		}
+		// posts are listed a hundred at a time by default
+		_, pageLimit := parse(page, limit)
+		return 1 + pageLimit*childComplexity
+	}
+	c.Query.Ratings = func(childComplexity int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Search = func(childComplexity int, q string, kinds []string, categories []int, tags []int, formats []int, publishedFrom *time.Time, publishedTo *time.Time, page *int, limit *int) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Tags = func(childComplexity int, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) int {
+		return connectionCost(childComplexity, page, limit, first, after)
+	}
+	c.Query.Media = func(childComplexity int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) int {
+		return connectionCost(childComplexity, page, limit, first, after)
+	}
+	c.Query.Users = func(childComplexity int, page *int, limit *int) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Query.Videos = func(childComplexity int, spaces []int, tags []int, categories []int, status *string, page *int, limit *int, sortBy *string, sortOrder *string) int {
+		return listCost(childComplexity, page, limit)
+	}
+	c.Podcast.Episodes = func(childComplexity int, page *int, limit *int) int {
+		return listCost(childComplexity, page, limit)
+	}
+
+	return c
+}
+
+// listCost is the cost of a paged list, using the limit the resolvers apply
+func listCost(childComplexity int, page *int, limit *int) int {
+	_, pageLimit := util.Parse(page, limit)
+	return 1 + pageLimit*childComplexity
+}
+
+// connectionCost is the cost of a list paginated either with pages or with
+// cursors
+func connectionCost(childComplexity int, page *int, limit *int, first *int, after *string) int {
+	if first == nil && after == nil {
+		return listCost(childComplexity, page, limit)
+	}
+	_, pageLimit, _ := util.ParseCursor(first, nil)
+	return 1 + pageLimit*childComplexity
+}
+
+// featuredCost is the cost of the featured entities along with their posts
+func featuredCost(childComplexity int, featuredCount int, postLimit int) int {
+	if postLimit < 1 {
+		postLimit = 1
+	}
+	return 1 + featuredCount*postLimit*childComplexity
+}
@@ -0,0 +1,59 @@
+package resolvers
+
+import (
+	"time"
+
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/util"
+	"gorm.io/gorm"
+)
+
+// connection holds the arguments of the lists paginated with cursors and
+// synced incrementally. Pages after a cursor are ordered by id so that
+// entities published meanwhile do not shift them.
+type connection struct {
+	cursor       bool
+	after        uint
+	first        int
+	updatedSince *time.Time
+}
+
+func newConnection(first *int, after *string, updatedSince *time.Time) (*connection, error) {
+	afterID, pageLimit, err := util.ParseCursor(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection{
+		cursor:       first != nil || after != nil,
+		after:        afterID,
+		first:        pageLimit,
+		updatedSince: updatedSince,
+	}, nil
+}
+
+// filter keeps the entities of the table updated since updatedSince
+func (c *connection) filter(tx *gorm.DB, table string) {
+	if c.updatedSince != nil {
+		tx.Where(table+".updated_at > ?", *c.updatedSince)
+	}
+}
+
+// find fetches the page after the cursor along with the first entity of the
+// next page, if any
+func (c *connection) find(tx *gorm.DB, table string, dest interface{}) {
+	tx.Where(table+".id > ?", c.after).Order(table + ".id asc").Limit(c.first + 1).Find(dest)
+}
+
+// deletedIDs returns the ids of the entities of the space deleted since
+// updatedSince
+func (c *connection) deletedIDs(model interface{}, sID uint) []string {
+	ids := make([]string, 0)
+	if c.updatedSince == nil {
+		return ids
+	}
+
+	config.DB.Unscoped().Model(model).Where("space_id = ? AND deleted_at > ?", sID, *c.updatedSince).Pluck("id", &ids)
+
+	return ids
+}
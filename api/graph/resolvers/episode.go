@@ -0,0 +1,134 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/generated"
+	"github.com/factly/dega-api/graph/loaders"
+	"github.com/factly/dega-api/graph/models"
+	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util"
+)
+
+func (r *episodeResolver) ID(ctx context.Context, obj *models.Episode) (string, error) {
+	return fmt.Sprint(obj.ID), nil
+}
+
+func (r *episodeResolver) SpaceID(ctx context.Context, obj *models.Episode) (int, error) {
+	return int(obj.SpaceID), nil
+}
+
+func (r *episodeResolver) Description(ctx context.Context, obj *models.Episode) (interface{}, error) {
+	return obj.Description, nil
+}
+
+func (r *episodeResolver) Podcast(ctx context.Context, obj *models.Episode) (*models.Podcast, error) {
+	if obj.PodcastID == nil || *obj.PodcastID == 0 {
+		return nil, nil
+	}
+
+	return loaders.GetPodcastLoader(ctx).Load(fmt.Sprint(*obj.PodcastID))
+}
+
+func (r *episodeResolver) Medium(ctx context.Context, obj *models.Episode) (*models.Medium, error) {
+	if obj.MediumID == nil || *obj.MediumID == 0 {
+		return nil, nil
+	}
+
+	return loaders.GetMediumLoader(ctx).Load(fmt.Sprint(*obj.MediumID))
+}
+
+func (r *episodeResolver) Users(ctx context.Context, obj *models.Episode) ([]*models.User, error) {
+	return loaders.GetEpisodeAuthorsLoader(ctx).Load(fmt.Sprint(obj.ID))
+}
+
+func (r *episodeResolver) MetaFields(ctx context.Context, obj *models.Episode) (interface{}, error) {
+	return obj.MetaFields, nil
+}
+
+func (r *episodeResolver) Meta(ctx context.Context, obj *models.Episode) (interface{}, error) {
+	return obj.Meta, nil
+}
+
+func (r *queryResolver) Episode(ctx context.Context, id *int, slug *string) (*models.Episode, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == nil && slug == nil {
+		return nil, errors.New("please provide either id or slug")
+	}
+
+	result := &models.Episode{}
+	tx := config.DB.Model(&models.Episode{})
+	if id != nil {
+		tx.Where(&models.Episode{
+			ID:      uint(*id),
+			SpaceID: sID,
+		})
+	} else {
+		tx.Where(&models.Episode{
+			Slug:    *slug,
+			SpaceID: sID,
+		})
+	}
+
+	// the episodes published before they had a status are backfilled as
+	// published by dega-server
+	err = tx.Where("status = ?", "publish").First(&result).Error
+	if err != nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func (r *queryResolver) Episodes(ctx context.Context, podcast *int, page *int, limit *int, sortBy *string, sortOrder *string) (*models.EpisodesPaging, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{"created_at", "updated_at", "published_date", "title", "slug", "season", "episode"}
+	pageSortBy := "published_date"
+	pageSortOrder := "desc"
+
+	if sortOrder != nil && *sortOrder == "asc" {
+		pageSortOrder = "asc"
+	}
+
+	if sortBy != nil && util.ColumnValidator(*sortBy, columns) {
+		pageSortBy = *sortBy
+	}
+
+	order := pageSortBy + " " + pageSortOrder
+
+	result := &models.EpisodesPaging{}
+	result.Nodes = make([]*models.Episode, 0)
+
+	offset, pageLimit := util.Parse(page, limit)
+
+	tx := config.DB.Model(&models.Episode{}).Where(&models.Episode{
+		SpaceID: sID,
+	}).Where("status = ?", "publish")
+
+	if podcast != nil {
+		tx.Where("podcast_id = ?", *podcast)
+	}
+
+	var total int64
+	tx.Count(&total).Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+
+	result.Total = int(total)
+
+	return result, nil
+}
+
+// Episode model resolver
+func (r *Resolver) Episode() generated.EpisodeResolver { return &episodeResolver{r} }
+
+type episodeResolver struct{ *Resolver }
@@ -0,0 +1,32 @@
+package resolvers
+
+import (
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/models"
+	"gorm.io/gorm"
+)
+
+// defaultLocale returns the default locale of the space
+func defaultLocale(sID uint) string {
+	space := models.Space{}
+	config.DB.Model(&models.Space{}).Select("id, default_locale").Where("id = ?", sID).First(&space)
+	return space.DefaultLocale
+}
+
+// localeOf returns the locale of an entity, the entities without a locale
+// are in the default locale of their space
+func localeOf(locale string, sID uint) string {
+	if locale == "" {
+		return defaultLocale(sID)
+	}
+	return locale
+}
+
+// inLocale narrows the query down to the entities in the locale
+func inLocale(tx *gorm.DB, column, locale string, sID uint) {
+	if locale != "" && locale == defaultLocale(sID) {
+		tx.Where(column+" IN (?)", []string{locale, ""})
+		return
+	}
+	tx.Where(column+" = ?", locale)
+}
@@ -3,11 +3,13 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/factly/dega-api/config"
 	"github.com/factly/dega-api/graph/generated"
 	"github.com/factly/dega-api/graph/models"
 	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util"
 )
 
 func (r *mediumResolver) ID(ctx context.Context, obj *models.Medium) (string, error) {
@@ -26,17 +28,58 @@ func (r *mediumResolver) MetaFields(ctx context.Context, obj *models.Medium) (in
 	return obj.MetaFields, nil
 }
 
-func (r *queryResolver) Media(ctx context.Context) ([]*models.Medium, error) {
+func (r *queryResolver) Media(ctx context.Context, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) (*models.MediaPaging, error) {
 	sID, err := validator.GetSpace(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	result := []*models.Medium{}
+	conn, err := newConnection(first, after, updatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{"created_at", "updated_at", "name", "slug"}
+	pageSortBy := "created_at"
+	pageSortOrder := "desc"
+
+	if sortOrder != nil && *sortOrder == "asc" {
+		pageSortOrder = "asc"
+	}
+
+	if sortBy != nil && util.ColumnValidator(*sortBy, columns) {
+		pageSortBy = *sortBy
+	}
 
-	config.DB.Model(&models.Medium{}).Where(&models.Medium{
+	order := pageSortBy + " " + pageSortOrder
+
+	result := &models.MediaPaging{}
+	result.Nodes = make([]*models.Medium, 0)
+
+	offset, pageLimit := util.Parse(page, limit)
+
+	tx := config.DB.Model(&models.Medium{}).Where(&models.Medium{
 		SpaceID: sID,
-	}).Find(&result)
+	})
+	conn.filter(tx, "media")
+
+	var total int64
+	tx.Count(&total)
+
+	if conn.cursor {
+		conn.find(tx, "media", &result.Nodes)
+
+		if len(result.Nodes) > conn.first {
+			result.Nodes = result.Nodes[:conn.first]
+			result.HasNextPage = true
+		}
+		result.HasPreviousPage = conn.after > 0
+	} else {
+		tx.Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}
+
+	result.Total = int(total)
+	result.DeletedIds = conn.deletedIDs(&models.Medium{}, sID)
 
 	return result, nil
 }
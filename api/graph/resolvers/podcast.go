@@ -0,0 +1,138 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/generated"
+	"github.com/factly/dega-api/graph/loaders"
+	"github.com/factly/dega-api/graph/models"
+	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util"
+)
+
+func (r *podcastResolver) ID(ctx context.Context, obj *models.Podcast) (string, error) {
+	return fmt.Sprint(obj.ID), nil
+}
+
+func (r *podcastResolver) SpaceID(ctx context.Context, obj *models.Podcast) (int, error) {
+	return int(obj.SpaceID), nil
+}
+
+func (r *podcastResolver) Description(ctx context.Context, obj *models.Podcast) (interface{}, error) {
+	return obj.Description, nil
+}
+
+func (r *podcastResolver) Categories(ctx context.Context, obj *models.Podcast) ([]*models.Category, error) {
+	return loaders.GetPodcastCategoriesLoader(ctx).Load(fmt.Sprint(obj.ID))
+}
+
+func (r *podcastResolver) PrimaryCategory(ctx context.Context, obj *models.Podcast) (*models.Category, error) {
+	if obj.PrimaryCategoryID == nil || *obj.PrimaryCategoryID == 0 {
+		return nil, nil
+	}
+
+	return loaders.GetCategoryLoader(ctx).Load(fmt.Sprint(*obj.PrimaryCategoryID))
+}
+
+func (r *podcastResolver) Medium(ctx context.Context, obj *models.Podcast) (*models.Medium, error) {
+	if obj.MediumID == nil || *obj.MediumID == 0 {
+		return nil, nil
+	}
+
+	return loaders.GetMediumLoader(ctx).Load(fmt.Sprint(*obj.MediumID))
+}
+
+func (r *podcastResolver) Episodes(ctx context.Context, obj *models.Podcast, page *int, limit *int) (*models.EpisodesPaging, error) {
+	podcastID := int(obj.ID)
+	return r.Query().Episodes(ctx, &podcastID, page, limit, nil, nil)
+}
+
+func (r *podcastResolver) MetaFields(ctx context.Context, obj *models.Podcast) (interface{}, error) {
+	return obj.MetaFields, nil
+}
+
+func (r *podcastResolver) Meta(ctx context.Context, obj *models.Podcast) (interface{}, error) {
+	return obj.Meta, nil
+}
+
+func (r *queryResolver) Podcast(ctx context.Context, id *int, slug *string) (*models.Podcast, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == nil && slug == nil {
+		return nil, errors.New("please provide either id or slug")
+	}
+
+	result := &models.Podcast{}
+	tx := config.DB.Model(&models.Podcast{})
+	if id != nil {
+		tx.Where(&models.Podcast{
+			ID:      uint(*id),
+			SpaceID: sID,
+		})
+	} else {
+		tx.Where(&models.Podcast{
+			Slug:    *slug,
+			SpaceID: sID,
+		})
+	}
+
+	err = tx.First(&result).Error
+	if err != nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func (r *queryResolver) Podcasts(ctx context.Context, categories []int, page *int, limit *int, sortBy *string, sortOrder *string) (*models.PodcastsPaging, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{"created_at", "updated_at", "title", "slug"}
+	pageSortBy := "created_at"
+	pageSortOrder := "desc"
+
+	if sortOrder != nil && *sortOrder == "asc" {
+		pageSortOrder = "asc"
+	}
+
+	if sortBy != nil && util.ColumnValidator(*sortBy, columns) {
+		pageSortBy = *sortBy
+	}
+
+	order := "podcasts." + pageSortBy + " " + pageSortOrder
+
+	result := &models.PodcastsPaging{}
+	result.Nodes = make([]*models.Podcast, 0)
+
+	offset, pageLimit := util.Parse(page, limit)
+
+	tx := config.DB.Model(&models.Podcast{}).Where(&models.Podcast{
+		SpaceID: sID,
+	})
+
+	if len(categories) > 0 {
+		tx.Joins("INNER JOIN podcast_categories ON podcast_categories.podcast_id = podcasts.id").
+			Where("podcast_categories.category_id IN (?)", categories).Group("podcasts.id")
+	}
+
+	var total int64
+	tx.Count(&total).Order(order).Offset(offset).Limit(pageLimit).Select("podcasts.*").Find(&result.Nodes)
+
+	result.Total = int(total)
+
+	return result, nil
+}
+
+// Podcast model resolver
+func (r *Resolver) Podcast() generated.PodcastResolver { return &podcastResolver{r} }
+
+type podcastResolver struct{ *Resolver }
@@ -170,7 +170,21 @@ func (r *postResolver) Schemas(ctx context.Context, obj *models.Post) (interface
 	return schema, nil
 }
 
-func (r *queryResolver) Post(ctx context.Context, id *int, slug *string, include_page *bool) (*models.Post, error) {
+func (r *postResolver) Translations(ctx context.Context, obj *models.Post) ([]*models.Post, error) {
+	result := make([]*models.Post, 0)
+	if obj.TranslationGroupID == nil {
+		return result, nil
+	}
+
+	config.DB.Model(&models.Post{}).Where(&models.Post{
+		SpaceID: obj.SpaceID,
+		Status:  "publish",
+	}).Where("translation_group_id = ? AND id <> ?", *obj.TranslationGroupID, obj.ID).Order("id").Find(&result)
+
+	return result, nil
+}
+
+func (r *queryResolver) Post(ctx context.Context, id *int, slug *string, include_page *bool, locale *string) (*models.Post, error) {
 	sID, err := validator.GetSpace(ctx)
 	if err != nil {
 		return nil, err
@@ -204,10 +218,27 @@ func (r *queryResolver) Post(ctx context.Context, id *int, slug *string, include
 		return nil, nil
 	}
 
+	// the translation of the post in the locale
+	if locale != nil && localeOf(result.Locale, sID) != *locale {
+		if result.TranslationGroupID == nil {
+			return nil, nil
+		}
+
+		translation := &models.Post{}
+		err = config.DB.Model(&models.Post{}).Where(&models.Post{
+			SpaceID: sID,
+			IsPage:  result.IsPage,
+		}).Where("translation_group_id = ? AND locale = ?", *result.TranslationGroupID, *locale).First(&translation).Error
+		if err != nil {
+			return nil, nil
+		}
+		return translation, nil
+	}
+
 	return result, nil
 }
 
-func (r *queryResolver) Posts(ctx context.Context, spaces []int, formats *models.PostFilter, categories *models.PostFilter, tags *models.PostFilter, users *models.PostFilter, status *string, page *int, limit *int, sortBy *string, sortOrder *string) (*models.PostsPaging, error) {
+func (r *queryResolver) Posts(ctx context.Context, spaces []int, formats *models.PostFilter, categories *models.PostFilter, tags *models.PostFilter, users *models.PostFilter, status *string, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time, locale *string) (*models.PostsPaging, error) {
 	sID, err := validator.GetSpace(ctx)
 	if err != nil {
 		return nil, err
@@ -217,6 +248,11 @@ func (r *queryResolver) Posts(ctx context.Context, spaces []int, formats *models
 		return nil, err
 	}
 
+	conn, err := newConnection(first, after, updatedSince)
+	if err != nil {
+		return nil, err
+	}
+
 	columns := []string{"created_at", "updated_at", "name", "slug"}
 	pageSortBy := "created_at"
 	pageSortOrder := "desc"
@@ -238,11 +274,11 @@ func (r *queryResolver) Posts(ctx context.Context, spaces []int, formats *models
 
 	tx := config.DB.Model(&models.Post{}).Where("is_page = ?", false)
 
+	postStatus := "publish"
 	if status != nil {
-		tx.Where("status = ?", status)
-	} else {
-		tx.Where("status = ?", "publish")
+		postStatus = *status
 	}
+	tx.Where("status = ?", postStatus)
 
 	userIDs := make([]int, 0)
 	// get user ids if slugs provided
@@ -339,14 +375,40 @@ func (r *queryResolver) Posts(ctx context.Context, spaces []int, formats *models
 	tx.Group("posts.id")
 
 	filterStr = strings.Trim(filterStr, " AND")
-	var total int64
 	tx.Where(&models.Post{
 		SpaceID: uint(sID),
-	}).Where(filterStr).Count(&total).Offset(offset).Limit(pageLimit).Order(order).Select("posts.*").Find(&result.Nodes)
+	}).Where(filterStr)
+	if locale != nil {
+		inLocale(tx, "posts.locale", *locale, sID)
+	}
+	conn.filter(tx, "posts")
+
+	var total int64
+	tx.Count(&total).Select("posts.*")
+
+	if conn.cursor {
+		conn.find(tx, "posts", &result.Nodes)
+
+		if len(result.Nodes) > conn.first {
+			result.Nodes = result.Nodes[:conn.first]
+			result.HasNextPage = true
+		}
+		result.HasPreviousPage = conn.after > 0
+	} else {
+		tx.Offset(offset).Limit(pageLimit).Order(order).Find(&result.Nodes)
+	}
 
 	tx.Commit()
 
 	result.Total = int(total)
+	result.DeletedIds = make([]string, 0)
+
+	// posts no longer in the status listed are removed along with the deleted ones
+	if conn.updatedSince != nil {
+		config.DB.Unscoped().Model(&models.Post{}).Where(&models.Post{
+			SpaceID: uint(sID),
+		}).Where("is_page = ?", false).Where("deleted_at > ? OR (updated_at > ? AND status <> ?)", *conn.updatedSince, *conn.updatedSince, postStatus).Pluck("id", &result.DeletedIds)
+	}
 
 	return result, nil
 }
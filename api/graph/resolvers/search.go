@@ -2,62 +2,123 @@ package resolvers
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/factly/dega-api/config"
 	"github.com/factly/dega-api/graph/models"
-	"github.com/factly/x/meilisearchx"
-	"github.com/meilisearch/meilisearch-go"
+	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util"
+	"github.com/factly/dega-api/util/search"
 )
 
-func (r *queryResolver) Search(ctx context.Context, q string) (*models.SearchResult, error) {
-	result := models.SearchResult{}
-	hits, err := meilisearchx.Client.Search("dega").Search(meilisearch.SearchRequest{
-		Query: q,
-		Limit: 1000000,
+func (r *queryResolver) Search(ctx context.Context, q string, kinds []string, categories []int, tags []int, formats []int, publishedFrom *time.Time, publishedTo *time.Time, page *int, limit *int) (*models.SearchResult, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, pageLimit := util.Parse(page, limit)
+
+	res, err := search.Search(search.Query{
+		Text:          q,
+		SpaceID:       sID,
+		Kinds:         kinds,
+		Categories:    categories,
+		Tags:          tags,
+		Formats:       formats,
+		PublishedFrom: publishedFrom,
+		PublishedTo:   publishedTo,
+		Offset:        offset,
+		Limit:         pageLimit,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	entityMap := make(map[string][]uint)
+	result := models.SearchResult{
+		Total: int(res.Total),
+		Hits:  make([]*models.SearchHit, 0),
+		Facets: &models.SearchFacets{
+			Kinds:      facetCounts(res.Facets["kind"]),
+			Categories: facetCounts(res.Facets["category_ids"]),
+			Tags:       facetCounts(res.Facets["tag_ids"]),
+			Formats:    facetCounts(res.Facets["format_id"]),
+		},
+	}
 
-	for _, hit := range hits.Hits {
-		hitmap := hit.(map[string]interface{})
-		id := hitmap["id"].(float64)
-		entity := hitmap["kind"].(string)
+	entityMap := make(map[string][]uint)
 
-		entityMap[entity] = append(entityMap[entity], uint(id))
+	for _, hit := range res.Hits {
+		highlights := make([]*models.SearchHighlight, 0)
+		for field, snippet := range hit.Highlights {
+			highlights = append(highlights, &models.SearchHighlight{
+				Field:   field,
+				Snippet: snippet,
+			})
+		}
+		sort.Slice(highlights, func(i, j int) bool {
+			return highlights[i].Field < highlights[j].Field
+		})
+
+		result.Hits = append(result.Hits, &models.SearchHit{
+			ID:         int(hit.ID),
+			Kind:       hit.Kind,
+			Highlights: highlights,
+		})
+
+		// pages are posts too
+		kind := hit.Kind
+		if kind == "page" {
+			kind = "post"
+		}
+		entityMap[kind] = append(entityMap[kind], hit.ID)
 	}
 
 	for key, val := range entityMap {
 
 		switch key {
 		case "post":
-			config.DB.Model(&models.Post{}).Where(val).Find(&result.Posts)
+			config.DB.Model(&models.Post{}).Where("space_id = ?", sID).Where(val).Find(&result.Posts)
 
 		case "category":
-			config.DB.Model(&models.Category{}).Where(val).Find(&result.Categories)
+			config.DB.Model(&models.Category{}).Where("space_id = ?", sID).Where(val).Find(&result.Categories)
 
 		case "tag":
-			config.DB.Model(&models.Tag{}).Where(val).Find(&result.Tags)
+			config.DB.Model(&models.Tag{}).Where("space_id = ?", sID).Where(val).Find(&result.Tags)
 
 		case "claim":
-			config.DB.Model(&models.Claim{}).Where(val).Find(&result.Claims)
+			config.DB.Model(&models.Claim{}).Where("space_id = ?", sID).Where(val).Find(&result.Claims)
 
 		case "claimant":
-			config.DB.Model(&models.Claimant{}).Where(val).Find(&result.Claimants)
+			config.DB.Model(&models.Claimant{}).Where("space_id = ?", sID).Where(val).Find(&result.Claimants)
 
 		case "rating":
-			config.DB.Model(&models.Rating{}).Where(val).Find(&result.Ratings)
+			config.DB.Model(&models.Rating{}).Where("space_id = ?", sID).Where(val).Find(&result.Ratings)
 
 		case "medium":
-			config.DB.Model(&models.Medium{}).Where(val).Find(&result.Media)
-
-		default:
-			return nil, nil
+			config.DB.Model(&models.Medium{}).Where("space_id = ?", sID).Where(val).Find(&result.Media)
 
 		}
 	}
 
 	return &result, nil
 }
+
+// facetCounts returns the counts of a facet with the most frequent values first
+func facetCounts(counts map[string]int64) []*models.SearchFacetCount {
+	result := make([]*models.SearchFacetCount, 0)
+	for value, count := range counts {
+		result = append(result, &models.SearchFacetCount{
+			Value: value,
+			Count: int(count),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count == result[j].Count {
+			return result[i].Value < result[j].Value
+		}
+		return result[i].Count > result[j].Count
+	})
+	return result
+}
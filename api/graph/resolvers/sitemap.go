@@ -10,6 +10,7 @@ import (
 	"github.com/factly/dega-api/graph/generated"
 	"github.com/factly/dega-api/graph/models"
 	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util/permalink"
 	"github.com/spf13/viper"
 )
 
@@ -29,11 +30,14 @@ func (r *sitemapsResolver) Categories(ctx context.Context, obj *models.Sitemaps)
 	config.DB.Model(&models.Category{}).Where("space_id in (?)", sID).Find(&categories)
 	nodes := []*models.Sitemap{}
 
+	builder := urls(sID)
 	for _, category := range categories {
+		url := builder.Category(category)
 		sitemap := &models.Sitemap{
 			ID:        fmt.Sprint(category.ID),
 			Slug:      category.Slug,
 			CreatedAt: category.CreatedAt,
+			URL:       &url,
 		}
 		nodes = append(nodes, sitemap)
 	}
@@ -50,11 +54,14 @@ func (r *sitemapsResolver) Tags(ctx context.Context, obj *models.Sitemaps) ([]*m
 	config.DB.Model(&models.Tag{}).Where("space_id in (?)", sID).Find(&tags)
 	nodes := []*models.Sitemap{}
 
+	builder := urls(sID)
 	for _, tag := range tags {
+		url := builder.Tag(tag)
 		sitemap := &models.Sitemap{
 			ID:        fmt.Sprint(tag.ID),
 			Slug:      tag.Slug,
 			CreatedAt: tag.CreatedAt,
+			URL:       &url,
 		}
 		nodes = append(nodes, sitemap)
 	}
@@ -119,11 +126,14 @@ func (r *sitemapsResolver) Users(ctx context.Context, obj *models.Sitemaps) ([]*
 
 	nodes := []*models.Sitemap{}
 
+	builder := permalink.New(*space)
 	for _, user := range users {
+		url := builder.Author(*user)
 		sitemap := &models.Sitemap{
 			ID:        fmt.Sprint(user.ID),
 			Slug:      fmt.Sprint(user.ID),
 			CreatedAt: user.CreatedAt,
+			URL:       &url,
 		}
 		nodes = append(nodes, sitemap)
 	}
@@ -140,11 +150,14 @@ func (r *sitemapsResolver) Formats(ctx context.Context, obj *models.Sitemaps) ([
 	config.DB.Model(&models.Format{}).Where("space_id in (?)", sID).Find(&formats)
 	nodes := []*models.Sitemap{}
 
+	builder := urls(sID)
 	for _, format := range formats {
+		url := builder.Format(format)
 		sitemap := &models.Sitemap{
 			ID:        fmt.Sprint(format.ID),
 			Slug:      format.Slug,
 			CreatedAt: format.CreatedAt,
+			URL:       &url,
 		}
 		nodes = append(nodes, sitemap)
 	}
@@ -158,14 +171,17 @@ func (r *sitemapsResolver) Posts(ctx context.Context, obj *models.Sitemaps) ([]*
 	}
 	posts := []models.Post{}
 
-	config.DB.Model(&models.Post{}).Where("space_id in (?)", sID).Find(&posts)
+	config.DB.Model(&models.Post{}).Where("space_id in (?)", sID).Preload("Format").Find(&posts)
 	nodes := []*models.Sitemap{}
 
+	builder := urls(sID)
 	for _, post := range posts {
+		url := builder.Post(post)
 		sitemap := &models.Sitemap{
 			ID:        fmt.Sprint(post.ID),
 			Slug:      post.Slug,
 			CreatedAt: post.CreatedAt,
+			URL:       &url,
 		}
 		nodes = append(nodes, sitemap)
 	}
@@ -235,6 +251,13 @@ func (r *sitemapsResolver) Ratings(ctx context.Context, obj *models.Sitemaps) ([
 	return nodes, nil
 }
 
+// urls returns the url builder of the space
+func urls(sID uint) permalink.Builder {
+	space := models.Space{}
+	config.DB.Model(&models.Space{}).Where("id = ?", sID).First(&space)
+	return permalink.New(space)
+}
+
 // Sitemaps model resolver
 func (r *Resolver) Sitemaps() generated.SitemapsResolver { return &sitemapsResolver{r} }
 
@@ -73,6 +73,14 @@ func (r *spaceResolver) MetaFields(ctx context.Context, obj *models.Space) (inte
 	return obj.MetaFields, nil
 }
 
+func (r *spaceResolver) SupportedLocales(ctx context.Context, obj *models.Space) (interface{}, error) {
+	return obj.SupportedLocales, nil
+}
+
+func (r *spaceResolver) Permalinks(ctx context.Context, obj *models.Space) (interface{}, error) {
+	return obj.Permalinks, nil
+}
+
 func (r *queryResolver) Space(ctx context.Context) (*models.Space, error) {
 
 	log.Println(" Space resolver entry")
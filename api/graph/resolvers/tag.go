@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -118,11 +119,17 @@ func (r *queryResolver) Tag(ctx context.Context, id *int, slug *string) (*models
 	return result, nil
 }
 
-func (r *queryResolver) Tags(ctx context.Context, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string) (*models.TagsPaging, error) {
+func (r *queryResolver) Tags(ctx context.Context, ids []int, spaces []int, page *int, limit *int, sortBy *string, sortOrder *string, first *int, after *string, updatedSince *time.Time) (*models.TagsPaging, error) {
 	sID, err := validator.GetSpace(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	conn, err := newConnection(first, after, updatedSince)
+	if err != nil {
+		return nil, err
+	}
+
 	columns := []string{"created_at", "updated_at", "name", "slug"}
 	pageSortBy := "created_at"
 	pageSortOrder := "desc"
@@ -150,12 +157,28 @@ func (r *queryResolver) Tags(ctx context.Context, ids []int, spaces []int, page
 		tx = config.DB.Model(&models.Tag{})
 	}
 
-	var total int64
 	tx.Where(&models.Tag{
 		SpaceID: uint(sID),
-	}).Count(&total).Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	})
+	conn.filter(tx, "tags")
+
+	var total int64
+	tx.Count(&total)
+
+	if conn.cursor {
+		conn.find(tx, "tags", &result.Nodes)
+
+		if len(result.Nodes) > conn.first {
+			result.Nodes = result.Nodes[:conn.first]
+			result.HasNextPage = true
+		}
+		result.HasPreviousPage = conn.after > 0
+	} else {
+		tx.Order(order).Offset(offset).Limit(pageLimit).Find(&result.Nodes)
+	}
 
 	result.Total = int(total)
+	result.DeletedIds = conn.deletedIDs(&models.Tag{}, sID)
 
 	return result, nil
 }
@@ -0,0 +1,193 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/generated"
+	"github.com/factly/dega-api/graph/loaders"
+	"github.com/factly/dega-api/graph/models"
+	"github.com/factly/dega-api/graph/validator"
+	"github.com/factly/dega-api/util"
+)
+
+func (r *videoResolver) ID(ctx context.Context, obj *models.Video) (string, error) {
+	return fmt.Sprint(obj.ID), nil
+}
+
+func (r *videoResolver) SpaceID(ctx context.Context, obj *models.Video) (int, error) {
+	return int(obj.SpaceID), nil
+}
+
+func (r *videoResolver) Tags(ctx context.Context, obj *models.Video) ([]*models.Tag, error) {
+	res := make([]models.VideoTag, 0)
+	tags := make([]string, 0)
+
+	// fetch all tags
+	config.DB.Model(&models.VideoTag{}).Where(&models.VideoTag{
+		VideoID: obj.ID,
+	}).Find(&res)
+
+	for _, videoTag := range res {
+		tags = append(tags, fmt.Sprint(videoTag.TagID))
+	}
+
+	tagList, _ := loaders.GetTagLoader(ctx).LoadAll(tags)
+	return tagList, nil
+}
+
+func (r *videoResolver) Categories(ctx context.Context, obj *models.Video) ([]*models.Category, error) {
+	res := make([]models.VideoCategory, 0)
+	cats := make([]string, 0)
+
+	// fetch all categories
+	config.DB.Model(&models.VideoCategory{}).Where(&models.VideoCategory{
+		VideoID: obj.ID,
+	}).Find(&res)
+
+	for _, videoCat := range res {
+		cats = append(cats, fmt.Sprint(videoCat.CategoryID))
+	}
+
+	categories, _ := loaders.GetCategoryLoader(ctx).LoadAll(cats)
+	return categories, nil
+}
+
+func (r *videoResolver) Users(ctx context.Context, obj *models.Video) ([]*models.User, error) {
+	videoUsers := []models.VideoAuthor{}
+
+	config.DB.Model(&models.VideoAuthor{}).Where(&models.VideoAuthor{
+		VideoID: obj.ID,
+	}).Where("deleted_at IS NULL").Find(&videoUsers)
+
+	var allUserID []string
+
+	for _, videoUser := range videoUsers {
+		allUserID = append(allUserID, fmt.Sprint(videoUser.AuthorID))
+	}
+
+	users, _ := loaders.GetUserLoader(ctx).LoadAll(allUserID)
+	return users, nil
+}
+
+func (r *videoResolver) Claims(ctx context.Context, obj *models.Video) ([]*models.Claim, error) {
+	videoClaims := []models.Claim{}
+
+	// claims are ordered by the time they appear in the video
+	config.DB.Model(&models.Claim{}).Where("video_id = ?", obj.ID).Order("start_time asc").Find(&videoClaims)
+
+	var allClaimID []string
+
+	for _, videoClaim := range videoClaims {
+		allClaimID = append(allClaimID, fmt.Sprint(videoClaim.ID))
+	}
+
+	claims, _ := loaders.GetClaimLoader(ctx).LoadAll(allClaimID)
+	return claims, nil
+}
+
+func (r *videoResolver) Schemas(ctx context.Context, obj *models.Video) (interface{}, error) {
+	var schema interface{}
+	if err := json.Unmarshal(obj.Schemas.RawMessage, &schema); err != nil {
+		return schema, nil
+	}
+	return schema, nil
+}
+
+func (r *queryResolver) Video(ctx context.Context, id *int, slug *string) (*models.Video, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == nil && slug == nil {
+		return nil, errors.New("please provide either id or slug")
+	}
+
+	result := &models.Video{}
+	tx := config.DB.Model(&models.Video{})
+	if id != nil {
+		tx.Where(&models.Video{
+			ID:      uint(*id),
+			SpaceID: sID,
+		})
+	} else {
+		tx.Where(&models.Video{
+			Slug:    *slug,
+			SpaceID: sID,
+		})
+	}
+
+	err = tx.Where("status = ?", "publish").First(&result).Error
+	if err != nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func (r *queryResolver) Videos(ctx context.Context, spaces []int, tags []int, categories []int, status *string, page *int, limit *int, sortBy *string, sortOrder *string) (*models.VideosPaging, error) {
+	sID, err := validator.GetSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{"created_at", "updated_at", "published_date", "title", "slug"}
+	pageSortBy := "created_at"
+	pageSortOrder := "desc"
+
+	if sortOrder != nil && *sortOrder == "asc" {
+		pageSortOrder = "asc"
+	}
+
+	if sortBy != nil && util.ColumnValidator(*sortBy, columns) {
+		pageSortBy = *sortBy
+	}
+
+	order := "videos." + pageSortBy + " " + pageSortOrder
+
+	result := &models.VideosPaging{}
+	result.Nodes = make([]*models.Video, 0)
+
+	offset, pageLimit := util.Parse(page, limit)
+
+	tx := config.DB.Model(&models.Video{})
+
+	if status != nil {
+		tx.Where("videos.status = ?", status)
+	} else {
+		tx.Where("videos.status = ?", "publish")
+	}
+
+	filterStr := ""
+
+	if len(tags) > 0 {
+		tx.Joins("INNER JOIN video_tags ON video_tags.video_id = videos.id")
+		filterStr = filterStr + fmt.Sprint("video_tags.tag_id IN (", strings.Trim(strings.Replace(fmt.Sprint(tags), " ", ",", -1), "[]"), ") AND ")
+	}
+
+	if len(categories) > 0 {
+		tx.Joins("INNER JOIN video_categories ON video_categories.video_id = videos.id")
+		filterStr = filterStr + fmt.Sprint("video_categories.category_id IN (", strings.Trim(strings.Replace(fmt.Sprint(categories), " ", ",", -1), "[]"), ") AND ")
+	}
+
+	filterStr = strings.Trim(filterStr, " AND")
+
+	var total int64
+	tx.Where(&models.Video{
+		SpaceID: uint(sID),
+	}).Where(filterStr).Group("videos.id").Count(&total).Order(order).Offset(offset).Limit(pageLimit).Select("videos.*").Find(&result.Nodes)
+
+	result.Total = int(total)
+
+	return result, nil
+}
+
+// Video model resolver
+func (r *Resolver) Video() generated.VideoResolver { return &videoResolver{r} }
+
+type videoResolver struct{ *Resolver }
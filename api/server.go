@@ -7,20 +7,20 @@ import (
 
 	"github.com/factly/x/healthx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 
-	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/extensions"
 	"github.com/factly/dega-api/graph/generated"
 	"github.com/factly/dega-api/graph/loaders"
 	"github.com/factly/dega-api/graph/resolvers"
 	"github.com/factly/dega-api/graph/validator"
 	"github.com/factly/dega-api/util"
 	"github.com/factly/dega-api/util/cache"
+	"github.com/factly/dega-api/util/search"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
@@ -68,21 +68,33 @@ func main() {
 		}
 
 		cache.SetupCache(viper.GetString("redis_url"), viper.GetString("redis_password"), time.Duration(cacheExpiration)*time.Second, 0)
+
+		// evict the entries affected by the events of dega-server
+		if viper.IsSet("nats_url") {
+			nc, err := cache.SubscribeEvents(viper.GetString("nats_url"), viper.GetString("nats_user_name"), viper.GetString("nats_user_password"))
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer nc.Close()
+		}
 	}
 
 	if config.SearchEnabled() {
-		err := meilisearchx.SetupMeiliSearch("dega", []string{"name", "slug", "description", "title", "subtitle", "excerpt", "claim", "fact", "site_title", "site_address", "tag_line", "review", "review_tag_line"})
+		err := search.Setup()
 		if err != nil {
 			log.Println(err)
 		}
 	}
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{}}))
+	srv := extensions.NewServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{}, Complexity: resolvers.Complexity()}))
 
-	r := router.With(validator.CheckSpace(), validator.CheckOrganisation(), middlewarex.ValidateAPIToken("X-Dega-API-Key", "dega", validator.GetOrganisation))
+	auth := router.With(validator.CheckSpace(), validator.CheckOrganisation(), middlewarex.ValidateAPIToken("X-Dega-API-Key", "dega", validator.GetOrganisation))
 
+	r := auth
 	if cache.IsEnabled() {
-		r = r.With(cache.CachingMiddleware(), cache.RespMiddleware)
+		r = r.With(cache.CachingMiddleware(validator.GetSpace), cache.RespMiddleware(validator.GetSpace))
+
+		auth.Post("/cache/purge", cache.PurgeHandler(validator.GetSpace))
 	}
 
 	r.Handle("/query", loaders.DataloaderMiddleware(srv))
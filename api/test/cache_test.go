@@ -0,0 +1,398 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/factly/dega-api/util/cache"
+	"github.com/spf13/viper"
+)
+
+func TestCacheTags(t *testing.T) {
+	t.Run("tag entities and lists of a response", func(t *testing.T) {
+		response := map[string]interface{}{
+			"data": map[string]interface{}{
+				"posts": map[string]interface{}{
+					"nodes": []interface{}{
+						map[string]interface{}{
+							"id":    "1",
+							"title": "Post",
+							"categories": []interface{}{
+								map[string]interface{}{"id": "2", "name": "Politics"},
+							},
+							"medium": map[string]interface{}{"id": "3"},
+						},
+					},
+					"total": 1,
+				},
+				"space": map[string]interface{}{
+					"id":   "1",
+					"logo": nil,
+				},
+				"post": nil,
+			},
+		}
+
+		expected := []string{"category:2", "list:post", "medium:3", "post:1", "space:1"}
+		tags := cache.Tags(response)
+		if len(tags) != len(expected) {
+			t.Fatalf("expected tags %v, got %v", expected, tags)
+		}
+		for i := range expected {
+			if tags[i] != expected[i] {
+				t.Fatalf("expected tags %v, got %v", expected, tags)
+			}
+		}
+	})
+
+	t.Run("tag entities queried without id with their list", func(t *testing.T) {
+		response := map[string]interface{}{
+			"data": map[string]interface{}{
+				"category": map[string]interface{}{"name": "Politics"},
+			},
+		}
+
+		tags := cache.Tags(response)
+		if len(tags) != 1 || tags[0] != "list:category" {
+			t.Fatalf("expected tags [list:category], got %v", tags)
+		}
+	})
+
+	t.Run("tag the nodes of edges with their kind", func(t *testing.T) {
+		response := map[string]interface{}{
+			"data": map[string]interface{}{
+				"media": map[string]interface{}{
+					"edges": []interface{}{
+						map[string]interface{}{
+							"cursor": "Y3Vyc29yOjc=",
+							"node":   map[string]interface{}{"id": "7"},
+						},
+					},
+				},
+			},
+		}
+
+		expected := []string{"list:medium", "medium:7"}
+		tags := cache.Tags(response)
+		if len(tags) != len(expected) || tags[0] != expected[0] || tags[1] != expected[1] {
+			t.Fatalf("expected tags %v, got %v", expected, tags)
+		}
+	})
+
+	t.Run("tags of an updated post", func(t *testing.T) {
+		spaceID, tags, ok := cache.EventTags("post.updated", []byte(`{"id":4,"space_id":1,"title":"Post"}`))
+		if !ok || spaceID != 1 || len(tags) != 2 || tags[0] != "list:post" || tags[1] != "post:4" {
+			t.Fatalf("unexpected space %d and tags %v", spaceID, tags)
+		}
+	})
+
+	t.Run("tags of a deleted medium", func(t *testing.T) {
+		spaceID, tags, ok := cache.EventTags("media.deleted", []byte(`{"id":7,"space_id":2}`))
+		if !ok || spaceID != 2 || len(tags) != 2 || tags[1] != "medium:7" {
+			t.Fatalf("unexpected space %d and tags %v", spaceID, tags)
+		}
+	})
+
+	t.Run("updated space affects all its entries", func(t *testing.T) {
+		spaceID, tags, ok := cache.EventTags("space.updated", []byte(`{"id":3}`))
+		if !ok || spaceID != 3 || len(tags) != 1 || tags[0] != "all" {
+			t.Fatalf("unexpected space %d and tags %v", spaceID, tags)
+		}
+	})
+
+	t.Run("ignore events of other entities", func(t *testing.T) {
+		if _, _, ok := cache.EventTags("comment.created", []byte(`{"id":1,"space_id":1}`)); ok {
+			t.Fatal("expected comment events to be ignored")
+		}
+		if _, _, ok := cache.EventTags("post.template.created", []byte(`{"id":1,"space_id":1}`)); ok {
+			t.Fatal("expected post template events to be ignored")
+		}
+	})
+}
+
+func TestCachePurge(t *testing.T) {
+	viper.Set("cache_purge_token", "secret")
+	defer viper.Set("cache_purge_token", "")
+
+	// the space is only looked up once the token is checked, failing so that
+	// the purge does not reach redis
+	called := false
+	handler := cache.PurgeHandler(func(ctx context.Context) (uint, error) {
+		called = true
+		return 0, errors.New("space not found")
+	})
+
+	purge := func(token string) int {
+		called = false
+		req := httptest.NewRequest(http.MethodPost, "/cache/purge", nil)
+		if token != "" {
+			req.Header.Set(cache.PurgeTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	t.Run("purge without token", func(t *testing.T) {
+		if code := purge(""); code != http.StatusUnauthorized || called {
+			t.Fatalf("expected purge to be refused, got %d", code)
+		}
+	})
+
+	t.Run("purge with wrong token", func(t *testing.T) {
+		if code := purge("guess"); code != http.StatusUnauthorized || called {
+			t.Fatalf("expected purge to be refused, got %d", code)
+		}
+	})
+
+	t.Run("purge when no token is set", func(t *testing.T) {
+		viper.Set("cache_purge_token", "")
+		defer viper.Set("cache_purge_token", "secret")
+
+		if code := purge(""); code != http.StatusUnauthorized || called {
+			t.Fatalf("expected purge to be disabled, got %d", code)
+		}
+	})
+
+	t.Run("purge with token", func(t *testing.T) {
+		purge("secret")
+		if !called {
+			t.Fatal("expected the space of the purge to be looked up")
+		}
+	})
+}
+
+func TestCachingMiddleware(t *testing.T) {
+	redis := newFakeRedis(t)
+	defer redis.Close()
+	cache.SetupCache(redis.Addr(), "", time.Minute, 0)
+
+	getSpace := func(ctx context.Context) (uint, error) {
+		return 1, nil
+	}
+
+	// the graphql handler answers with the operation it received, or with an
+	// error for persisted queries sent without their query
+	calls := 0
+	graphql := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		query := r.URL.Query().Get("query")
+		if r.Method != http.MethodGet {
+			body, _ := ioutil.ReadAll(r.Body)
+			query = string(body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(query, "notfound") {
+			_, _ = fmt.Fprint(w, `{"errors":[{"message":"PersistedQueryNotFound"}],"data":null}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"calls":%d}}`, calls)
+	})
+	handler := cache.CachingMiddleware(getSpace)(cache.RespMiddleware(getSpace)(graphql))
+
+	do := func(req *http.Request) int {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		return calls
+	}
+
+	post := func(body string) *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(body))
+	}
+
+	persisted := func(operation, hash string) string {
+		return `{"operationName":"` + operation + `","extensions":{"persistedQuery":{"version":1,"sha256Hash":"` + hash + `"}}}`
+	}
+
+	t.Run("hash-only requests of different queries are cached apart", func(t *testing.T) {
+		calls = 0
+		do(post(persisted("Posts", "aaaa")))
+		do(post(persisted("Tags", "bbbb")))
+		if calls != 2 {
+			t.Fatalf("expected both queries to reach the handler, got %d calls", calls)
+		}
+
+		do(post(persisted("Posts", "aaaa")))
+		if calls != 2 {
+			t.Fatalf("expected the repeated query to be served from the cache, got %d calls", calls)
+		}
+	})
+
+	t.Run("responses with errors are not cached", func(t *testing.T) {
+		calls = 0
+		do(post(persisted("notfound", "cccc")))
+		do(post(persisted("notfound", "cccc")))
+		if calls != 2 {
+			t.Fatalf("expected the failed query to reach the handler again, got %d calls", calls)
+		}
+	})
+
+	t.Run("get requests are cached by their query parameters", func(t *testing.T) {
+		calls = 0
+		get := func(query string) *http.Request {
+			params := url.Values{}
+			params.Set("query", query)
+			params.Set("variables", `{"id":1}`)
+			return httptest.NewRequest(http.MethodGet, "/query?"+params.Encode(), nil)
+		}
+
+		do(get("{ posts { total } }"))
+		do(get("{ tags { total } }"))
+		do(get("{ posts { total } }"))
+		if calls != 2 {
+			t.Fatalf("expected 2 calls to the handler, got %d", calls)
+		}
+	})
+
+	t.Run("get requests with invalid variables are left to the handler", func(t *testing.T) {
+		calls = 0
+		do(httptest.NewRequest(http.MethodGet, "/query?query=%7Bposts%7D&variables=invalid", nil))
+		if calls != 1 {
+			t.Fatalf("expected the handler to be called, got %d calls", calls)
+		}
+	})
+}
+
+// fakeRedis is an in memory server of the redis commands used by the cache
+type fakeRedis struct {
+	t        *testing.T
+	listener net.Listener
+	mu       sync.Mutex
+	values   map[string]string
+	sets     map[string]map[string]bool
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	redis := &fakeRedis{t: t, listener: listener, values: map[string]string{}, sets: map[string]map[string]bool{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go redis.serve(conn)
+		}
+	}()
+	return redis
+}
+
+func (f *fakeRedis) Addr() string {
+	return f.listener.Addr().String()
+}
+
+func (f *fakeRedis) Close() {
+	_ = f.listener.Close()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	var queued []string
+	multi := false
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "MULTI":
+			multi, queued, reply = true, nil, "+OK\r\n"
+		case cmd == "EXEC":
+			reply = fmt.Sprintf("*%d\r\n%s", len(queued), strings.Join(queued, ""))
+			multi = false
+		case multi:
+			queued = append(queued, f.execute(args))
+			reply = "+QUEUED\r\n"
+		default:
+			reply = f.execute(args)
+		}
+
+		if _, err = conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) execute(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "GET":
+		value, ok := f.values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "SET":
+		f.values[args[1]] = args[2]
+		return "+OK\r\n"
+	case "SADD":
+		if f.sets[args[1]] == nil {
+			f.sets[args[1]] = map[string]bool{}
+		}
+		for _, member := range args[2:] {
+			f.sets[args[1]][member] = true
+		}
+		return ":1\r\n"
+	case "EXPIRE":
+		return ":1\r\n"
+	}
+
+	f.t.Errorf("unexpected redis command %v", args)
+	return "-ERR unknown command\r\n"
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+	for i := range args {
+		if line, err = reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
@@ -0,0 +1,230 @@
+package test
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var mediumColumns = []string{"id", "created_at", "updated_at", "deleted_at", "name", "slug", "type", "title", "description", "caption", "alt_text", "file_size", "url", "dimensions", "meta_fields", "space_id"}
+
+func cursor(id string) string {
+	return base64.StdEncoding.EncodeToString([]byte("cursor:" + id))
+}
+
+func tagRows(ids ...int) *sqlmock.Rows {
+	rows := sqlmock.NewRows(tagColumns)
+	for _, id := range ids {
+		rows.AddRow(id, time.Now(), time.Now(), nil, 1, 1, tagData["name"], tagData["slug"], tagData["description"], tagData["html_description"], tagData["is_featured"], tagData["meta_fields"], 1)
+	}
+	return rows
+}
+
+func TestConnections(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	KavachMockServer()
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get first page of tags", func(t *testing.T) {
+		CheckSpaceMock(mock)
+		TagCountMock(mock, 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE "tags"."space_id" = $1 AND "tags"."deleted_at" IS NULL AND tags.id > $2 ORDER BY tags.id asc LIMIT 2`)).
+			WithArgs(1, 0).
+			WillReturnRows(tagRows(1, 2))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				tags(first: 1) {
+					edges {
+						cursor
+						node {
+							id
+						}
+					}
+					pageInfo {
+						hasNextPage
+						hasPreviousPage
+						endCursor
+					}
+					total
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"edges": []map[string]interface{}{
+				{"cursor": cursor("1"), "node": map[string]interface{}{"id": "1"}},
+			},
+			"pageInfo": map[string]interface{}{
+				"hasNextPage":     true,
+				"hasPreviousPage": false,
+				"endCursor":       cursor("1"),
+			},
+			"total": 2,
+		}, "tags")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get tags after a cursor", func(t *testing.T) {
+		CheckSpaceMock(mock)
+		TagCountMock(mock, 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE "tags"."space_id" = $1 AND "tags"."deleted_at" IS NULL AND tags.id > $2 ORDER BY tags.id asc LIMIT 2`)).
+			WithArgs(1, 1).
+			WillReturnRows(tagRows(2))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `query($after: String) {
+				tags(first: 1, after: $after) {
+					nodes {
+						id
+					}
+					pageInfo {
+						hasNextPage
+						hasPreviousPage
+						startCursor
+					}
+				}
+			}`,
+				Variables: map[string]interface{}{"after": cursor("1")},
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{"id": "2"},
+			},
+			"pageInfo": map[string]interface{}{
+				"hasNextPage":     false,
+				"hasPreviousPage": true,
+				"startCursor":     cursor("2"),
+			},
+		}, "tags")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				tags(after: "invalid") {
+					nodes {
+						id
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			Value("message").
+			Equal("invalid cursor")
+
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get media updated since a time", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "media" WHERE "media"."space_id" = $1 AND media.updated_at > $2`)).
+			WithArgs(1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media" WHERE "media"."space_id" = $1 AND media.updated_at > $2`)).
+			WithArgs(1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(mediumColumns).
+				AddRow(1, time.Now(), time.Now(), nil, "image", "image", "image/png", "Image", "", "", "", 100, nil, "100x100", nil, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "media" WHERE space_id = $1 AND deleted_at > $2`)).
+			WithArgs(1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				media(updatedSince: "2021-05-01T00:00:00Z") {
+					nodes {
+						id
+						name
+					}
+					deleted_ids
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{"id": "1", "name": "image"},
+			},
+			"deleted_ids": []string{"5"},
+		}, "media")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get posts removed since a time", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts" WHERE is_page = $1 AND status = $2 AND "posts"."space_id" = $3 AND posts.updated_at > $4`)).
+			WithArgs(false, "publish", 1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT posts.* FROM "posts" WHERE is_page = $1 AND status = $2 AND "posts"."space_id" = $3 AND posts.updated_at > $4`)).
+			WithArgs(false, "publish", 1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(postColumns))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "posts" WHERE "posts"."space_id" = $1 AND is_page = $2 AND (deleted_at > $3 OR (updated_at > $4 AND status <> $5))`)).
+			WithArgs(1, false, sqlmock.AnyArg(), sqlmock.AnyArg(), "publish").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				posts(updatedSince: "2021-05-01T00:00:00Z") {
+					nodes {
+						id
+					}
+					deleted_ids
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes":       []map[string]interface{}{},
+			"deleted_ids": []string{"3", "4"},
+		}, "posts")
+		ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,257 @@
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-api/graph/extensions"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestQueryLimits(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	KavachMockServer()
+
+	viper.Set("query_complexity_limit", 100)
+	viper.Set("query_depth_limit", 3)
+	defer viper.Set("query_complexity_limit", 5000)
+	defer viper.Set("query_depth_limit", 10)
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("query above the complexity limit", func(t *testing.T) {
+		CheckSpaceMock(mock)
+		rejected := testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonComplexity))
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				posts(page: 1, limit: 50) {
+					nodes {
+						id
+						title
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"message":    "operation has complexity 151, which exceeds the limit of 100",
+				"extensions": map[string]interface{}{"code": "COMPLEXITY_LIMIT_EXCEEDED"},
+			})
+
+		if testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonComplexity)) != rejected+1 {
+			t.Error("rejected query is not counted")
+		}
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("query above the depth limit", func(t *testing.T) {
+		CheckSpaceMock(mock)
+		rejected := testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonDepth))
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				posts(page: 1, limit: 1) {
+					nodes {
+						...postCategories
+					}
+				}
+			}
+			fragment postCategories on Post {
+				categories {
+					id
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"message":    "operation has depth 4, which exceeds the limit of 3",
+				"extensions": map[string]interface{}{"code": "DEPTH_LIMIT_EXCEEDED"},
+			})
+
+		if testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonDepth)) != rejected+1 {
+			t.Error("rejected query is not counted")
+		}
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("introspection is not limited by depth", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				__schema {
+					types {
+						fields {
+							type {
+								name
+							}
+						}
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object().
+			NotContainsKey("errors")
+
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("persisted query not found", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{
+				"extensions": map[string]interface{}{
+					"persistedQuery": map[string]interface{}{
+						"version":    1,
+						"sha256Hash": queryHash(`{ space { id } }`),
+					},
+				},
+			}).Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			Value("message").
+			Equal("PersistedQueryNotFound")
+
+		ExpectationsMet(t, mock)
+	})
+}
+
+func TestQueryAllowList(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	KavachMockServer()
+
+	viper.Set("enable_query_allowlist", true)
+	defer viper.Set("enable_query_allowlist", false)
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	query := `{ __typename }`
+	countQuery := regexp.QuoteMeta(`SELECT count(*) FROM "persisted_queries"`)
+
+	t.Run("query in the persisted queries", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(queryHash(query), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{Query: query}).
+			Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, "Query", "__typename")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("organisation without persisted queries", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(queryHash(query), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(countQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{Query: query}).
+			Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, "Query", "__typename")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("query missing from the persisted queries", func(t *testing.T) {
+		CheckSpaceMock(mock)
+		rejected := testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonNotAllowed))
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(queryHash(query), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(countQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{Query: query}).
+			Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"message":    "query is not in the persisted queries of the organisation",
+				"extensions": map[string]interface{}{"code": "PERSISTED_QUERY_NOT_ALLOWED"},
+			})
+
+		if testutil.ToFloat64(extensions.RejectedQueries.WithLabelValues(extensions.ReasonNotAllowed)) != rejected+1 {
+			t.Error("rejected query is not counted")
+		}
+		ExpectationsMet(t, mock)
+	})
+}
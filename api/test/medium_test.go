@@ -0,0 +1,112 @@
+package test
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var uploadedURL = postgres.Jsonb{RawMessage: []byte(`{
+	"raw": "http://localhost:7789/uploads/1/2021/4/1617039625_photo.jpg",
+	"mime_type": "image/jpeg",
+	"width": 700,
+	"height": 350,
+	"blurhash": "LEHV6nWB2yk8pyo0adR*.7kCMdnj",
+	"webp": "http://localhost:7789/uploads/1/2021/4/1617039625_photo.webp",
+	"renditions": [
+		{"width": 320, "height": 160, "url": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-320w.jpg", "webp": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-320w.webp"},
+		{"width": 640, "height": 320, "url": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-640w.jpg", "webp": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-640w.webp"}
+	],
+	"exif": {"Make": "Canon", "FNumber": 2.8}
+}`)}
+
+func TestMedia(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	KavachMockServer()
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get processed fields of uploaded and older media", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "media" WHERE "media"."space_id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media" WHERE "media"."space_id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(mediumColumns).
+				AddRow(1, time.Now(), time.Now(), nil, "photo", "photo", "image/jpeg", "Photo", "", "", "", 100, uploadedURL, "700x350", nil, 1).
+				AddRow(2, time.Now(), time.Now(), nil, "logo", "logo", "png", "Logo", "", "", "", 100, postgres.Jsonb{RawMessage: []byte(`{"raw":"http://example.com/logo.png"}`)}, "200x100", nil, 1))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				media {
+					nodes {
+						id
+						mime_type
+						width
+						height
+						blurhash
+						webp
+						renditions {
+							width
+							height
+							url
+							webp
+						}
+						exif
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{
+					"id":        "1",
+					"mime_type": "image/jpeg",
+					"width":     700,
+					"height":    350,
+					"blurhash":  "LEHV6nWB2yk8pyo0adR*.7kCMdnj",
+					"webp":      "http://localhost:7789/uploads/1/2021/4/1617039625_photo.webp",
+					"renditions": []map[string]interface{}{
+						{"width": 320, "height": 160, "url": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-320w.jpg", "webp": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-320w.webp"},
+						{"width": 640, "height": 320, "url": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-640w.jpg", "webp": "http://localhost:7789/uploads/1/2021/4/1617039625_photo-640w.webp"},
+					},
+					"exif": map[string]interface{}{"Make": "Canon", "FNumber": 2.8},
+				},
+				{
+					"id":         "2",
+					"mime_type":  nil,
+					"width":      200,
+					"height":     100,
+					"blurhash":   nil,
+					"webp":       nil,
+					"renditions": []interface{}{},
+					"exif":       nil,
+				},
+			},
+		}, "media")
+		ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,318 @@
+package test
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var podcastData = map[string]interface{}{
+	"title":            "Fact Check Weekly",
+	"slug":             "fact-check-weekly",
+	"description":      postgres.Jsonb{RawMessage: []byte(`{"time":1617039625490,"blocks":[{"type":"paragraph","data":{"text":"Weekly podcast"}}],"version":"2.19.0"}`)},
+	"html_description": "<p>Weekly podcast</p>",
+	"language":         "english",
+}
+
+var podcastColumns = []string{"id", "created_at", "updated_at", "deleted_at", "title", "slug", "description", "html_description", "language", "primary_category_id", "medium_id", "header_code", "footer_code", "meta_fields", "meta", "space_id"}
+
+var episodeData = map[string]interface{}{
+	"title":            "Episode One",
+	"slug":             "episode-one",
+	"season":           1,
+	"episode":          1,
+	"audio_url":        "https://example.com/episode-one.mp3",
+	"description":      postgres.Jsonb{RawMessage: []byte(`{"time":1617039625490,"blocks":[{"type":"paragraph","data":{"text":"First episode"}}],"version":"2.19.0"}`)},
+	"html_description": "<p>First episode</p>",
+	"status":           "publish",
+}
+
+var episodeColumns = []string{"id", "created_at", "updated_at", "deleted_at", "title", "slug", "season", "episode", "audio_url", "podcast_id", "description", "html_description", "status", "published_date", "medium_id", "meta_fields", "meta", "header_code", "footer_code", "space_id"}
+
+func podcastRows() *sqlmock.Rows {
+	return sqlmock.NewRows(podcastColumns).
+		AddRow(1, time.Now(), time.Now(), nil, podcastData["title"], podcastData["slug"], podcastData["description"], podcastData["html_description"], podcastData["language"], 1, nil, "", "", nil, nil, 1)
+}
+
+func episodeRows() *sqlmock.Rows {
+	return sqlmock.NewRows(episodeColumns).
+		AddRow(1, time.Now(), time.Now(), nil, episodeData["title"], episodeData["slug"], episodeData["season"], episodeData["episode"], episodeData["audio_url"], 1, episodeData["description"], episodeData["html_description"], episodeData["status"], time.Now(), nil, nil, nil, "", "", 1)
+}
+
+// legacyEpisodeRows returns an episode published before episodes had a
+// status, as backfilled by dega-server
+func legacyEpisodeRows() *sqlmock.Rows {
+	return sqlmock.NewRows(episodeColumns).
+		AddRow(2, time.Now(), time.Now(), nil, "Pilot", "pilot", 1, 0, "https://example.com/pilot.mp3", 1, episodeData["description"], "<p>Pilot</p>", "publish", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil, nil, "", "", 1)
+}
+
+func TestPodcasts(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	KavachMockServer()
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get list of podcasts with categories", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "podcasts"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT podcasts.* FROM "podcasts"`)).
+			WithArgs(1).
+			WillReturnRows(podcastRows())
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "podcast_categories" WHERE podcast_id IN ($1)`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"podcast_id", "category_id"}).AddRow(1, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(2, "Politics", "politics"))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				podcasts {
+					nodes {
+						id
+						title
+						categories {
+							id
+							name
+						}
+					}
+					total
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{"id": "1", "title": podcastData["title"], "categories": []map[string]interface{}{
+					{"id": "2", "name": "Politics"},
+				}},
+			},
+			"total": 1,
+		}, "podcasts")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get podcast by slug with its primary category", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "podcasts" WHERE "podcasts"."slug" = $1 AND "podcasts"."space_id" = $2`)).
+			WithArgs(podcastData["slug"], 1).
+			WillReturnRows(podcastRows())
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Elections", "elections"))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				podcast(slug: "fact-check-weekly") {
+					id
+					language
+					primary_category {
+						id
+						name
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"id":               "1",
+			"language":         "english",
+			"primary_category": map[string]interface{}{"id": "1", "name": "Elections"},
+		}, "podcast")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("podcast without id or slug", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				podcast {
+					id
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			Value("message").
+			Equal("please provide either id or slug")
+
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get published episodes of a podcast", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "episodes" WHERE "episodes"."space_id" = $1 AND status = $2 AND podcast_id = $3`)).
+			WithArgs(1, "publish", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE "episodes"."space_id" = $1 AND status = $2 AND podcast_id = $3`)).
+			WithArgs(1, "publish", 1).
+			WillReturnRows(episodeRows())
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "podcasts" WHERE "podcasts"."id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(podcastRows())
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				episodes(podcast: 1) {
+					nodes {
+						id
+						title
+						season
+						episode
+						audio_url
+						podcast {
+							slug
+						}
+					}
+					total
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{
+					"id":        "1",
+					"title":     episodeData["title"],
+					"season":    1,
+					"episode":   1,
+					"audio_url": episodeData["audio_url"],
+					"podcast":   map[string]interface{}{"slug": podcastData["slug"]},
+				},
+			},
+			"total": 1,
+		}, "episodes")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get episode by id", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE "episodes"."id" = $1 AND "episodes"."space_id" = $2 AND status = $3`)).
+			WithArgs(1, 1, "publish").
+			WillReturnRows(episodeRows())
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				episode(id: 1) {
+					id
+					slug
+					html_description
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"id":               "1",
+			"slug":             episodeData["slug"],
+			"html_description": episodeData["html_description"],
+		}, "episode")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("get legacy episode published before statuses", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "episodes" WHERE "episodes"."space_id" = $1 AND status = $2`)).
+			WithArgs(1, "publish").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE "episodes"."space_id" = $1 AND status = $2`)).
+			WithArgs(1, "publish").
+			WillReturnRows(legacyEpisodeRows())
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				episodes {
+					nodes {
+						id
+						slug
+						published_date
+					}
+					total
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{"id": "2", "slug": "pilot", "published_date": "2020-01-01T00:00:00Z"},
+			},
+			"total": 1,
+		}, "episodes")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("draft episode is not found", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE "episodes"."slug" = $1 AND "episodes"."space_id" = $2 AND status = $3`)).
+			WithArgs("draft-episode", 1, "publish").
+			WillReturnRows(sqlmock.NewRows(episodeColumns))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				episode(slug: "draft-episode") {
+					id
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, nil, "episode")
+		ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,197 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/x/meilisearchx"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const meiliURL = "http://meili.test"
+
+func TestSearch(t *testing.T) {
+	// Setup Mock DB
+	mock := SetupMockDB()
+	viper.Set("search_backend", "postgres")
+	defer viper.Set("search_backend", "meilisearch")
+
+	KavachMockServer()
+
+	// Start test server
+	testServer := httptest.NewServer(TestRouter())
+	defer testServer.Close()
+
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer gock.Off()
+
+	// Setup httpexpect
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("search posts of the space with highlights and facets", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "search_documents" WHERE space_id = $1 AND (kind NOT IN ($2,$3,$4,$5) OR data->>'status' = $6) AND kind IN ($7,$8) AND (EXISTS (SELECT 1 FROM jsonb_array_elements_text(CASE jsonb_typeof(data->'category_ids')`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT kind, entity_id, ts_headline(language::regconfig`)).
+			WillReturnRows(sqlmock.NewRows([]string{"kind", "entity_id", "title", "name", "subtitle", "excerpt", "claim", "fact", "description"}).
+				AddRow("post", "1", "<em>Election</em> results", "", "", "", "", "", "").
+				AddRow("page", "2", "About", "", "", "The <em>election</em> desk", "", "", ""))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT kind AS value, count(*) AS count FROM "search_documents"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("post", 2).AddRow("page", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT ids.value AS value, count(*) AS count FROM search_documents, jsonb_array_elements_text(CASE jsonb_typeof(data->'category_ids')`)).
+			WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("1", 3))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT ids.value AS value, count(*) AS count FROM search_documents, jsonb_array_elements_text(CASE jsonb_typeof(data->'tag_ids')`)).
+			WillReturnRows(sqlmock.NewRows([]string{"value", "count"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT data->>'format_id' AS value, count(*) AS count FROM "search_documents"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("1", 2))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts" WHERE space_id = $1 AND "posts"."id" IN ($2,$3)`)).
+			WithArgs(1, 1, 2).
+			WillReturnRows(sqlmock.NewRows(postColumns).
+				AddRow(1, time.Now(), time.Now(), nil, 1, 1, postData["title"], postData["subtitle"], postData["slug"], postData["status"], postData["page"], postData["excerpt"], postData["description"], postData["html_description"], postData["is_featured"], postData["is_sticky"], postData["is_highlighted"], postData["featured_medium_id"], postData["format_id"], postData["published_date"], postData["schemas"], postData["meta"], postData["header_code"], postData["footer_code"], postData["meta_fields"], 1))
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				search(q: "election", kinds: ["post", "page"], categories: [1], page: 1, limit: 2) {
+					total
+					hits {
+						id
+						kind
+						highlights {
+							field
+							snippet
+						}
+					}
+					facets {
+						kinds {
+							value
+							count
+						}
+						formats {
+							value
+							count
+						}
+					}
+					posts {
+						id
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"total": 3,
+			"hits": []map[string]interface{}{
+				{"id": 1, "kind": "post", "highlights": []map[string]interface{}{
+					{"field": "title", "snippet": "<em>Election</em> results"},
+				}},
+				{"id": 2, "kind": "page", "highlights": []map[string]interface{}{
+					{"field": "excerpt", "snippet": "The <em>election</em> desk"},
+				}},
+			},
+			"facets": map[string]interface{}{
+				"kinds": []map[string]interface{}{
+					{"value": "post", "count": 2},
+					{"value": "page", "count": 1},
+				},
+				"formats": []map[string]interface{}{
+					{"value": "1", "count": 2},
+				},
+			},
+			"posts": []map[string]interface{}{
+				{"id": "1"},
+			},
+		}, "search")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("draft posts do not match", func(t *testing.T) {
+		CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "search_documents" WHERE space_id = $1 AND (kind NOT IN ($2,$3,$4,$5) OR data->>'status' = $6) AND document @@`)).
+			WithArgs(1, "post", "page", "video", "episode", "publish", sqlmock.AnyArg(), "draft", "draft").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT kind, entity_id, ts_headline(language::regconfig`)).
+			WillReturnRows(sqlmock.NewRows([]string{"kind", "entity_id", "title", "name", "subtitle", "excerpt", "claim", "fact", "description"}))
+		for i := 0; i < 4; i++ {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
+				WillReturnRows(sqlmock.NewRows([]string{"value", "count"}))
+		}
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				search(q: "draft") {
+					total
+					hits {
+						id
+					}
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"total": 0,
+			"hits":  []map[string]interface{}{},
+		}, "search")
+		ExpectationsMet(t, mock)
+	})
+
+	t.Run("meilisearch searches only published posts", func(t *testing.T) {
+		viper.Set("search_backend", "meilisearch")
+		defer viper.Set("search_backend", "postgres")
+		meilisearchx.Client = meilisearch.NewClient(meilisearch.Config{Host: meiliURL})
+
+		CheckSpaceMock(mock)
+
+		gock.New(meiliURL).
+			Post("/indexes/dega/search").
+			AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+				body := map[string]interface{}{}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return false, err
+				}
+				filters, _ := body["filters"].(string)
+				return strings.Contains(filters, "(status = publish OR (kind != post AND kind != page AND kind != video AND kind != episode))"), nil
+			}).
+			Reply(http.StatusOK).
+			JSON(map[string]interface{}{"hits": []interface{}{}, "nbHits": 0})
+
+		resp := e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Query{
+				Query: `{
+				search(q: "draft") {
+					total
+				}
+			}`,
+			}).Expect().
+			JSON().
+			Object()
+
+		CheckJSON(resp, map[string]interface{}{
+			"total": 0,
+		}, "search")
+		ExpectationsMet(t, mock)
+	})
+}
@@ -7,9 +7,9 @@ import (
 	"testing"
 	"time"
 
-	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/factly/dega-api/config"
+	"github.com/factly/dega-api/graph/extensions"
 	"github.com/factly/dega-api/graph/generated"
 	"github.com/factly/dega-api/graph/loaders"
 	"github.com/factly/dega-api/graph/resolvers"
@@ -55,7 +55,7 @@ func TestRouter() http.Handler {
 	router.Use(validator.CheckSpace())
 	router.Use(middleware.RealIP)
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{}}))
+	srv := extensions.NewServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{}, Complexity: resolvers.Complexity()}))
 
 	router.With(validator.CheckSpace(), validator.CheckOrganisation(), middlewarex.ValidateAPIToken("X-Dega-API-Key", "dega", validator.GetOrganisation)).Handle("/query", loaders.DataloaderMiddleware(srv))
 
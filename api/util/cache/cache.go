@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
@@ -51,15 +52,57 @@ func (c *Cache) Get(ctx context.Context, key string) ([]byte, error) {
 	}
 }
 
-func SaveToCache(ctx context.Context, queryStr string, data interface{}) error {
-	// hash query
+// SetWithTags saves the entry of a space and adds it to the sets of its tags
+// so that it can be evicted with Invalidate
+func (c *Cache) SetWithTags(ctx context.Context, spaceID uint, key string, value interface{}, tags []string) error {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.Set(AppPrefix+key, bytes, c.ttl)
+	for _, tag := range append(tags, allTag) {
+		set := tagKey(spaceID, tag)
+		pipe.SAdd(set, AppPrefix+key)
+		pipe.Expire(set, c.ttl)
+	}
+	_, err = pipe.Exec()
+	return err
+}
+
+// Invalidate deletes the entries of a space having any of the tags
+func (c *Cache) Invalidate(spaceID uint, tags ...string) error {
+	for _, tag := range tags {
+		set := tagKey(spaceID, tag)
+		keys, err := c.client.SMembers(set).Result()
+		if err != nil {
+			return err
+		}
+
+		if err = c.client.Del(append(keys, set)...).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Purge deletes all the entries of a space
+func (c *Cache) Purge(spaceID uint) error {
+	return c.Invalidate(spaceID, allTag)
+}
+
+// Key returns the cache key of a query with its variables in a space
+func Key(spaceID uint, queryStr string) string {
 	h := md5.New()
-	_, _ = io.WriteString(h, queryStr)
-	hash := hex.EncodeToString(h.Sum(nil))
+	_, _ = io.WriteString(h, fmt.Sprint(spaceID, ":", queryStr))
+	return hex.EncodeToString(h.Sum(nil))
+}
 
-	err := GlobalCache.Set(ctx, hash, data)
+func SaveToCache(ctx context.Context, spaceID uint, queryStr string, data interface{}) error {
+	err := GlobalCache.SetWithTags(ctx, spaceID, Key(spaceID, queryStr), data, Tags(data))
 	if err != nil {
-		return nil
+		return err
 	}
 	return nil
 }
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+// eventKinds maps the subjects of the events published by dega-server to the
+// kind of entity they are about
+var eventKinds = map[string]string{
+	"post":     "post",
+	"page":     "post",
+	"category": "category",
+	"tag":      "tag",
+	"format":   "format",
+	"media":    "medium",
+	"menu":     "menu",
+	"space":    "space",
+	"claim":    "claim",
+	"claimant": "claimant",
+	"rating":   "rating",
+	"video":    "video",
+	"podcast":  "podcast",
+	"episode":  "episode",
+}
+
+// SubscribeEvents connects to nats and evicts the entries affected by every
+// event dega-server publishes about the entities of a space
+func SubscribeEvents(url, user, password string) (*nats.Conn, error) {
+	nc, err := nats.Connect(url, nats.UserInfo(user, password))
+	if err != nil {
+		return nil, err
+	}
+
+	for subject := range eventKinds {
+		_, err = nc.Subscribe(subject+".*", func(m *nats.Msg) {
+			spaceID, tags, ok := EventTags(m.Subject, m.Data)
+			if !ok {
+				return
+			}
+			if err := GlobalCache.Invalidate(spaceID, tags...); err != nil {
+				log.Println(err)
+			}
+		})
+		if err != nil {
+			nc.Close()
+			return nil, err
+		}
+	}
+
+	return nc, nil
+}
+
+// EventTags returns the space and the tags of the entries affected by an
+// event, an event about a space affects all of its entries
+func EventTags(subject string, payload []byte) (uint, []string, bool) {
+	parts := strings.Split(subject, ".")
+	kind, found := eventKinds[parts[0]]
+	if !found || len(parts) != 2 {
+		return 0, nil, false
+	}
+
+	entity := struct {
+		ID      uint `json:"id"`
+		SpaceID uint `json:"space_id"`
+	}{}
+	if err := json.Unmarshal(payload, &entity); err != nil {
+		return 0, nil, false
+	}
+
+	if kind == "space" {
+		if entity.ID == 0 {
+			return 0, nil, false
+		}
+		return entity.ID, []string{allTag}, true
+	}
+
+	if entity.SpaceID == 0 {
+		return 0, nil, false
+	}
+
+	tags := []string{ListTag(kind)}
+	if entity.ID != 0 {
+		tags = append(tags, EntityTag(kind, entity.ID))
+	}
+	return entity.SpaceID, tags, true
+}
+
+// PurgeTokenHeader is the header carrying the secret shared with the
+// services allowed to purge the cache
+const PurgeTokenHeader = "X-Dega-Purge-Token"
+
+// PurgeHandler deletes all the cached entries of the space of the request.
+// The request must carry the cache_purge_token secret, purging is disabled
+// when it is not set.
+func PurgeHandler(getSpace func(ctx context.Context) (uint, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := viper.GetString("cache_purge_token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get(PurgeTokenHeader)), []byte(token)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		sID, err := getSpace(r.Context())
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err = GlobalCache.Purge(sID); err != nil {
+			log.Println("cannot purge cache of space", sID, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -2,11 +2,9 @@ package cache
 
 import (
 	"bytes"
-	"crypto/md5"
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -19,41 +17,72 @@ type requestBody struct {
 	OperationName string      `json:"operationName"`
 	Query         string      `json:"query"`
 	Variables     interface{} `json:"variables"`
+	Extensions    struct {
+		PersistedQuery struct {
+			Sha256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
 }
 
-func CachingMiddleware() func(http.Handler) http.Handler {
+// parseRequest reads the graphql request from the query parameters of GET
+// requests and from the body of the others, which is restored for the handler
+func parseRequest(r *http.Request) (requestBody, error) {
+	body := requestBody{}
+
+	if r.Method == http.MethodGet {
+		params := r.URL.Query()
+		body.OperationName = params.Get("operationName")
+		body.Query = params.Get("query")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &body.Variables); err != nil {
+				return body, err
+			}
+		}
+		if extensions := params.Get("extensions"); extensions != "" {
+			if err := json.Unmarshal([]byte(extensions), &body.Extensions); err != nil {
+				return body, err
+			}
+		}
+		return body, nil
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+
+	err := json.Unmarshal(bodyBytes, &body)
+	return body, err
+}
+
+// key returns the part of the cache key identifying the request, which
+// includes the hash of persisted queries sent without their query
+func (body requestBody) key() string {
+	queryStr := strings.ReplaceAll(body.Query, "\n", "")
+	queryStr = strings.ReplaceAll(queryStr, " ", "")
+
+	varBytes, _ := json.Marshal(body.Variables)
+
+	return fmt.Sprint(body.OperationName, ":", body.Extensions.PersistedQuery.Sha256Hash, ":", queryStr, string(varBytes))
+}
+
+func CachingMiddleware(getSpace func(ctx context.Context) (uint, error)) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log.Println(" CachingMiddleware entry")
-			body := requestBody{}
-			bodyBytes, _ := ioutil.ReadAll(r.Body)
-			err := json.Unmarshal(bodyBytes, &body)
-			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
+			// requests which cannot be parsed are left to the graphql handler
+			body, err := parseRequest(r)
+			if err != nil || body.OperationName == "IntrospectionQuery" {
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			if body.OperationName == "IntrospectionQuery" {
-				r.Body.Close()
-				r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
-				next.ServeHTTP(w, r)
+			sID, err := getSpace(r.Context())
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
 
-			// get query string from the request body
-			queryString := body.Query
-			queryStr := strings.ReplaceAll(queryString, "\n", "")
-			queryStr = strings.ReplaceAll(queryStr, " ", "")
-
-			varBytes, _ := json.Marshal(body.Variables)
-			varString := string(varBytes)
-
-			// hash query
-			h := md5.New()
-			_, _ = io.WriteString(h, fmt.Sprint(queryStr, varString))
-			hash := hex.EncodeToString(h.Sum(nil))
-
-			respBodyBytes, err := GlobalCache.Get(r.Context(), hash)
+			respBodyBytes, err := GlobalCache.Get(r.Context(), Key(sID, body.key()))
 			if err == nil {
 				var data interface{}
 				_ = json.Unmarshal(respBodyBytes, &data)
@@ -61,9 +90,6 @@ func CachingMiddleware() func(http.Handler) http.Handler {
 				return
 			}
 
-			r.Body.Close()
-			r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
-
 			log.Println(" CachingMiddleware exit")
 			next.ServeHTTP(w, r)
 		})
@@ -2,18 +2,17 @@ package cache
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"net/http"
-	"strings"
 )
 
 type CacheResponseWriter struct {
 	http.ResponseWriter
-	buf *bytes.Buffer
+	buf    *bytes.Buffer
+	status int
 }
 
 // Here we are implementing a Write() function from ResponseWriter with our custom instructions.
@@ -22,51 +21,51 @@ func (myrw *CacheResponseWriter) Write(p []byte) (int, error) {
 }
 
 func (myrw *CacheResponseWriter) WriteHeader(header int) {
+	myrw.status = header
 	myrw.ResponseWriter.WriteHeader(header)
 }
 
-func RespMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Println(" RespMiddleware entry")
-		// Create a response writer:
-		crw := &CacheResponseWriter{
-			ResponseWriter: w,
-			buf:            &bytes.Buffer{},
-		}
+func RespMiddleware(getSpace func(ctx context.Context) (uint, error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log.Println(" RespMiddleware entry")
+			// Create a response writer:
+			crw := &CacheResponseWriter{
+				ResponseWriter: w,
+				buf:            &bytes.Buffer{},
+				status:         http.StatusOK,
+			}
 
-		body := requestBody{}
-		bodyBytes, _ := ioutil.ReadAll(r.Body)
-		err := json.Unmarshal(bodyBytes, &body)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		r.Body.Close()
-		r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+			body, err := parseRequest(r)
+			cacheable := err == nil && body.OperationName != "IntrospectionQuery"
 
-		next.ServeHTTP(crw, r)
+			next.ServeHTTP(crw, r)
 
-		queryString := body.Query
-		queryStr := strings.ReplaceAll(queryString, "\n", "")
-		queryStr = strings.ReplaceAll(queryStr, " ", "")
+			// only successful responses are cached, errors like a persisted
+			// query which is not known yet must not be served to later requests
+			var data map[string]interface{}
+			if err = json.Unmarshal(crw.buf.Bytes(), &data); err != nil || crw.status != http.StatusOK {
+				cacheable = false
+			}
+			if _, hasErrors := data["errors"]; hasErrors {
+				cacheable = false
+			}
 
-		varBytes, _ := json.Marshal(body.Variables)
-		varString := string(varBytes)
+			if cacheable {
+				sID, err := getSpace(r.Context())
+				if err == nil {
+					err = SaveToCache(r.Context(), sID, body.key(), data)
+				}
+				if err != nil {
+					log.Println(err.Error())
+				}
+			}
 
-		var data interface{}
-		saveBytes := crw.buf.Bytes()
+			if _, err = io.Copy(w, crw.buf); err != nil {
+				log.Printf("Failed to send out response: %v", err)
+			}
 
-		_ = json.Unmarshal(saveBytes, &data)
-
-		err = SaveToCache(r.Context(), fmt.Sprint(queryStr, varString), data)
-		if err != nil {
-			log.Println(err.Error())
-		}
-
-		if _, err = io.Copy(w, crw.buf); err != nil {
-			log.Printf("Failed to send out response: %v", err)
-		}
-
-		log.Println(" RespMiddleware exit")
-	})
+			log.Println(" RespMiddleware exit")
+		})
+	}
 }
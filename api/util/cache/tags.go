@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+)
+
+// every entry of a space is tagged with allTag so that the space can be purged
+const allTag = "all"
+
+// fieldKinds maps the fields of the schema to the kind of entity they hold
+var fieldKinds = map[string]string{
+	"space":              "space",
+	"menu":               "menu",
+	"posts":              "post",
+	"post":               "post",
+	"pages":              "post",
+	"page":               "post",
+	"categories":         "category",
+	"category":           "category",
+	"featuredCategories": "category",
+	"primary_category":   "category",
+	"tags":               "tag",
+	"tag":                "tag",
+	"featuredTags":       "tag",
+	"formats":            "format",
+	"format":             "format",
+	"medium":             "medium",
+	"media":              "medium",
+	"logo":               "medium",
+	"logo_mobile":        "medium",
+	"fav_icon":           "medium",
+	"mobile_icon":        "medium",
+	"users":              "user",
+	"user":               "user",
+	"ratings":            "rating",
+	"rating":             "rating",
+	"claimants":          "claimant",
+	"claimant":           "claimant",
+	"claims":             "claim",
+	"claim":              "claim",
+	"videos":             "video",
+	"video":              "video",
+	"podcasts":           "podcast",
+	"podcast":            "podcast",
+	"episodes":           "episode",
+	"episode":            "episode",
+}
+
+// queries whose results depend on every entity of some kinds
+var aggregateQueries = map[string][]string{
+	"sitemap": {"post", "category", "tag", "user", "format", "claim", "claimant", "rating"},
+	"search":  {"post", "category", "tag", "claim", "claimant", "rating", "medium"},
+}
+
+// EntityTag is the tag of the entries containing an entity
+func EntityTag(kind string, id interface{}) string {
+	return fmt.Sprint(kind, ":", id)
+}
+
+// ListTag is the tag of the entries listing the entities of a kind, which
+// change when an entity of the kind is added or removed
+func ListTag(kind string) string {
+	return fmt.Sprint("list:", kind)
+}
+
+func tagKey(spaceID uint, tag string) string {
+	return fmt.Sprint(AppPrefix, "tag:", spaceID, ":", tag)
+}
+
+// Tags returns the tags of a graphql response. Every entity in the response
+// is tagged with its kind and id. Top level lists, paged fields, entities
+// queried without their id and top level queries returning nothing are tagged
+// with the list tag of their kind. The nodes of paged fields and of their edges
+// are of the kind of the field.
+func Tags(response interface{}) []string {
+	tags := make(map[string]bool)
+
+	body, _ := response.(map[string]interface{})
+	data, _ := body["data"].(map[string]interface{})
+	for field, value := range data {
+		if kinds, found := aggregateQueries[field]; found {
+			for _, kind := range kinds {
+				tags[ListTag(kind)] = true
+			}
+		}
+
+		kind := fieldKinds[field]
+		if kind != "" {
+			switch value.(type) {
+			case nil, []interface{}:
+				tags[ListTag(kind)] = true
+			}
+		}
+		collectTags(tags, kind, value)
+	}
+
+	result := make([]string, 0)
+	for tag := range tags {
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func collectTags(tags map[string]bool, kind string, value interface{}) {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, each := range v {
+			collectTags(tags, kind, each)
+		}
+	case map[string]interface{}:
+		if kind != "" {
+			if id, found := v["id"]; found && id != nil {
+				tags[EntityTag(kind, id)] = true
+			} else {
+				tags[ListTag(kind)] = true
+			}
+		}
+		for field, child := range v {
+			if field == "nodes" || field == "edges" || field == "node" {
+				collectTags(tags, kind, child)
+				continue
+			}
+			collectTags(tags, fieldKinds[field], child)
+		}
+	}
+}
@@ -1,5 +1,13 @@
 package util
 
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // Parse pagination
 func Parse(page *int, perPage *int) (int, int) {
 	offset := 0 // no. of records to skip
@@ -19,3 +27,47 @@ func Parse(page *int, perPage *int) (int, int) {
 
 	return offset, limit
 }
+
+// cursors are opaque to the clients, they hold the id of an entity
+const cursorPrefix = "cursor:"
+
+// EncodeCursor returns the cursor of an entity
+func EncodeCursor(id uint) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(cursorPrefix, id)))
+}
+
+// DecodeCursor returns the id of the entity of a cursor
+func DecodeCursor(cursor string) (uint, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return 0, errors.New("invalid cursor")
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(string(decoded), cursorPrefix), 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid cursor")
+	}
+
+	return uint(id), nil
+}
+
+// ParseCursor returns the id after which the entities are listed and the
+// number of entities to list
+func ParseCursor(first *int, after *string) (uint, int, error) {
+	limit := 30
+
+	if first != nil && *first > 0 && *first <= 100 {
+		limit = *first
+	}
+
+	if after == nil {
+		return 0, limit, nil
+	}
+
+	id, err := DecodeCursor(*after)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, limit, nil
+}
@@ -0,0 +1,155 @@
+package permalink
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-api/graph/models"
+)
+
+// Patterns of the urls of the entities of a space, relative to its site
+// address, as set on the space in the server
+type Patterns struct {
+	Post     string `json:"post,omitempty"`
+	Page     string `json:"page,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Category string `json:"category,omitempty"`
+	Format   string `json:"format,omitempty"`
+	Author   string `json:"author,omitempty"`
+}
+
+// Default patterns, for the kinds of entities a space has no pattern for
+var Default = Patterns{
+	Post:     "/{slug}",
+	Page:     "/{slug}",
+	Tag:      "/tag/{slug}",
+	Category: "/category/{slug}",
+	Format:   "/format/{slug}",
+	Author:   "/author/{slug}",
+}
+
+var placeholder = regexp.MustCompile(`{([^{}/]*)}`)
+
+// Builder builds the urls of the entities of a space
+type Builder struct {
+	address  string
+	locale   string
+	patterns Patterns
+}
+
+// New returns the url builder of the space, its site address joined with its
+// patterns and the default ones
+func New(space models.Space) Builder {
+	patterns := Patterns{}
+	_ = json.Unmarshal(space.Permalinks.RawMessage, &patterns)
+	withDefault(&patterns.Post, Default.Post)
+	withDefault(&patterns.Page, Default.Page)
+	withDefault(&patterns.Tag, Default.Tag)
+	withDefault(&patterns.Category, Default.Category)
+	withDefault(&patterns.Format, Default.Format)
+	withDefault(&patterns.Author, Default.Author)
+
+	return Builder{
+		address:  strings.TrimSuffix(space.SiteAddress, "/"),
+		locale:   space.DefaultLocale,
+		patterns: patterns,
+	}
+}
+
+func withDefault(pattern *string, value string) {
+	if *pattern == "" {
+		*pattern = value
+	}
+}
+
+// Post returns the url of a post or a page, its format is only in the url
+// when it is loaded along with the post
+func (b Builder) Post(post models.Post) string {
+	pattern := b.patterns.Post
+	if post.IsPage {
+		pattern = b.patterns.Page
+	}
+
+	date := post.CreatedAt
+	if post.PublishedDate != nil {
+		date = *post.PublishedDate
+	}
+
+	format := ""
+	if post.Format != nil {
+		format = post.Format.Slug
+	}
+
+	return b.build(pattern, values(post.ID, post.Slug, b.localeOf(post.Locale)), dated(date), map[string]string{
+		"format": format,
+	})
+}
+
+// Tag returns the url of a tag
+func (b Builder) Tag(tag models.Tag) string {
+	return b.build(b.patterns.Tag, values(tag.ID, tag.Slug, b.localeOf(tag.Locale)))
+}
+
+// Category returns the url of a category
+func (b Builder) Category(category models.Category) string {
+	return b.build(b.patterns.Category, values(category.ID, category.Slug, b.localeOf(category.Locale)))
+}
+
+// Format returns the url of a format
+func (b Builder) Format(format models.Format) string {
+	return b.build(b.patterns.Format, values(format.ID, format.Slug, ""))
+}
+
+// Author returns the url of a user
+func (b Builder) Author(user models.User) string {
+	return b.build(b.patterns.Author, values(user.ID, user.Slug, ""))
+}
+
+func (b Builder) localeOf(locale string) string {
+	if locale == "" {
+		return b.locale
+	}
+	return locale
+}
+
+// build fills the placeholders of the pattern in, the path segments left
+// empty are dropped
+func (b Builder) build(pattern string, fields ...map[string]string) string {
+	path := placeholder.ReplaceAllStringFunc(pattern, func(match string) string {
+		name := match[1 : len(match)-1]
+		for _, each := range fields {
+			if value, found := each[name]; found {
+				return value
+			}
+		}
+		return ""
+	})
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return b.address + "/" + strings.Join(segments, "/")
+}
+
+func values(id uint, slug, locale string) map[string]string {
+	return map[string]string{
+		"id":     fmt.Sprint(id),
+		"slug":   slug,
+		"locale": locale,
+	}
+}
+
+func dated(date time.Time) map[string]string {
+	return map[string]string{
+		"yyyy": date.Format("2006"),
+		"mm":   date.Format("01"),
+		"dd":   date.Format("02"),
+	}
+}
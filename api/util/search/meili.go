@@ -0,0 +1,100 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/factly/x/meilisearchx"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func meiliSearch(query Query) (*Result, error) {
+	filters := []string{fmt.Sprint("space_id=", query.SpaceID)}
+
+	otherKinds := make([]string, 0)
+	for _, kind := range StatusKinds {
+		otherKinds = append(otherKinds, fmt.Sprint("kind != ", kind))
+	}
+	filters = append(filters, fmt.Sprint("(status = ", PublishedStatus, " OR (", strings.Join(otherKinds, " AND "), "))"))
+
+	for field, ids := range map[string][]int{"category_ids": query.Categories, "tag_ids": query.Tags, "format_id": query.Formats} {
+		if len(ids) == 0 {
+			continue
+		}
+		conditions := make([]string, 0)
+		for _, id := range ids {
+			conditions = append(conditions, fmt.Sprint(field, "=", id))
+		}
+		filters = append(filters, fmt.Sprint("(", strings.Join(conditions, " OR "), ")"))
+	}
+	if query.PublishedFrom != nil {
+		filters = append(filters, fmt.Sprint("published_date >= ", query.PublishedFrom.Unix()))
+	}
+	if query.PublishedTo != nil {
+		filters = append(filters, fmt.Sprint("published_date <= ", query.PublishedTo.Unix()))
+	}
+
+	request := meilisearch.SearchRequest{
+		Query:                 query.Text,
+		Offset:                int64(query.Offset),
+		Limit:                 int64(query.Limit),
+		Filters:               strings.Join(filters, " AND "),
+		AttributesToHighlight: highlightFields,
+		AttributesToCrop:      []string{"excerpt", "description", "claim", "fact"},
+		CropLength:            200,
+		FacetsDistribution:    FacetAttributes,
+		PlaceholderSearch:     query.Text == "",
+	}
+	if len(query.Kinds) > 0 {
+		kinds := make([]string, 0)
+		for _, kind := range query.Kinds {
+			kinds = append(kinds, "kind:"+kind)
+		}
+		request.FacetFilters = []interface{}{kinds}
+	}
+
+	response, err := meilisearchx.Client.Search(IndexName).Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newResult()
+	result.Total = response.NbHits
+
+	for _, each := range response.Hits {
+		hitMap, ok := each.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := hitMap["id"].(float64)
+		kind, _ := hitMap["kind"].(string)
+		hit := Hit{
+			ID:         uint(id),
+			Kind:       kind,
+			Highlights: make(map[string]string),
+		}
+
+		formatted, _ := hitMap["_formatted"].(map[string]interface{})
+		for _, field := range highlightFields {
+			if snippet, ok := formatted[field].(string); ok && strings.Contains(snippet, "<em>") {
+				hit.Highlights[field] = snippet
+			}
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+
+	distribution, _ := response.FacetsDistribution.(map[string]interface{})
+	for attribute, values := range distribution {
+		counts, _ := values.(map[string]interface{})
+		if _, found := result.Facets[attribute]; !found {
+			continue
+		}
+		for value, count := range counts {
+			if c, ok := count.(float64); ok && c > 0 {
+				result.Facets[attribute][value] = int64(c)
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/factly/dega-api/config"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tsQuery parses the text in both the search language and the simple
+// configuration as dega-server does when indexing
+const tsQuery = "(websearch_to_tsquery(?::regconfig, ?) || websearch_to_tsquery('simple', ?))"
+
+const headlineOptions = "StartSel=<em>, StopSel=</em>, MinWords=15, MaxWords=35"
+
+func postgresSearch(query Query) (*Result, error) {
+	result := newResult()
+	text := strings.TrimSpace(query.Text)
+	textArgs := []interface{}{config.SearchLanguage(), text, text}
+
+	filter := func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("space_id = ?", query.SpaceID)
+		tx = tx.Where("kind NOT IN (?) OR data->>'status' = ?", StatusKinds, PublishedStatus)
+		if len(query.Kinds) > 0 {
+			tx = tx.Where("kind IN (?)", query.Kinds)
+		}
+		if len(query.Categories) > 0 {
+			tx = tx.Where(fmt.Sprint("EXISTS (SELECT 1 FROM jsonb_array_elements_text(", arrayField("category_ids"), ") WHERE value IN (?))"), strIDs(query.Categories))
+		}
+		if len(query.Tags) > 0 {
+			tx = tx.Where(fmt.Sprint("EXISTS (SELECT 1 FROM jsonb_array_elements_text(", arrayField("tag_ids"), ") WHERE value IN (?))"), strIDs(query.Tags))
+		}
+		if len(query.Formats) > 0 {
+			tx = tx.Where("data->>'format_id' IN (?)", strIDs(query.Formats))
+		}
+		if query.PublishedFrom != nil {
+			tx = tx.Where("(CASE WHEN jsonb_typeof(data->'published_date') = 'number' THEN (data->>'published_date')::numeric >= ? ELSE false END)", query.PublishedFrom.Unix())
+		}
+		if query.PublishedTo != nil {
+			tx = tx.Where("(CASE WHEN jsonb_typeof(data->'published_date') = 'number' THEN (data->>'published_date')::numeric <= ? ELSE false END)", query.PublishedTo.Unix())
+		}
+		if text != "" {
+			tx = tx.Where(fmt.Sprint("document @@ ", tsQuery), textArgs...)
+		}
+		return tx
+	}
+
+	err := config.DB.Table("search_documents").Scopes(filter).Count(&result.Total).Error
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{"kind", "entity_id"}
+	columnArgs := make([]interface{}, 0)
+	if text != "" {
+		for _, field := range highlightFields {
+			columns = append(columns, fmt.Sprint("ts_headline(language::regconfig, CASE jsonb_typeof(data->'", field, "') WHEN 'string' THEN data->>'", field, "' ELSE '' END, ", tsQuery, ", '", headlineOptions, "')"))
+			columnArgs = append(columnArgs, textArgs...)
+		}
+	}
+
+	tx := config.DB.Table("search_documents").Select(strings.Join(columns, ", "), columnArgs...).Scopes(filter)
+	if text != "" {
+		tx = tx.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  fmt.Sprint("ts_rank_cd(document, ", tsQuery, ") DESC"),
+			Vars: textArgs,
+		}})
+	}
+	rows, err := tx.Order("updated_at DESC").Offset(query.Offset).Limit(query.Limit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var kind, entityID string
+		snippets := make([]string, len(columns)-2)
+		dest := []interface{}{&kind, &entityID}
+		for i := range snippets {
+			dest = append(dest, &snippets[i])
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		id, _ := strconv.ParseUint(entityID, 10, 64)
+		hit := Hit{
+			ID:         uint(id),
+			Kind:       kind,
+			Highlights: make(map[string]string),
+		}
+		for i, snippet := range snippets {
+			if strings.Contains(snippet, "<em>") {
+				hit.Highlights[highlightFields[i]] = snippet
+			}
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	facets := map[string]*gorm.DB{
+		"kind": config.DB.Table("search_documents").Select("kind AS value, count(*) AS count").
+			Group("kind"),
+		"category_ids": config.DB.Table(fmt.Sprint("search_documents, jsonb_array_elements_text(", arrayField("category_ids"), ") AS ids")).
+			Select("ids.value AS value, count(*) AS count").Group("ids.value"),
+		"tag_ids": config.DB.Table(fmt.Sprint("search_documents, jsonb_array_elements_text(", arrayField("tag_ids"), ") AS ids")).
+			Select("ids.value AS value, count(*) AS count").Group("ids.value"),
+		"format_id": config.DB.Table("search_documents").Select("data->>'format_id' AS value, count(*) AS count").
+			Where("jsonb_typeof(data->'format_id') = 'number' AND data->>'format_id' <> '0'").Group("data->>'format_id'"),
+	}
+	for _, attribute := range FacetAttributes {
+		counts := make([]struct {
+			Value string
+			Count int64
+		}, 0)
+		if err = facets[attribute].Scopes(filter).Scan(&counts).Error; err != nil {
+			return nil, err
+		}
+		for _, each := range counts {
+			result.Facets[attribute][each.Value] = each.Count
+		}
+	}
+
+	return result, nil
+}
+
+// arrayField returns the array in a field of the document data or an empty
+// array when the document does not have it
+func arrayField(field string) string {
+	return fmt.Sprint("CASE jsonb_typeof(data->'", field, "') WHEN 'array' THEN data->'", field, "' ELSE '[]'::jsonb END")
+}
+
+func strIDs(ids []int) []string {
+	result := make([]string, 0)
+	for _, id := range ids {
+		result = append(result, fmt.Sprint(id))
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+// Package search queries the documents indexed by dega-server with the backend
+// set by search_backend, either meilisearch or the full text search of postgres.
+package search
+
+import (
+	"time"
+
+	"github.com/factly/dega-api/config"
+	"github.com/factly/x/meilisearchx"
+)
+
+// IndexName is the name of the meilisearch index
+const IndexName = "dega"
+
+// SearchableAttributes of the documents
+var SearchableAttributes = []string{"space_id", "name", "slug", "description", "title", "subtitle", "excerpt", "claim", "fact", "site_title", "site_address", "tag_line", "review", "review_tag_line"}
+
+// FacetAttributes are the fields of the documents counted in facets
+var FacetAttributes = []string{"kind", "category_ids", "tag_ids", "format_id"}
+
+// StatusKinds are the kinds of documents having a status, only the published
+// ones are searched
+var StatusKinds = []string{"post", "page", "video", "episode"}
+
+// PublishedStatus is the status of the documents searched among StatusKinds
+const PublishedStatus = "publish"
+
+// fields of the documents returned as highlighted snippets
+var highlightFields = []string{"title", "name", "subtitle", "excerpt", "claim", "fact", "description"}
+
+// Query for a search of a space. Categories, tags and formats match documents
+// having any of the ids and the published dates are inclusive. Drafts and
+// scheduled documents never match.
+type Query struct {
+	Text          string
+	SpaceID       uint
+	Kinds         []string
+	Categories    []int
+	Tags          []int
+	Formats       []int
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time
+	Offset        int
+	Limit         int
+}
+
+// Hit is a document matching a search
+type Hit struct {
+	ID         uint
+	Kind       string
+	Highlights map[string]string
+}
+
+// Result of a search. Facets maps each facet attribute to the number of
+// matching documents for each of its values.
+type Result struct {
+	Total  int64
+	Hits   []Hit
+	Facets map[string]map[string]int64
+}
+
+// Setup prepares the meilisearch index, the postgres backend needs no setup
+// as dega-server creates the search_documents table
+func Setup() error {
+	if config.SearchBackend() == config.SearchPostgres {
+		return nil
+	}
+	err := meilisearchx.SetupMeiliSearch(IndexName, SearchableAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = meilisearchx.Client.Settings(IndexName).UpdateAttributesForFaceting(FacetAttributes)
+	return err
+}
+
+// Search returns a page of the documents matching the query ranked by
+// relevance with the total hits and facet counts
+func Search(query Query) (*Result, error) {
+	if config.SearchBackend() == config.SearchPostgres {
+		return postgresSearch(query)
+	}
+	return meiliSearch(query)
+}
+
+func newResult() *Result {
+	result := &Result{
+		Hits:   make([]Hit, 0),
+		Facets: make(map[string]map[string]int64),
+	}
+	for _, attribute := range FacetAttributes {
+		result.Facets[attribute] = make(map[string]int64)
+	}
+	return result
+}
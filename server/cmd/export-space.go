@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/space"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportSpace  int
+	exportUser   int
+	exportOutput string
+	exportFormat string
+)
+
+func init() {
+	exportSpaceCmd.Flags().IntVarP(&exportSpace, "space", "s", 0, "ID of the space to export")
+	exportSpaceCmd.Flags().IntVarP(&exportUser, "user", "u", 0, "ID of a user of the organisation, used to look up author emails")
+	exportSpaceCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "path of the archive, defaults to stdout")
+	exportSpaceCmd.Flags().StringVar(&exportFormat, "format", space.FormatJSON, "json or ndjson")
+	_ = exportSpaceCmd.MarkFlagRequired("space")
+
+	rootCmd.AddCommand(exportSpaceCmd)
+}
+
+var exportSpaceCmd = &cobra.Command{
+	Use:   "export-space",
+	Short: "Exports everything a space owns into a portable JSON or NDJSON archive.",
+	Run: func(cmd *cobra.Command, args []string) {
+		// db setup
+		config.SetupDB()
+
+		archive, err := space.Export(uint(exportSpace), exportUser)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		out := os.Stdout
+		if exportOutput != "" {
+			out, err = os.Create(exportOutput)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer out.Close()
+		}
+
+		if err = archive.Write(out, exportFormat); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/space"
+	"github.com/factly/x/middlewarex"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	importSpaceFile         string
+	importSpaceUser         int
+	importSpaceOrganisation int
+)
+
+func init() {
+	importSpaceCmd.Flags().StringVarP(&importSpaceFile, "file", "f", "", "path to the archive written by export-space")
+	importSpaceCmd.Flags().IntVarP(&importSpaceUser, "user", "u", 0, "ID of the user the space is imported as")
+	importSpaceCmd.Flags().IntVarP(&importSpaceOrganisation, "organisation", "o", 0, "ID of the organisation the space is created in")
+	_ = importSpaceCmd.MarkFlagRequired("file")
+	_ = importSpaceCmd.MarkFlagRequired("user")
+	_ = importSpaceCmd.MarkFlagRequired("organisation")
+
+	rootCmd.AddCommand(importSpaceCmd)
+}
+
+var importSpaceCmd = &cobra.Command{
+	Use:   "import-space",
+	Short: "Creates a new space in an organisation from an archive written by export-space.",
+	Run: func(cmd *cobra.Command, args []string) {
+		file, err := os.Open(importSpaceFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+
+		archive, err := space.ReadArchive(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// db setup
+		config.SetupDB()
+
+		var superOrgID int
+		if viper.GetBool("create_super_organisation") {
+			superOrgID, err = middlewarex.GetSuperOrganisationID("dega")
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		result, err := space.Import(archive, importSpaceOrganisation, importSpaceUser, superOrgID)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("imported space %s with id %d\n", result.Slug, result.ID)
+	},
+}
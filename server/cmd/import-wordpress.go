@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/wordpress"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importFile         string
+	importSpace        int
+	importUser         int
+	importOrganisation int
+)
+
+func init() {
+	importWordpressCmd.Flags().StringVarP(&importFile, "file", "f", "", "path to the WXR export")
+	importWordpressCmd.Flags().IntVarP(&importSpace, "space", "s", 0, "ID of the space to import into")
+	importWordpressCmd.Flags().IntVarP(&importUser, "user", "u", 0, "ID of the user the content is imported as")
+	importWordpressCmd.Flags().IntVarP(&importOrganisation, "organisation", "o", 0, "ID of the organisation of the space, used to match authors by email")
+	_ = importWordpressCmd.MarkFlagRequired("file")
+	_ = importWordpressCmd.MarkFlagRequired("space")
+	_ = importWordpressCmd.MarkFlagRequired("user")
+
+	rootCmd.AddCommand(importWordpressCmd)
+}
+
+var importWordpressCmd = &cobra.Command{
+	Use:   "import-wordpress",
+	Short: "Imports posts, pages, categories, tags and media from a WordPress WXR export into a space.",
+	Run: func(cmd *cobra.Command, args []string) {
+		file, err := os.Open(importFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+
+		// db setup
+		config.SetupDB()
+
+		job, err := wordpress.NewJob(context.Background(), importSpace, importUser, filepath.Base(importFile))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// the operators running the command can publish the imported posts
+		err = wordpress.Import(job, file, importOrganisation, importUser, true, func(job model.ImportJob) {
+			log.Printf("%s: %d/%d items processed\n", job.Status, job.Processed, job.Total)
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("imported %d posts, %d pages, %d categories, %d tags and %d media, skipped %d items\n", job.Posts, job.Pages, job.Categories, job.Tags, job.Media, job.Skipped)
+		log.Println("errors:", string(job.Errors.RawMessage))
+	},
+}
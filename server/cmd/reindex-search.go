@@ -2,34 +2,46 @@ package cmd
 
 import (
 	"errors"
+	"log"
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
+var reindexBackend string
+
 func init() {
+	reindexCommand.Flags().StringVarP(&reindexBackend, "backend", "b", "", "search backend to reindex, meilisearch or postgres; defaults to SEARCH_BACKEND")
 	rootCmd.AddCommand(reindexCommand)
 }
 
 var reindexCommand = &cobra.Command{
 	Use:   "reindex-search",
-	Short: "Reindex meilisearch index if ENABLE_SEARCH_INDEXING is set true",
+	Short: "Reindex the search index of the configured SEARCH_BACKEND if ENABLE_SEARCH_INDEXING is set true",
 	Run: func(cmd *cobra.Command, args []string) {
 		if !config.SearchEnabled() {
 			loggerx.Error(errors.New("search indexing not enabled"))
 		}
 
+		if reindexBackend != "" {
+			if reindexBackend != config.SearchMeilisearch && reindexBackend != config.SearchPostgres {
+				log.Fatal("backend must be meilisearch or postgres")
+			}
+			viper.Set("search_backend", reindexBackend)
+		}
+
 		config.SetupDB()
 
-		err := meilisearchx.SetupMeiliSearch("dega", []string{"name", "slug", "description", "title", "subtitle", "excerpt", "claim", "fact", "site_title", "site_address", "tag_line", "review", "review_tag_line"})
+		err := search.Setup()
 		if err != nil {
 			loggerx.Error(err)
 		}
 
-		_, err = meilisearchx.Client.Documents("dega").DeleteAllDocuments()
+		err = search.DeleteAll()
 		if err != nil {
 			loggerx.Error(err)
 		}
@@ -37,6 +49,5 @@ var reindexCommand = &cobra.Command{
 		if err = util.ReindexAllEntities(0); err != nil {
 			loggerx.Error(err)
 		}
-
 	},
 }
@@ -8,8 +8,12 @@ import (
 	"github.com/dlmiddlecote/sqlstats"
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/dega-server/service/relay"
+	"github.com/factly/dega-server/service/retention"
+	"github.com/factly/dega-server/service/scheduler"
 	"github.com/factly/dega-server/util"
-	"github.com/factly/x/meilisearchx"
+	"github.com/factly/dega-server/util/search"
 	"github.com/go-chi/chi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -29,7 +33,7 @@ var serveCmd = &cobra.Command{
 		config.SetupDB()
 
 		if config.SearchEnabled() {
-			err := meilisearchx.SetupMeiliSearch("dega", []string{"space_id", "name", "slug", "description", "title", "subtitle", "excerpt", "claim", "fact", "site_title", "site_address", "tag_line", "review", "review_tag_line"})
+			err := search.Setup()
 			if err != nil {
 				fmt.Println(err)
 			}
@@ -38,10 +42,26 @@ var serveCmd = &cobra.Command{
 		if util.CheckNats() {
 			util.ConnectNats()
 			defer util.NC.Close()
+
+			// publish events written to the outbox
+			go relay.Start()
 		}
 
 		r := service.RegisterRoutes()
 
+		// publish scheduled posts, pages and episodes
+		go scheduler.Start()
+
+		// purge the entities kept in the trash for too long
+		if config.TrashRetentionDays() > 0 {
+			go retention.Start()
+		}
+
+		// deliver events to the webhooks registered for them
+		if util.CheckWebhooks() {
+			go delivery.Start()
+		}
+
 		go func() {
 			promRouter := chi.NewRouter()
 
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"log"
+	"time"
 
+	"github.com/factly/x/loggerx"
 	"github.com/spf13/viper"
 )
 
@@ -65,7 +68,7 @@ func SetupVars() {
 		}
 	}
 
-	if SearchEnabled() {
+	if SearchEnabled() && SearchBackend() == SearchMeilisearch {
 		if !viper.IsSet("meili_url") {
 			log.Fatal("please provide meili_url config param")
 		}
@@ -75,10 +78,22 @@ func SetupVars() {
 		}
 	}
 
+	if StorageBackend() == StorageS3 {
+		for _, param := range []string{"s3_endpoint", "s3_bucket", "s3_access_key", "s3_secret_key"} {
+			if !viper.IsSet(param) {
+				log.Fatal("please provide " + param + " config param")
+			}
+		}
+	}
+
 	if Sqlite() {
 		if !viper.IsSet("sqlite_db_path") {
 			log.Fatal("please provide sqlite_db_path config param")
 		}
+
+		if SearchEnabled() && SearchBackend() == SearchPostgres {
+			log.Fatal("postgres search backend can not be used with sqlite")
+		}
 	}
 
 }
@@ -87,6 +102,98 @@ func SearchEnabled() bool {
 	return viper.IsSet("enable_search_indexing") && viper.GetBool("enable_search_indexing")
 }
 
+// Search backends
+const (
+	SearchMeilisearch = "meilisearch"
+	SearchPostgres    = "postgres"
+)
+
+// SearchBackend returns the backend used for search indexing, meilisearch unless
+// search_backend is set to postgres
+func SearchBackend() string {
+	if viper.GetString("search_backend") == SearchPostgres {
+		return SearchPostgres
+	}
+	return SearchMeilisearch
+}
+
+// SearchLanguage returns the postgres text search configuration used for
+// stemming documents that do not set their own language
+func SearchLanguage() string {
+	if viper.IsSet("search_language") {
+		return viper.GetString("search_language")
+	}
+	return "english"
+}
+
 func Sqlite() bool {
 	return viper.IsSet("use_sqlite") && viper.GetBool("use_sqlite")
 }
+
+// Storage backends
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
+
+// StorageBackend returns the backend uploaded media are stored in, the local
+// filesystem unless storage_backend is set to s3
+func StorageBackend() string {
+	if viper.GetString("storage_backend") == StorageS3 {
+		return StorageS3
+	}
+	return StorageLocal
+}
+
+// StorageLocalPath returns the directory the local storage backend writes to
+func StorageLocalPath() string {
+	if viper.IsSet("storage_local_path") {
+		return viper.GetString("storage_local_path")
+	}
+	return "uploads"
+}
+
+// StoragePublicURL returns the url the stored media are served from
+func StoragePublicURL() string {
+	if viper.IsSet("storage_public_url") {
+		return viper.GetString("storage_public_url")
+	}
+	if StorageBackend() == StorageS3 {
+		return viper.GetString("s3_endpoint") + "/" + viper.GetString("s3_bucket")
+	}
+	return "http://localhost:7789/uploads"
+}
+
+// UploadSizeLimit returns the maximum size in bytes of an uploaded file
+func UploadSizeLimit() int64 {
+	if viper.IsSet("upload_size_limit") {
+		return viper.GetInt64("upload_size_limit")
+	}
+	return 20 << 20
+}
+
+// TrashRetentionDays returns the number of days deleted entities are kept in
+// the trash before they are purged, they are kept until purged by hand when
+// trash_retention_days is not set
+func TrashRetentionDays() int {
+	if viper.IsSet("trash_retention_days") {
+		return viper.GetInt("trash_retention_days")
+	}
+	return 0
+}
+
+// Interval returns the duration set for the key, or the fallback when it is
+// not set, not a duration or not positive
+func Interval(key string, fallback time.Duration) time.Duration {
+	if !viper.IsSet(key) {
+		return fallback
+	}
+
+	// durations which cannot be parsed are read as zero
+	interval := viper.GetDuration(key)
+	if interval <= 0 {
+		loggerx.Error(fmt.Errorf("invalid %s %q, using %s", key, viper.GetString(key), fallback))
+		return fallback
+	}
+	return interval
+}
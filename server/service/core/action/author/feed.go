@@ -1,20 +1,19 @@
 package author
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util/feed"
+	"github.com/factly/dega-server/util/permalink"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/paginationx"
 	"github.com/go-chi/chi"
-	"github.com/gorilla/feeds"
 )
 
 func Feeds(w http.ResponseWriter, r *http.Request) {
@@ -49,27 +48,8 @@ func Feeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	now := time.Now()
-	feed := &feeds.Feed{
-		Id:          fmt.Sprint(space.ID),
-		Title:       space.Name,
-		Subtitle:    space.TagLine,
-		Link:        &feeds.Link{Href: space.SiteAddress},
-		Description: space.Description,
-		Created:     now,
-	}
-
-	if space.Logo != nil {
-		spaceLogo := map[string]interface{}{}
-		_ = json.Unmarshal(space.Logo.URL.RawMessage, &spaceLogo)
-		if rawURL, found := spaceLogo["raw"]; found {
-			feed.Image = &feeds.Image{
-				Title: space.Logo.Name,
-				Url:   rawURL.(string),
-				Link:  rawURL.(string),
-			}
-		}
-	}
+	locale := translation.GetFeedLocale(r.Context())
+	result := feed.New(space, locale)
 
 	// get post authors from published posts in given space
 	postAuthors := make([]model.PostAuthor, 0)
@@ -84,47 +64,27 @@ func Feeds(w http.ResponseWriter, r *http.Request) {
 	authorIDs := make([]uint, 0)
 	authorMap := Mapper(space.OrganisationID, userID)
 
+	urls := permalink.New(space)
 	for _, author := range authorMap {
 		if _, found := slugMap[author.Slug]; found {
 			authorIDs = append(authorIDs, author.ID)
+			// the feed of a single author links to their page
+			result.Link = urls.Author(author)
 		}
 	}
+	if len(authorIDs) != 1 {
+		result.Link = space.SiteAddress
+	}
 
 	postList := make([]model.Post, 0)
 	config.DB.Model(&model.Post{}).Joins("JOIN post_authors ON posts.id = post_authors.post_id").Where(&model.Post{
 		Status:  "publish",
 		SpaceID: uint(sID),
-	}).Where("is_page = ?", false).Where("author_id IN (?)", authorIDs).Where("post_authors.deleted_at IS NULL").Order("created_at " + sort).Offset(offset).Limit(limit).Find(&postList)
-
-	// generate post author map
-	postAuthorMap := make(map[uint][]uint)
-	for _, po := range postAuthors {
-		if _, found := postAuthorMap[po.PostID]; !found {
-			postAuthorMap[po.PostID] = make([]uint, 0)
-		}
-		postAuthorMap[po.PostID] = append(postAuthorMap[po.PostID], po.AuthorID)
-	}
-
-	for _, post := range postList {
-		author := authorMap[fmt.Sprint(postAuthorMap[post.ID][0])]
+	}).Where("is_page = ?", false).Where("author_id IN (?)", authorIDs).Where("post_authors.deleted_at IS NULL").Scopes(translation.InLocale("posts.locale", locale, space)).Preload("Format").Preload("Medium").Preload("Tags").Preload("Categories").Order("created_at " + sort).Offset(offset).Limit(limit).Find(&postList)
 
-		item := feeds.Item{
-			Id:          fmt.Sprint(post.ID),
-			Title:       post.Title,
-			Link:        &feeds.Link{Href: fmt.Sprint("https://factly.org/", post.Slug)},
-			Created:     *post.PublishedDate,
-			Updated:     post.UpdatedAt,
-			Description: post.Excerpt,
-			Content:     post.HTMLDescription,
-		}
-		authorName := fmt.Sprint(author.FirstName, " ", author.LastName)
-		if authorName != " " {
-			item.Author = &feeds.Author{Name: authorName, Email: author.Email}
-		}
-		feed.Items = append(feed.Items, &item)
-	}
+	result.Items = feed.Items(postList, space, authorMap)
 
-	if err := feed.WriteRss(w); err != nil {
+	if err := feed.Write(w, r, result); err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
 		return
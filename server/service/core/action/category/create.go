@@ -7,15 +7,15 @@ import (
 	"net/http"
 	"reflect"
 
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/loggerx"
 	"gorm.io/gorm"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/x/errorx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -113,7 +113,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(category.Description.RawMessage) > 0 && !reflect.DeepEqual(category.Description, test.NilJsonb()) {
+	if len(category.Description.RawMessage) > 0 && !reflect.DeepEqual(category.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(category.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -138,6 +138,18 @@ func create(w http.ResponseWriter, r *http.Request) {
 		FooterCode:       category.FooterCode,
 	}
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	result.Locale, result.TranslationGroupID, err = translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+	}.Resolve(tx, category.Locale, category.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	err = tx.Model(&model.Category{}).Create(result).Error
 
 	if err != nil {
@@ -162,18 +174,19 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("category.created", result); err != nil {
+		if err = util.Outbox(tx, "category.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
@@ -8,9 +8,9 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -94,18 +94,19 @@ func delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.DeleteDocument("dega", result.ID, "category")
+		_ = search.DeleteDocument(result.ID, "category")
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("category.deleted", result); err != nil {
+		if err = util.Outbox(tx, "category.deleted", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, nil)
 }
@@ -1,12 +1,12 @@
 package category
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/meilisearchx"
@@ -63,10 +63,12 @@ func list(w http.ResponseWriter, r *http.Request) {
 	if searchQuery != "" {
 
 		if config.SearchEnabled() {
-			filters := fmt.Sprint("space_id=", sID)
 			var hits []interface{}
-
-			hits, err = meilisearchx.SearchWithQuery("dega", searchQuery, filters, "category")
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Kinds:   []string{"category"},
+			})
 
 			if err != nil {
 				loggerx.Error(err)
@@ -20,6 +20,8 @@ type category struct {
 	Meta             postgres.Jsonb `json:"meta" swaggertype:"primitive,string"`
 	HeaderCode       string         `json:"header_code"`
 	FooterCode       string         `json:"footer_code"`
+	Locale           string         `json:"locale"`
+	TranslationOf    uint           `json:"translation_of"`
 }
 
 var userContext config.ContextKey = "category_user"
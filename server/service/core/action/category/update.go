@@ -8,12 +8,12 @@ import (
 	"strconv"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -135,7 +135,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(category.Description.RawMessage) > 0 && !reflect.DeepEqual(category.Description, test.NilJsonb()) {
+	if len(category.Description.RawMessage) > 0 && !reflect.DeepEqual(category.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(category.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -146,6 +146,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.Begin()
 
+	locale, translationGroupID, err := translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		ID:      result.ID,
+		Locale:  result.Locale,
+		GroupID: result.TranslationGroupID,
+	}.Resolve(tx, category.Locale, category.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	mediumID := &category.MediumID
 	result.MediumID = &category.MediumID
 	if category.MediumID == 0 {
@@ -173,18 +187,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx.Model(&result).Select("IsFeatured").Updates(model.Category{IsFeatured: category.IsFeatured})
 	err = tx.Model(&result).Updates(model.Category{
-		Base:             config.Base{UpdatedByID: uint(uID)},
-		Name:             category.Name,
-		Slug:             categorySlug,
-		BackgroundColour: category.BackgroundColour,
-		Description:      category.Description,
-		HTMLDescription:  description,
-		ParentID:         parentID,
-		MediumID:         mediumID,
-		MetaFields:       category.MetaFields,
-		Meta:             category.Meta,
-		HeaderCode:       category.HeaderCode,
-		FooterCode:       category.FooterCode,
+		Base:               config.Base{UpdatedByID: uint(uID)},
+		Name:               category.Name,
+		Slug:               categorySlug,
+		BackgroundColour:   category.BackgroundColour,
+		Description:        category.Description,
+		HTMLDescription:    description,
+		ParentID:           parentID,
+		MediumID:           mediumID,
+		MetaFields:         category.MetaFields,
+		Meta:               category.Meta,
+		HeaderCode:         category.HeaderCode,
+		FooterCode:         category.FooterCode,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
 	}).Preload("Medium").First(&result).Error
 
 	if err != nil {
@@ -207,18 +223,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("category.updated", result); err != nil {
+		if err = util.Outbox(tx, "category.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
@@ -0,0 +1,185 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/user"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/validationx"
+)
+
+// create - Create comment
+// @Summary Create comment on a post
+// @Description Create a comment thread or reply on a post, optionally anchored to a description block
+// @Tags Comment
+// @ID add-post-comment
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param Comment body comment true "Comment Object"
+// @Success 201 {object} model.Comment
+// @Failure 400 {array} string
+// @Router /core/posts/{post_id}/comments [post]
+func create(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	comment := &comment{}
+	err = json.NewDecoder(r.Body).Decode(&comment)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(comment)
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	desc, err := description(uint(sID), entityType, uint(id))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result := &model.Comment{
+		Body:       comment.Body,
+		EntityType: entityType,
+		EntityID:   uint(id),
+		BlockID:    comment.BlockID,
+		MentionIDs: toJsonb(comment.MentionIDs),
+		SpaceID:    uint(sID),
+	}
+
+	if comment.ParentID != 0 {
+		// replies belong to the thread of the parent comment
+		thread, err := find(uint(sID), entityType, uint(id), comment.ParentID)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("parent comment not found", http.StatusUnprocessableEntity)))
+			return
+		}
+
+		if thread.ParentID != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot reply to a reply", http.StatusUnprocessableEntity)))
+			return
+		}
+
+		result.ParentID = &thread.ID
+		result.BlockID = thread.BlockID
+	} else if comment.BlockID != "" && !hasBlock(desc, comment.BlockID) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("block not found in description", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	mentioned, errMessage := mentions(r.Context(), comment.MentionIDs)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	err = tx.Model(&model.Comment{}).Create(&result).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "comment.created", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+
+		if len(mentioned) > 0 {
+			if err = util.Outbox(tx, "comment.mentioned", commentData{
+				Comment:  *result,
+				Mentions: mentioned,
+			}); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
+
+// mentions validates that all the mentioned users have access to the space
+func mentions(ctx context.Context, mentionIDs []uint) ([]model.Author, errorx.Message) {
+	mentioned := make([]model.Author, 0)
+	if len(mentionIDs) == 0 {
+		return mentioned, errorx.Message{}
+	}
+
+	sID, err := middlewarex.GetSpace(ctx)
+	if err != nil {
+		loggerx.Error(err)
+		return nil, errorx.Unauthorized()
+	}
+
+	oID, err := util.GetOrganisation(ctx)
+	if err != nil {
+		loggerx.Error(err)
+		return nil, errorx.Unauthorized()
+	}
+
+	users, errMessage := user.SpaceUsers(ctx, oID, sID)
+	if errMessage.Code != 0 {
+		return nil, errMessage
+	}
+
+	for _, id := range mentionIDs {
+		mentionedUser, found := users[fmt.Sprint(id)]
+		if !found {
+			return nil, errorx.GetMessage(fmt.Sprint("user ", id, " does not have access to the space"), http.StatusUnprocessableEntity)
+		}
+		mentioned = append(mentioned, mentionedUser)
+	}
+
+	return mentioned, errorx.Message{}
+}
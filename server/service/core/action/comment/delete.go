@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// delete - Delete comment by id
+// @Summary Delete a comment
+// @Description Delete comment by ID along with its replies. Only the author of the comment can delete it
+// @Tags Comment
+// @ID delete-post-comment-by-id
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 200
+// @Failure 400 {array} string
+// @Router /core/posts/{post_id}/comments/{comment_id} [delete]
+func delete(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	commentID, err := strconv.Atoi(chi.URLParam(r, "comment_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result, err := find(uint(sID), entityType, uint(id), uint(commentID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	if result.CreatedByID != uint(uID) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("only the author can delete the comment", http.StatusForbidden)))
+		return
+	}
+
+	tx := config.DB.Begin()
+
+	tx.Where(&model.Comment{
+		ParentID: &result.ID,
+	}).Delete(&model.Comment{})
+
+	err = tx.Delete(&result).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "comment.deleted", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, nil)
+}
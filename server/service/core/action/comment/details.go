@@ -0,0 +1,70 @@
+package comment
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// details - Get comment by id
+// @Summary Show a comment by id
+// @Description Get comment of a post by ID along with its replies
+// @Tags Comment
+// @ID get-post-comment-by-id
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 200 {object} model.Comment
+// @Router /core/posts/{post_id}/comments/{comment_id} [get]
+func details(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	commentID, err := strconv.Atoi(chi.URLParam(r, "comment_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.Comment{}
+	result.ID = uint(commentID)
+
+	err = config.DB.Model(&model.Comment{}).Where(&model.Comment{
+		EntityType: entityType,
+		EntityID:   uint(id),
+		SpaceID:    uint(sID),
+	}).Preload("Replies", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at asc")
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
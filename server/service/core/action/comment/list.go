@@ -0,0 +1,88 @@
+package comment
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+	"gorm.io/gorm"
+)
+
+// list response
+type paging struct {
+	Total int64           `json:"total"`
+	Nodes []model.Comment `json:"nodes"`
+}
+
+// list - Get all comment threads of a post
+// @Summary Show all comment threads of a post
+// @Description Get all comment threads of a post along with their replies
+// @Tags Comment
+// @ID get-all-post-comments
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param block_id query string false "Block ID"
+// @Param resolved query string false "Resolved"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} paging
+// @Router /core/posts/{post_id}/comments [get]
+func list(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	blockID := r.URL.Query().Get("block_id")
+	resolved := r.URL.Query().Get("resolved")
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	result := paging{}
+	result.Nodes = make([]model.Comment, 0)
+
+	tx := config.DB.Model(&model.Comment{}).Where(&model.Comment{
+		EntityType: entityType,
+		EntityID:   uint(id),
+		SpaceID:    uint(sID),
+	}).Where("parent_id IS NULL")
+
+	if blockID != "" {
+		tx.Where("block_id = ?", blockID)
+	}
+
+	if resolved == "true" {
+		tx.Where("is_resolved = ?", true)
+	} else if resolved == "false" {
+		tx.Where("is_resolved = ?", false)
+	}
+
+	err = tx.Count(&result.Total).Preload("Replies", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at asc")
+	}).Order("created_at asc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,133 @@
+package comment
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// resolve - Resolve comment thread
+// @Summary Resolve a comment thread
+// @Description Mark a comment thread as resolved
+// @Tags Comment
+// @ID resolve-post-comment
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 200 {object} model.Comment
+// @Router /core/posts/{post_id}/comments/{comment_id}/resolve [post]
+func resolve(w http.ResponseWriter, r *http.Request) {
+	setResolved(w, r, true)
+}
+
+// reopen - Reopen comment thread
+// @Summary Reopen a comment thread
+// @Description Reopen a resolved comment thread
+// @Tags Comment
+// @ID reopen-post-comment
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 200 {object} model.Comment
+// @Router /core/posts/{post_id}/comments/{comment_id}/reopen [post]
+func reopen(w http.ResponseWriter, r *http.Request) {
+	setResolved(w, r, false)
+}
+
+func setResolved(w http.ResponseWriter, r *http.Request, resolved bool) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	commentID, err := strconv.Atoi(chi.URLParam(r, "comment_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result, err := find(uint(sID), entityType, uint(id), uint(commentID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	if result.ParentID != nil {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("only comment threads can be resolved", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	if result.IsResolved == resolved {
+		renderx.JSON(w, http.StatusOK, result)
+		return
+	}
+
+	updates := map[string]interface{}{
+		"is_resolved":    resolved,
+		"resolved_by_id": nil,
+		"resolved_at":    nil,
+		"updated_by_id":  uID,
+	}
+	if resolved {
+		updates["resolved_by_id"] = uID
+		updates["resolved_at"] = time.Now()
+	}
+
+	tx := config.DB.Begin()
+
+	err = tx.Model(&result).Updates(updates).First(&result).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	event := "comment.reopened"
+	if resolved {
+		event = "comment.resolved"
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, event, result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
+}
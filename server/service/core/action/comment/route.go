@@ -0,0 +1,125 @@
+package comment
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// comment request body
+type comment struct {
+	Body       string `json:"body" validate:"required,max=5000"`
+	BlockID    string `json:"block_id"`
+	ParentID   uint   `json:"parent_id"`
+	MentionIDs []uint `json:"mention_ids"`
+}
+
+// commentData is the payload of the comment.mentioned event
+type commentData struct {
+	model.Comment
+	Mentions []model.Author `json:"mentions"`
+}
+
+var userContext config.ContextKey = "comment_user"
+
+// Router - Group of comment router, mounted under posts and claims
+func Router(entity string) chi.Router {
+	r := chi.NewRouter()
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
+	r.With(util.CheckKetoPolicy(entity, "get")).Post("/", create)
+
+	r.Route("/{comment_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
+		r.With(util.CheckKetoPolicy(entity, "get")).Put("/", update)
+		r.With(util.CheckKetoPolicy(entity, "get")).Delete("/", delete)
+		r.With(util.CheckKetoPolicy(entity, "update")).Post("/resolve", resolve)
+		r.With(util.CheckKetoPolicy(entity, "update")).Post("/reopen", reopen)
+	})
+
+	return r
+}
+
+// parent returns the type and id of the post or claim whose comments are requested
+func parent(r *http.Request) (string, int, error) {
+	if claimID := chi.URLParam(r, "claim_id"); claimID != "" {
+		id, err := strconv.Atoi(claimID)
+		return "claim", id, err
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "post_id"))
+	return "post", id, err
+}
+
+// description fetches the description of the commented post or claim
+func description(sID uint, entityType string, entityID uint) (postgres.Jsonb, error) {
+	if entityType == "claim" {
+		claim := factCheckModel.Claim{}
+		claim.ID = entityID
+		err := config.DB.Model(&factCheckModel.Claim{}).Where(&factCheckModel.Claim{
+			SpaceID: sID,
+		}).First(&claim).Error
+		return claim.Description, err
+	}
+
+	post := model.Post{}
+	post.ID = entityID
+	err := config.DB.Model(&model.Post{}).Where(&model.Post{
+		SpaceID: sID,
+	}).Where("is_page = ?", false).First(&post).Error
+	return post.Description, err
+}
+
+// hasBlock checks if the EditorJS description contains a block with the given id
+func hasBlock(description postgres.Jsonb, blockID string) bool {
+	editorJS := struct {
+		Blocks []struct {
+			ID string `json:"id"`
+		} `json:"blocks"`
+	}{}
+
+	if len(description.RawMessage) == 0 {
+		return false
+	}
+
+	if err := json.Unmarshal(description.RawMessage, &editorJS); err != nil {
+		return false
+	}
+
+	for _, block := range editorJS.Blocks {
+		if block.ID == blockID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// find fetches a comment of the post or claim
+func find(sID uint, entityType string, entityID, commentID uint) (*model.Comment, error) {
+	result := &model.Comment{}
+	result.ID = commentID
+
+	err := config.DB.Model(&model.Comment{}).Where(&model.Comment{
+		EntityType: entityType,
+		EntityID:   entityID,
+		SpaceID:    sID,
+	}).First(&result).Error
+
+	return result, err
+}
+
+func toJsonb(ids []uint) postgres.Jsonb {
+	if ids == nil {
+		ids = make([]uint, 0)
+	}
+	byteArr, _ := json.Marshal(ids)
+	return postgres.Jsonb{RawMessage: byteArr}
+}
@@ -0,0 +1,151 @@
+package comment
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+)
+
+// update - Update comment by id
+// @Summary Update a comment by id
+// @Description Update the body and mentions of a comment. Only the author of the comment can update it
+// @Tags Comment
+// @ID update-post-comment-by-id
+// @Produce json
+// @Consume json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Param Comment body comment false "Comment"
+// @Success 200 {object} model.Comment
+// @Router /core/posts/{post_id}/comments/{comment_id} [put]
+func update(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	entityType, id, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	commentID, err := strconv.Atoi(chi.URLParam(r, "comment_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	comment := &comment{}
+	err = json.NewDecoder(r.Body).Decode(&comment)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(comment)
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	result, err := find(uint(sID), entityType, uint(id), uint(commentID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	if result.CreatedByID != uint(uID) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("only the author can update the comment", http.StatusForbidden)))
+		return
+	}
+
+	// notify only the newly mentioned users
+	prevMentionIDs := make([]uint, 0)
+	_ = json.Unmarshal(result.MentionIDs.RawMessage, &prevMentionIDs)
+	prevMentions := make(map[uint]bool)
+	for _, each := range prevMentionIDs {
+		prevMentions[each] = true
+	}
+
+	newMentionIDs := make([]uint, 0)
+	for _, each := range comment.MentionIDs {
+		if !prevMentions[each] {
+			newMentionIDs = append(newMentionIDs, each)
+		}
+	}
+
+	mentioned, errMessage := mentions(r.Context(), newMentionIDs)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
+	}
+
+	tx := config.DB.Begin()
+
+	err = tx.Model(&result).Select("Body", "MentionIDs", "UpdatedByID").Updates(model.Comment{
+		Base:       config.Base{UpdatedByID: uint(uID)},
+		Body:       comment.Body,
+		MentionIDs: toJsonb(comment.MentionIDs),
+	}).First(&result).Error
+
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "comment.updated", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+
+		if len(mentioned) > 0 {
+			if err = util.Outbox(tx, "comment.mentioned", commentData{
+				Comment:  *result,
+				Mentions: mentioned,
+			}); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -1,21 +1,20 @@
 package event
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"reflect"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/util"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/factly/x/validationx"
-	"github.com/spf13/viper"
 )
 
 // create - Create Event
@@ -60,40 +59,26 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/events"
+	result := &model.Event{
+		Name:  event.Name,
+		Event: event.Event,
+		Tags:  event.Tags,
+	}
 
-	resp, err := requestx.Request("POST", hukzURL, event, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
+	err = config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Model(&model.Event{}).Create(&result).Error
 
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode == http.StatusUnprocessableEntity {
 		errorx.Render(w, errorx.Parser(errorx.CannotSaveChanges()))
 		return
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	var eventRes model.Event
-	if err = json.NewDecoder(resp.Body).Decode(&eventRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	renderx.JSON(w, http.StatusCreated, eventRes)
+	renderx.JSON(w, http.StatusCreated, result)
 }
 
 func AddTags(event *event) error {
 	tags := make(map[string]string)
-	if len(event.Tags.RawMessage) > 0 && !reflect.DeepEqual(event.Tags, test.NilJsonb()) {
+	if len(event.Tags.RawMessage) > 0 && !reflect.DeepEqual(event.Tags, util.NilJsonb()) {
 		err := json.Unmarshal(event.Tags.RawMessage, &tags)
 		if err != nil {
 			return err
@@ -1,19 +1,18 @@
 package event
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
-	"github.com/spf13/viper"
 )
 
 // DataFile default json data file
@@ -59,42 +58,35 @@ func defaults(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	eventsResp := make([]model.Event, 0)
+	result := make([]model.Event, 0)
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
 	for i := range events {
 		if err = AddTags(&events[i]); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
 			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
 			return
 		}
 
-		hukzURL := viper.GetString("hukz_url") + "/events"
-
-		resp, err := requestx.Request("POST", hukzURL, events[i], map[string]string{
-			"X-User": fmt.Sprint(uID),
-		})
-
+		event := model.Event{
+			Name:  events[i].Name,
+			Event: events[i].Event,
+			Tags:  events[i].Tags,
+		}
+		err = tx.Model(&model.Event{}).Where(&model.Event{
+			Event: events[i].Event,
+		}).FirstOrCreate(&event).Error
 		if err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-			return
-		}
-
-		if resp.StatusCode == http.StatusUnprocessableEntity {
-			continue
-		}
-
-		if resp.StatusCode != http.StatusCreated {
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
+		result = append(result, event)
+	}
 
-		var eventRes model.Event
-		if err = json.NewDecoder(resp.Body).Decode(&eventRes); err != nil {
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-			return
-		}
-		eventsResp = append(eventsResp, eventRes)
+	tx.Commit()
 
-	}
-	renderx.JSON(w, http.StatusCreated, eventsResp)
+	renderx.JSON(w, http.StatusCreated, result)
 }
@@ -1,18 +1,16 @@
 package event
 
 import (
-	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // delete - Delete Event by id
@@ -36,41 +34,27 @@ func delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	uID, err := middlewarex.GetUser(r.Context())
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
-		return
-	}
+	result := &model.Event{}
+	result.ID = uint(id)
 
-	hukzURL := viper.GetString("hukz_url") + "/events/" + fmt.Sprint(id)
-
-	resp, err := requestx.Request("DELETE", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
-
-	if err != nil {
+	// check record exists or not
+	if err = config.DB.First(&result).Error; err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
 		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
 		return
 	}
 
-	if resp.StatusCode == http.StatusUnprocessableEntity {
-		body := map[string]interface{}{}
-		_ = json.NewDecoder(resp.Body).Decode(&body)
-		renderx.JSON(w, http.StatusUnprocessableEntity, body)
-		return
-	}
+	// check if event is associated with webhooks
+	var totAssociated int64
+	config.DB.Table("webhook_events").Where("event_id = ?", id).Count(&totAssociated)
 
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if totAssociated != 0 {
+		loggerx.Error(errors.New("event is associated with webhook"))
+		errorx.Render(w, errorx.Parser(errorx.CannotDelete("event", "webhook")))
 		return
 	}
 
+	config.DB.Delete(&result)
+
 	renderx.JSON(w, http.StatusOK, nil)
 }
@@ -1,19 +1,15 @@
 package event
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // details - Get event by id
@@ -37,41 +33,15 @@ func details(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	uID, err := middlewarex.GetUser(r.Context())
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
-		return
-	}
-
-	hukzURL := viper.GetString("hukz_url") + "/events/" + fmt.Sprint(id)
+	result := &model.Event{}
+	result.ID = uint(id)
 
-	resp, err := requestx.Request("GET", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
-	if err != nil {
+	// check record exists or not
+	if err = config.DB.First(&result).Error; err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
 		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
 		return
 	}
 
-	var eventRes model.Event
-
-	if err = json.NewDecoder(resp.Body).Decode(&eventRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	renderx.JSON(w, http.StatusOK, eventRes)
-
+	renderx.JSON(w, http.StatusOK, result)
 }
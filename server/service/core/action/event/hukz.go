@@ -0,0 +1,186 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/requestx"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+	"github.com/spf13/viper"
+)
+
+// HukzRouter events endpoint router proxying the events to hukz, used when
+// hukz delivers the webhooks instead of dega-server
+func HukzRouter() chi.Router {
+	r := chi.NewRouter()
+
+	app := "dega"
+
+	r.Get("/", hukzList)
+	r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Post("/", hukzSave)
+	r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Post("/default", hukzDefaults)
+
+	r.Route("/{event_id}", func(r chi.Router) {
+		r.Get("/", hukzEvent)
+		r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Put("/", hukzSave)
+		r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Delete("/", hukzEvent)
+	})
+
+	return r
+}
+
+// hukzList lists the events of dega from hukz
+func hukzList(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	path := "/events?tag=app:dega&limit=" + r.URL.Query().Get("limit") + "&page=" + r.URL.Query().Get("page")
+
+	util.HukzRequest(w, http.MethodGet, path, nil, uID, http.StatusOK)
+}
+
+// hukzSave creates or updates an event in hukz
+func hukzSave(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	path := "/events"
+	method, status := http.MethodPost, http.StatusCreated
+	if eventID := chi.URLParam(r, "event_id"); eventID != "" {
+		id, err := strconv.Atoi(eventID)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+			return
+		}
+		path = "/events/" + fmt.Sprint(id)
+		method, status = http.MethodPut, http.StatusOK
+	}
+
+	event := &event{}
+
+	if err = json.NewDecoder(r.Body).Decode(&event); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	if validationError := validationx.Check(event); validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	// append app tag even if not provided
+	if err = AddTags(event); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	util.HukzRequest(w, method, path, event, uID, status)
+}
+
+// hukzDefaults creates the default events in hukz, skipping the ones which
+// already exist
+func hukzDefaults(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	jsonFile, err := os.Open(DataFile)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	defer jsonFile.Close()
+
+	events := make([]event, 0)
+
+	byteValue, _ := ioutil.ReadAll(jsonFile)
+	if err = json.Unmarshal(byteValue, &events); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	result := make([]interface{}, 0)
+	for i := range events {
+		if err = AddTags(&events[i]); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		resp, err := requestx.Request("POST", viper.GetString("hukz_url")+"/events", events[i], map[string]string{
+			"X-User": fmt.Sprint(uID),
+		})
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		if resp.StatusCode == http.StatusUnprocessableEntity {
+			resp.Body.Close()
+			continue
+		}
+
+		var eventRes interface{}
+		err = json.NewDecoder(resp.Body).Decode(&eventRes)
+		resp.Body.Close()
+
+		if err != nil || resp.StatusCode != http.StatusCreated {
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+		result = append(result, eventRes)
+	}
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
+
+// hukzEvent gets or deletes an event of hukz
+func hukzEvent(w http.ResponseWriter, r *http.Request) {
+	eventID := chi.URLParam(r, "event_id")
+	id, err := strconv.Atoi(eventID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	util.HukzRequest(w, r.Method, "/events/"+fmt.Sprint(id), nil, uID, http.StatusOK)
+}
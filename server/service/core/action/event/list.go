@@ -1,17 +1,14 @@
 package event
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
-	"github.com/spf13/viper"
 )
 
 type paging struct {
@@ -32,36 +29,18 @@ type paging struct {
 // @Success 200 {object} paging
 // @Router /core/events [get]
 func list(w http.ResponseWriter, r *http.Request) {
-	uID, err := middlewarex.GetUser(r.Context())
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
-		return
-	}
+	result := paging{}
+	result.Nodes = make([]model.Event, 0)
 
-	hukzURL := viper.GetString("hukz_url") + "/events?tag=app:dega&limit=" + r.URL.Query().Get("limit") + "&page=" + r.URL.Query().Get("page")
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	err := config.DB.Model(&model.Event{}).Count(&result.Total).Order("id asc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
 
-	resp, err := requestx.Request("GET", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	var eventsPaging paging
-
-	if err = json.NewDecoder(resp.Body).Decode(&eventsPaging); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	renderx.JSON(w, http.StatusOK, eventsPaging)
-
+	renderx.JSON(w, http.StatusOK, result)
 }
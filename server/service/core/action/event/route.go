@@ -1,6 +1,7 @@
 package event
 
 import (
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/x/middlewarex"
 	"github.com/go-chi/chi"
@@ -13,6 +14,8 @@ type event struct {
 	Tags  postgres.Jsonb `json:"tags" swaggertype:"primitive,string"`
 }
 
+var userContext config.ContextKey = "event_user"
+
 // Router events endpoint router
 func Router() chi.Router {
 	r := chi.NewRouter()
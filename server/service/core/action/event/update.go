@@ -3,19 +3,17 @@ package event
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/factly/x/validationx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // update - Update event by id
@@ -48,6 +46,16 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	result := &model.Event{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	if err = config.DB.First(&result).Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
 	event := &event{}
 
 	if err = json.NewDecoder(r.Body).Decode(&event); err != nil {
@@ -62,40 +70,25 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// append app and space tag even if not provided
+	// append app tag even if not provided
 	if err = AddTags(event); err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/events/" + fmt.Sprint(id)
+	err = config.DB.Model(&result).Updates(model.Event{
+		Base:  config.Base{UpdatedByID: uint(uID)},
+		Name:  event.Name,
+		Event: event.Event,
+		Tags:  event.Tags,
+	}).First(&result).Error
 
-	resp, err := requestx.Request("PUT", hukzURL, event, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
-		return
-	}
-
-	var eventRes model.Event
-
-	if err = json.NewDecoder(resp.Body).Decode(&eventRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		errorx.Render(w, errorx.Parser(errorx.CannotSaveChanges()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, eventRes)
+	renderx.JSON(w, http.StatusOK, result)
 }
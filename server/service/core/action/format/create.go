@@ -9,9 +9,9 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -132,16 +132,17 @@ func create(w http.ResponseWriter, r *http.Request) {
 		_ = insertIntoMeili(*result)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("format.created", result); err != nil {
+		if err = util.Outbox(tx, "format.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
 
@@ -155,5 +156,5 @@ func insertIntoMeili(format model.Format) error {
 		"space_id":    format.SpaceID,
 	}
 
-	return meilisearchx.AddDocument("dega", meiliObj)
+	return search.AddDocument(meiliObj)
 }
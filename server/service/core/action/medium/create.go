@@ -9,9 +9,9 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -59,29 +59,9 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if viper.GetBool("create_super_organisation") {
-		// Fetch space permissions
-		permission := model.SpacePermission{}
-		err = config.DB.Model(&model.SpacePermission{}).Where(&model.SpacePermission{
-			SpaceID: uint(sID),
-		}).First(&permission).Error
-
-		if err != nil {
-			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more media", http.StatusUnprocessableEntity)))
-			return
-		}
-
-		// Fetch total number of medium in space
-		var totMedia int64
-		config.DB.Model(&model.Medium{}).Where(&model.Medium{
-			SpaceID: uint(sID),
-		}).Count(&totMedia)
-
-		if totMedia+int64(len(mediumList)) > permission.Media && permission.Media > 0 {
-			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more media", http.StatusUnprocessableEntity)))
-			return
-		}
+	if !canCreate(sID, len(mediumList)) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more media", http.StatusUnprocessableEntity)))
+		return
 	}
 
 	result := paging{}
@@ -151,21 +131,48 @@ func create(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if config.SearchEnabled() {
-			_ = meilisearchx.AddDocument("dega", meiliObj)
+			_ = search.AddDocument(meiliObj)
 		}
 	}
 
 	result.Total = int64(len(result.Nodes))
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("media.created", result); err != nil {
+		if err = util.Outbox(tx, "media.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
+
+// canCreate checks whether the space is allowed to create count more media
+func canCreate(sID, count int) bool {
+	if !viper.GetBool("create_super_organisation") {
+		return true
+	}
+
+	// Fetch space permissions
+	permission := model.SpacePermission{}
+	err := config.DB.Model(&model.SpacePermission{}).Where(&model.SpacePermission{
+		SpaceID: uint(sID),
+	}).First(&permission).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		return false
+	}
+
+	// Fetch total number of medium in space
+	var totMedia int64
+	config.DB.Model(&model.Medium{}).Where(&model.Medium{
+		SpaceID: uint(sID),
+	}).Count(&totMedia)
+
+	return totMedia+int64(count) <= permission.Media || permission.Media <= 0
+}
@@ -4,14 +4,14 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
-	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -19,12 +19,13 @@ import (
 
 // delete - Delete medium by id
 // @Summary Delete a medium
-// @Description Delete medium by ID
+// @Description Delete medium by ID, media used by other entities are only deleted with force=true
 // @Tags Medium
 // @ID delete-medium-by-id
 // @Param X-User header string true "User ID"
 // @Param medium_id path string true "Medium ID"
 // @Param X-Space header string true "Space ID"
+// @Param force query string false "delete a medium in use, returning its usages"
 // @Success 200
 // @Router /core/media/{medium_id} [delete]
 func delete(w http.ResponseWriter, r *http.Request) {
@@ -60,85 +61,48 @@ func delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	uintID := uint(id)
-
-	// check if medium is associated with posts
-	var totAssociated int64
-	config.DB.Model(&model.Post{}).Where(&model.Post{
-		FeaturedMediumID: &uintID,
-	}).Count(&totAssociated)
-
-	if totAssociated != 0 {
-		loggerx.Error(errors.New("medium is associated with post"))
-		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", "post")))
-		return
-	}
-
-	// check if medium is associated with categories
-	config.DB.Model(&model.Category{}).Where(&model.Category{
-		MediumID: &uintID,
-	}).Count(&totAssociated)
-
-	if totAssociated != 0 {
-		loggerx.Error(errors.New("medium is associated with category"))
-		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", "category")))
+	used, err := usagesOf(uint(sID), result)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	// check if medium is associated with spaces
-	config.DB.Model(&model.Space{}).Where(&model.Space{
-		LogoID: &uintID,
-	}).Or(&model.Space{
-		LogoMobileID: &uintID,
-	}).Or(&model.Space{
-		FavIconID: &uintID,
-	}).Or(&model.Space{
-		MobileIconID: &uintID,
-	}).Count(&totAssociated)
-
-	if totAssociated != 0 {
-		loggerx.Error(errors.New("medium is associated with space"))
-		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", "space")))
+	// media in use are only deleted when forced
+	if len(used) != 0 && r.URL.Query().Get("force") != "true" {
+		loggerx.Error(errors.New("medium is in use"))
+		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", strings.Join(usageKinds(used), ", "))))
 		return
 	}
 
-	// check if medium is associated with ratings
-	config.DB.Model(&factCheckModel.Rating{}).Where(&factCheckModel.Rating{
-		MediumID: &uintID,
-	}).Count(&totAssociated)
+	tx := config.DB.Begin()
+	tx.Delete(&result)
 
-	if totAssociated != 0 {
-		loggerx.Error(errors.New("medium is associated with rating"))
-		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", "rating")))
-		return
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "media.deleted", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
 	}
 
-	// check if medium is associated with claimants
-	config.DB.Model(&factCheckModel.Claimant{}).Where(&factCheckModel.Claimant{
-		MediumID: &uintID,
-	}).Count(&totAssociated)
-
-	if totAssociated != 0 {
-		loggerx.Error(errors.New("medium is associated with claimant"))
-		errorx.Render(w, errorx.Parser(errorx.CannotDelete("medium", "claimant")))
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	tx := config.DB.Begin()
-	tx.Delete(&result)
-
 	if config.SearchEnabled() {
-		_ = meilisearchx.DeleteDocument("dega", result.ID, "medium")
+		_ = search.DeleteDocument(result.ID, "medium")
 	}
 
-	tx.Commit()
-
-	if util.CheckNats() {
-		if err = util.NC.Publish("media.deleted", result); err != nil {
-			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-			return
-		}
+	if len(used) != 0 {
+		renderx.JSON(w, http.StatusOK, usagePaging{
+			Total: int64(len(used)),
+			Nodes: used,
+		})
+		return
 	}
 
 	renderx.JSON(w, http.StatusOK, nil)
@@ -33,9 +33,12 @@ func Router() chi.Router {
 
 	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
 	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", create)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/upload", upload)
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/unused", unused)
 
 	r.Route("/{medium_id}", func(r chi.Router) {
 		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/usages", usages)
 		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
 		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
 	})
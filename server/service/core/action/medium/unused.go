@@ -0,0 +1,88 @@
+package medium
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+	"gorm.io/gorm"
+)
+
+// unused media response
+type unusedPaging struct {
+	Total         int64          `json:"total"`
+	TotalFileSize int64          `json:"total_file_size"`
+	Nodes         []model.Medium `json:"nodes"`
+}
+
+// unused - Get unused media
+// @Summary Show unused media
+// @Description Get the media of the space which are not used by any entity, oldest first, with the total size of their files
+// @Tags Medium
+// @ID get-unused-media
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} unusedPaging
+// @Router /core/media/unused [get]
+func unused(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	index, err := mediaUsages(uint(sID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	used := make([]uint, 0, len(index))
+	for id := range index {
+		used = append(used, id)
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i] < used[j] })
+
+	query := func() *gorm.DB {
+		tx := config.DB.Model(&model.Medium{}).Where(&model.Medium{
+			SpaceID: uint(sID),
+		})
+		if len(used) > 0 {
+			tx = tx.Where("id NOT IN ?", used)
+		}
+		return tx
+	}
+
+	result := unusedPaging{}
+	result.Nodes = make([]model.Medium, 0)
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	err = query().Select("COALESCE(SUM(file_size), 0)").Row().Scan(&result.TotalFileSize)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	err = query().Count(&result.Total).Order("created_at asc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
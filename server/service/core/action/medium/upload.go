@@ -0,0 +1,275 @@
+package medium
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/imaging"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/dega-server/util/storage"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// rendition of an uploaded image in the url of the medium
+type rendition struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	URL    string `json:"url"`
+	WebP   string `json:"webp,omitempty"`
+}
+
+// upload - Upload medium
+// @Summary Upload medium
+// @Description Upload a file to the storage backend. Images are processed into responsive renditions, webp variants and a blurhash placeholder, their dimensions and exif data are stored in the url of the medium. Only images, pdf, audio, video and plain text files can be uploaded.
+// @Tags Medium
+// @ID upload-medium
+// @Consume multipart/form-data
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param file formData file true "File"
+// @Param name formData string false "Name"
+// @Param title formData string false "Title"
+// @Param alt_text formData string false "Alt text"
+// @Param caption formData string false "Caption"
+// @Param description formData string false "Description"
+// @Success 201 {object} model.Medium
+// @Failure 400 {array} string
+// @Router /core/media/upload [post]
+func upload(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, config.UploadSizeLimit())
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("please upload a file no larger than the upload size limit", http.StatusBadRequest)))
+		return
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil || len(data) == 0 {
+		loggerx.Error(errors.New("cannot read uploaded file"))
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	if !canCreate(sID, 1) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more media", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	processed, err := imaging.Process(data)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot process image", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	// the extension comes from the detected type and never from the name of
+	// the file, so that the file is not served as a type it is not
+	extension := imaging.Extension(processed.MIMEType)
+	if extension == "" {
+		loggerx.Error(fmt.Errorf("cannot upload file of type %s", processed.MIMEType))
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("file type is not allowed", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	name := r.FormValue("name")
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(header.Filename), filepath.Ext(header.Filename))
+	}
+
+	// Get table name
+	stmt := &gorm.Statement{DB: config.DB}
+	_ = stmt.Parse(&model.Medium{})
+	tableName := stmt.Schema.Table
+
+	mediumSlug := slugx.Approve(&config.DB, slugx.Make(name), sID, tableName)
+
+	now := time.Now()
+	prefix := fmt.Sprint(sID, "/", now.Year(), "/", int(now.Month()), "/", now.Unix(), "_", mediumSlug)
+
+	stored := make([]string, 0)
+	put := func(key string, body []byte, contentType string) (string, error) {
+		url, err := storage.Put(r.Context(), key, body, contentType)
+		if err == nil {
+			stored = append(stored, key)
+		}
+		return url, err
+	}
+
+	urls, err := storeFiles(put, prefix, extension, data, processed)
+	if err != nil {
+		loggerx.Error(err)
+		removeFiles(stored)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	urlBytes, _ := json.Marshal(urls)
+
+	med := model.Medium{
+		Name:        name,
+		Slug:        mediumSlug,
+		Type:        processed.MIMEType,
+		Title:       r.FormValue("title"),
+		Description: r.FormValue("description"),
+		Caption:     r.FormValue("caption"),
+		AltText:     r.FormValue("alt_text"),
+		FileSize:    int64(len(data)),
+		URL: postgres.Jsonb{
+			RawMessage: urlBytes,
+		},
+		SpaceID: uint(sID),
+	}
+	if processed.Width > 0 {
+		med.Dimensions = fmt.Sprint(processed.Width, "x", processed.Height)
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+	err = tx.Model(&model.Medium{}).Create(&med).Error
+
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		removeFiles(stored)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	// Insert into meili index
+	meiliObj := map[string]interface{}{
+		"id":          med.ID,
+		"kind":        "medium",
+		"name":        med.Name,
+		"slug":        med.Slug,
+		"title":       med.Title,
+		"type":        med.Type,
+		"description": med.Description,
+		"space_id":    med.SpaceID,
+	}
+
+	if config.SearchEnabled() {
+		_ = search.AddDocument(meiliObj)
+	}
+
+	if util.CheckNats() {
+		result := paging{
+			Total: 1,
+			Nodes: []model.Medium{med},
+		}
+		if err = util.Outbox(tx, "media.created", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			removeFiles(stored)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusCreated, med)
+}
+
+// storeFiles stores the uploaded file with its renditions and webp variants
+// and returns the url of the medium
+func storeFiles(put func(key string, body []byte, contentType string) (string, error), prefix, extension string, data []byte, processed *imaging.Result) (map[string]interface{}, error) {
+	contentType := processed.MIMEType
+	raw, err := put(prefix+extension, data, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := map[string]interface{}{
+		"raw":       raw,
+		"mime_type": processed.MIMEType,
+	}
+	if !imaging.IsImage(processed.MIMEType) {
+		return urls, nil
+	}
+
+	urls["width"] = processed.Width
+	urls["height"] = processed.Height
+	urls["blurhash"] = processed.Blurhash
+	if processed.EXIF != nil {
+		urls["exif"] = processed.EXIF
+	}
+
+	if processed.WebP != nil {
+		if urls["webp"], err = put(prefix+".webp", processed.WebP, "image/webp"); err != nil {
+			return nil, err
+		}
+	}
+
+	renditions := make([]rendition, 0, len(processed.Renditions))
+	for _, each := range processed.Renditions {
+		key := fmt.Sprint(prefix, "-", each.Width, "w")
+		item := rendition{
+			Width:  each.Width,
+			Height: each.Height,
+		}
+		if item.URL, err = put(key+imaging.Extension(each.MIMEType), each.Data, each.MIMEType); err != nil {
+			return nil, err
+		}
+		if item.WebP, err = put(key+".webp", each.WebP, "image/webp"); err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, item)
+	}
+	urls["renditions"] = renditions
+
+	return urls, nil
+}
+
+// RemoveFiles deletes the stored files of a medium, its webp variant and
+// renditions once the medium is purged
+func RemoveFiles(url postgres.Jsonb) {
+	keys := make([]string, 0)
+	for _, each := range mediumURLs(url) {
+		if key, found := storage.Key(each); found {
+			keys = append(keys, key)
+		}
+	}
+	removeFiles(keys)
+}
+
+// removeFiles deletes stored files, like the ones of an upload that failed
+func removeFiles(keys []string) {
+	for _, key := range keys {
+		if err := storage.Delete(context.Background(), key); err != nil {
+			loggerx.Error(err)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package medium
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// usage of a medium by an entity of its space
+type usage struct {
+	Kind  string `json:"kind"`
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+	Field string `json:"field"`
+}
+
+// usageIndex maps the media of a space to their usages
+type usageIndex map[uint][]usage
+
+// entity of the space which may use media
+type usageEntity struct {
+	ID          uint
+	Title       string
+	IsPage      bool
+	MediumID    *uint
+	Description postgres.Jsonb
+}
+
+// usageSource is a table of entities with a medium and a description
+type usageSource struct {
+	kind   string
+	model  interface{}
+	title  string
+	medium string
+	// whether the entities have an editorjs description
+	described bool
+}
+
+var usageSources = []usageSource{
+	{kind: "post", model: &model.Post{}, title: "title", medium: "featured_medium_id", described: true},
+	{kind: "category", model: &model.Category{}, title: "name", medium: "medium_id", described: true},
+	{kind: "tag", model: &model.Tag{}, title: "name", medium: "medium_id", described: true},
+	{kind: "format", model: &model.Format{}, title: "name", medium: "medium_id"},
+	{kind: "rating", model: &factCheckModel.Rating{}, title: "name", medium: "medium_id", described: true},
+	{kind: "claimant", model: &factCheckModel.Claimant{}, title: "name", medium: "medium_id", described: true},
+	{kind: "claim", model: &factCheckModel.Claim{}, title: "claim", medium: "medium_id", described: true},
+	{kind: "podcast", model: &podcastModel.Podcast{}, title: "title", medium: "medium_id", described: true},
+	{kind: "episode", model: &podcastModel.Episode{}, title: "title", medium: "medium_id", described: true},
+}
+
+// mediaUsages scans the entities of a space for the media they use as their
+// featured medium, logo or icon of the space and as images embedded in their
+// descriptions
+func mediaUsages(sID uint) (usageIndex, error) {
+	index := usageIndex{}
+
+	// urls of the uploaded files and their renditions, to find the media
+	// embedded as images by their url
+	media := make([]struct {
+		ID  uint
+		URL postgres.Jsonb
+	}, 0)
+	err := config.DB.Model(&model.Medium{}).Select("id, url").Where(&model.Medium{
+		SpaceID: sID,
+	}).Find(&media).Error
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[string]uint)
+	for _, each := range media {
+		for _, url := range mediumURLs(each.URL) {
+			urls[url] = each.ID
+		}
+	}
+
+	space := model.Space{}
+	err = config.DB.Model(&model.Space{}).Where("id = ?", sID).First(&space).Error
+	if err != nil {
+		return nil, err
+	}
+	index.addSpace(space)
+
+	for _, source := range usageSources {
+		entities := make([]usageEntity, 0)
+		err = config.DB.Model(source.model).Select(source.columns()).Where("space_id = ?", sID).Order("id").Find(&entities).Error
+		if err != nil {
+			return nil, err
+		}
+		source.addTo(index, entities, urls)
+	}
+
+	return index, nil
+}
+
+// usagesOf looks up the usages of a single medium, selecting only the
+// entities referring to it by their medium or containing it in a block of
+// their description instead of scanning the whole space
+func usagesOf(sID uint, medium *model.Medium) ([]usage, error) {
+	index := usageIndex{}
+
+	urls := make(map[string]uint)
+	for _, url := range mediumURLs(medium.URL) {
+		urls[url] = medium.ID
+	}
+
+	spaces := make([]model.Space, 0)
+	err := config.DB.Model(&model.Space{}).Where("id = ?", sID).
+		Where("logo_id = ? OR logo_mobile_id = ? OR fav_icon_id = ? OR mobile_icon_id = ?", medium.ID, medium.ID, medium.ID, medium.ID).
+		Find(&spaces).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, space := range spaces {
+		index.addSpace(space)
+	}
+
+	patterns := descriptionPatterns(medium.ID, mediumURLs(medium.URL))
+	for _, source := range usageSources {
+		condition := fmt.Sprint(source.medium, " = ?")
+		args := []interface{}{medium.ID}
+		if source.described {
+			for _, pattern := range patterns {
+				condition += " OR description @> ?"
+				args = append(args, pattern)
+			}
+		}
+
+		entities := make([]usageEntity, 0)
+		err = config.DB.Model(source.model).Select(source.columns()).Where("space_id = ?", sID).Where(condition, args...).Order("id").Find(&entities).Error
+		if err != nil {
+			return nil, err
+		}
+		// a block containing one of the urls may still embed another medium
+		// by its id, so the descriptions are checked again
+		source.addTo(index, entities, urls)
+	}
+
+	return index[medium.ID], nil
+}
+
+// columns selected from the entities of the source
+func (source usageSource) columns() string {
+	columns := fmt.Sprint("id, ", source.title, " AS title, ", source.medium, " AS medium_id")
+	if source.described {
+		columns += ", description"
+	}
+	if source.kind == "post" {
+		columns += ", is_page"
+	}
+	return columns
+}
+
+// addTo adds the usages of the media by the entities of the source
+func (source usageSource) addTo(index usageIndex, entities []usageEntity, urls map[string]uint) {
+	for _, entity := range entities {
+		kind := source.kind
+		if entity.IsPage {
+			kind = "page"
+		}
+
+		if entity.MediumID != nil && *entity.MediumID > 0 {
+			index.add(*entity.MediumID, usage{Kind: kind, ID: entity.ID, Title: entity.Title, Field: source.medium})
+		}
+
+		for _, id := range embeddedMedia(entity.Description, urls) {
+			index.add(id, usage{Kind: kind, ID: entity.ID, Title: entity.Title, Field: "description"})
+		}
+	}
+}
+
+// addSpace adds the logos and icons of the space
+func (index usageIndex) addSpace(space model.Space) {
+	fields := []string{"logo_id", "logo_mobile_id", "fav_icon_id", "mobile_icon_id"}
+	for i, id := range []*uint{space.LogoID, space.LogoMobileID, space.FavIconID, space.MobileIconID} {
+		if id != nil && *id > 0 {
+			index.add(*id, usage{Kind: "space", ID: space.ID, Title: space.Name, Field: fields[i]})
+		}
+	}
+}
+
+func (index usageIndex) add(id uint, u usage) {
+	for _, each := range index[id] {
+		if each == u {
+			return
+		}
+	}
+	index[id] = append(index[id], u)
+}
+
+// usageKinds returns the kinds of the entities of the usages
+func usageKinds(used []usage) []string {
+	kinds := make([]string, 0)
+	found := map[string]bool{}
+	for _, each := range used {
+		if !found[each.Kind] {
+			found[each.Kind] = true
+			kinds = append(kinds, each.Kind)
+		}
+	}
+	return kinds
+}
+
+// mediumURLs returns the urls of a medium, of its file, proxy, webp variant
+// and renditions
+func mediumURLs(url postgres.Jsonb) []string {
+	data := struct {
+		Raw        string `json:"raw"`
+		Proxy      string `json:"proxy"`
+		WebP       string `json:"webp"`
+		Renditions []struct {
+			URL  string `json:"url"`
+			WebP string `json:"webp"`
+		} `json:"renditions"`
+	}{}
+	if url.RawMessage == nil || json.Unmarshal(url.RawMessage, &data) != nil {
+		return nil
+	}
+
+	urls := make([]string, 0)
+	for _, each := range []string{data.Raw, data.Proxy, data.WebP} {
+		if each != "" {
+			urls = append(urls, each)
+		}
+	}
+	for _, rendition := range data.Renditions {
+		urls = append(urls, rendition.URL, rendition.WebP)
+	}
+	return urls
+}
+
+// descriptionPatterns returns the editorjs documents contained by the
+// descriptions embedding a medium, by its id or by one of its urls in the
+// blocks read by embeddedMedia
+func descriptionPatterns(id uint, urls []string) []string {
+	type object = map[string]interface{}
+	uppy := func(embedded object) []object {
+		return []object{
+			{"type": "uppy", "data": embedded},
+			{"type": "uppy", "data": object{"nodes": []object{embedded}}},
+		}
+	}
+
+	blocks := uppy(object{"id": id})
+	for _, url := range urls {
+		blocks = append(blocks, uppy(object{"url": object{"raw": url}})...)
+		blocks = append(blocks, uppy(object{"url": object{"proxy": url}})...)
+		blocks = append(blocks,
+			object{"type": "image", "data": object{"url": url}},
+			object{"type": "image", "data": object{"file": object{"url": url}}},
+		)
+	}
+
+	patterns := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		pattern, _ := json.Marshal(object{"blocks": []object{block}})
+		patterns = append(patterns, string(pattern))
+	}
+	return patterns
+}
+
+// embeddedMedia returns the media embedded in an editorjs description, by
+// their id in uppy blocks and by their url in uppy and image blocks
+func embeddedMedia(description postgres.Jsonb, urls map[string]uint) []uint {
+	editor := struct {
+		Blocks []struct {
+			Type string          `json:"type"`
+			Data json.RawMessage `json:"data"`
+		} `json:"blocks"`
+	}{}
+	if description.RawMessage == nil || json.Unmarshal(description.RawMessage, &editor) != nil {
+		return nil
+	}
+
+	type embedded struct {
+		ID  uint `json:"id"`
+		URL struct {
+			Raw   string `json:"raw"`
+			Proxy string `json:"proxy"`
+		} `json:"url"`
+	}
+
+	ids := make([]uint, 0)
+	byURL := func(url string) {
+		if id, found := urls[url]; found && url != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	for _, block := range editor.Blocks {
+		switch block.Type {
+		case "uppy":
+			data := struct {
+				embedded
+				Nodes []embedded `json:"nodes"`
+			}{}
+			if json.Unmarshal(block.Data, &data) != nil {
+				continue
+			}
+			for _, each := range append(data.Nodes, data.embedded) {
+				if each.ID > 0 {
+					ids = append(ids, each.ID)
+					continue
+				}
+				byURL(each.URL.Raw)
+				byURL(each.URL.Proxy)
+			}
+		case "image":
+			data := struct {
+				URL  string `json:"url"`
+				File struct {
+					URL string `json:"url"`
+				} `json:"file"`
+			}{}
+			if json.Unmarshal(block.Data, &data) != nil {
+				continue
+			}
+			byURL(data.URL)
+			byURL(data.File.URL)
+		}
+	}
+	return ids
+}
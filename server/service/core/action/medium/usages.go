@@ -0,0 +1,77 @@
+package medium
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// usages response
+type usagePaging struct {
+	Total int64   `json:"total"`
+	Nodes []usage `json:"nodes"`
+}
+
+// usages - Get usages of medium
+// @Summary Show where a medium is used
+// @Description Get the posts, pages, taxonomy, fact checks, podcasts and space settings using a medium, as their medium, logo or icon or as an image in their description
+// @Tags Medium
+// @ID get-medium-usages
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param medium_id path string true "Medium ID"
+// @Param X-Space header string true "Space ID"
+// @Success 200 {object} usagePaging
+// @Router /core/media/{medium_id}/usages [get]
+func usages(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	mediumID := chi.URLParam(r, "medium_id")
+	id, err := strconv.Atoi(mediumID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	medium := &model.Medium{}
+	medium.ID = uint(id)
+
+	err = config.DB.Model(&model.Medium{}).Where(&model.Medium{
+		SpaceID: uint(sID),
+	}).First(&medium).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	used, err := usagesOf(uint(sID), medium)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	result := usagePaging{}
+	result.Nodes = make([]usage, 0)
+	result.Nodes = append(result.Nodes, used...)
+	result.Total = int64(len(result.Nodes))
+
+	renderx.JSON(w, http.StatusOK, result)
+}
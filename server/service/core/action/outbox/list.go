@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+)
+
+type paging struct {
+	Total int64               `json:"total"`
+	Nodes []model.OutboxEvent `json:"nodes"`
+}
+
+// list - Get all outbox events
+// @Summary Show all outbox events
+// @Description Get all outbox events. Stuck events are pending events whose publish has failed at least once.
+// @Tags Outbox
+// @ID get-all-outbox-events
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param status query string false "pending or published"
+// @Param subject query string false "Subject"
+// @Param stuck query string false "true to list only stuck events"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} paging
+// @Router /core/outbox [get]
+func list(w http.ResponseWriter, r *http.Request) {
+	result := paging{}
+	result.Nodes = make([]model.OutboxEvent, 0)
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	tx := config.DB.Model(&model.OutboxEvent{})
+
+	if r.URL.Query().Get("stuck") == "true" {
+		tx.Where("status = ? AND attempts > 0", util.OutboxPending)
+	} else if status := r.URL.Query().Get("status"); status != "" {
+		tx.Where("status = ?", status)
+	}
+
+	if subject := r.URL.Query().Get("subject"); subject != "" {
+		tx.Where("subject = ?", subject)
+	}
+
+	err := tx.Count(&result.Total).Order("id desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
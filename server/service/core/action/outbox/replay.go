@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/relay"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// replay - Replay outbox event
+// @Summary Replay outbox event
+// @Description Queue an outbox event to be published again, whether it is stuck or was already published
+// @Tags Outbox
+// @ID replay-outbox-event
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param event_id path string true "Outbox Event ID"
+// @Success 200 {object} model.OutboxEvent
+// @Router /core/outbox/{event_id}/replay [post]
+func replay(w http.ResponseWriter, r *http.Request) {
+	eventID := chi.URLParam(r, "event_id")
+	id, err := strconv.Atoi(eventID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.OutboxEvent{}
+	result.ID = uint(id)
+
+	if err = config.DB.Model(&model.OutboxEvent{}).First(&result).Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	err = config.DB.Model(&result).Updates(map[string]interface{}{
+		"status":       util.OutboxPending,
+		"attempts":     0,
+		"last_error":   "",
+		"published_at": nil,
+	}).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	relay.Run()
+
+	config.DB.Model(&model.OutboxEvent{}).First(&result)
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+// replayAll - Replay stuck outbox events
+// @Summary Replay stuck outbox events
+// @Description Reset the attempts of all stuck outbox events and publish them right away
+// @Tags Outbox
+// @ID replay-stuck-outbox-events
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Success 200 {object} paging
+// @Router /core/outbox/replay [post]
+func replayAll(w http.ResponseWriter, r *http.Request) {
+	err := config.DB.Model(&model.OutboxEvent{}).Where("status = ? AND attempts > 0", util.OutboxPending).Updates(map[string]interface{}{
+		"attempts":   0,
+		"last_error": "",
+	}).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	relay.Run()
+
+	result := paging{}
+	result.Nodes = make([]model.OutboxEvent, 0)
+
+	err = config.DB.Model(&model.OutboxEvent{}).Where("status = ? AND attempts > 0", util.OutboxPending).Count(&result.Total).Order("id asc").Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
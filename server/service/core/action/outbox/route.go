@@ -0,0 +1,20 @@
+package outbox
+
+import (
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/middlewarex"
+	"github.com/go-chi/chi"
+)
+
+// Router outbox endpoint router
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	app := "dega"
+
+	r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Get("/", list)
+	r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Post("/replay", replayAll)
+	r.With(middlewarex.CheckSuperOrganisation(app, util.GetOrganisation)).Post("/{event_id}/replay", replay)
+
+	return r
+}
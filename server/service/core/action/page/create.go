@@ -7,15 +7,17 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -50,6 +52,13 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	oID, err := util.GetOrganisation(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
 	page := page{}
 	err = json.NewDecoder(r.Body).Decode(&page)
 	if err != nil {
@@ -65,6 +74,32 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if page.Status == "scheduled" {
+		if err = checkSchedule(&page); err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
+	// pages are published and scheduled with the permission to publish posts
+	status := "draft"
+	if page.Status == "publish" || page.Status == "scheduled" {
+		stat, err := util.CheckPublishPermission("posts", oID, sID, uID)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		if stat == http.StatusOK {
+			status = page.Status
+		}
+	}
+
+	if page.Status == "ready" {
+		status = "ready"
+	}
+
 	result := &pageData{}
 	result.Authors = make([]model.Author, 0)
 
@@ -87,7 +122,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(page.Description.RawMessage) > 0 && !reflect.DeepEqual(page.Description, test.NilJsonb()) {
+	if len(page.Description.RawMessage) > 0 && !reflect.DeepEqual(page.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(page.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -99,7 +134,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 	result.Post = model.Post{
 		Title:            page.Title,
 		Slug:             slugx.Approve(&config.DB, postSlug, sID, tableName),
-		Status:           page.Status,
+		Status:           status,
 		IsPage:           true,
 		Subtitle:         page.Subtitle,
 		Excerpt:          page.Excerpt,
@@ -116,6 +151,17 @@ func create(w http.ResponseWriter, r *http.Request) {
 		SpaceID:          uint(sID),
 	}
 
+	if status == "publish" {
+		if page.PublishedDate == nil {
+			currTime := time.Now()
+			result.Post.PublishedDate = &currTime
+		} else {
+			result.Post.PublishedDate = page.PublishedDate
+		}
+	} else if status == "scheduled" {
+		result.Post.PublishedDate = page.PublishedDate
+	}
+
 	if len(page.TagIDs) > 0 {
 		config.DB.Model(&model.Tag{}).Where(page.TagIDs).Find(&result.Post.Tags)
 	}
@@ -124,6 +170,19 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	result.Post.Locale, result.Post.TranslationGroupID, err = translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		Scope:   translation.IsPage(true),
+	}.Resolve(tx, page.Locale, page.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	err = tx.Model(&model.Post{}).Create(&result.Post).Error
 
 	if err != nil {
@@ -157,6 +216,13 @@ func create(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if err = revision.Create(tx, result.Post, revision.AuthorIDs(result.Authors), nil); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
 	// Insert into meili index
 	var meiliPublishDate int64
 	if result.Post.PublishedDate != nil {
@@ -188,17 +254,18 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("page.created", result); err != nil {
-			errorx.Render(w, errorx.Parser(errorx.GetMessage("not able to publish event", http.StatusInternalServerError)))
+		if err = util.Outbox(tx, "page.created", result); err != nil {
+			tx.Rollback()
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/revision"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
 	"github.com/go-chi/chi"
@@ -32,6 +33,8 @@ type page struct {
 	CategoryIDs      []uint         `json:"category_ids"`
 	TagIDs           []uint         `json:"tag_ids"`
 	AuthorIDs        []uint         `json:"author_ids"`
+	Locale           string         `json:"locale"`
+	TranslationOf    uint           `json:"translation_of"`
 }
 
 type pageData struct {
@@ -53,6 +56,7 @@ func Router() chi.Router {
 		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
 		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
 		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+		r.Mount("/revisions", revision.Router(entity))
 	})
 
 	return r
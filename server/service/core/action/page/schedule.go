@@ -0,0 +1,123 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+)
+
+// checkSchedule validates that a page can be scheduled for publishing
+func checkSchedule(page *page) error {
+	if page.PublishedDate == nil || !page.PublishedDate.After(time.Now()) {
+		return errors.New("cannot schedule page without a future published date")
+	}
+
+	return nil
+}
+
+// checkPublishPermission checks that the user can publish the pages of the
+// space, pages are published with the permission to publish posts
+func checkPublishPermission(ctx context.Context, sID, uID int) error {
+	oID, err := util.GetOrganisation(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := util.CheckPublishPermission("posts", oID, sID, uID)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.New("user does not have permission to publish pages")
+	}
+
+	return nil
+}
+
+// isPublished checks if the status is publish or scheduled
+func isPublished(status string) bool {
+	return status == "publish" || status == "scheduled"
+}
+
+// PublishScheduled publishes all the scheduled pages whose published date has arrived
+func PublishScheduled() error {
+	pages := make([]model.Post, 0)
+
+	err := config.DB.Model(&model.Post{}).Where("status = ? AND published_date <= ?", "scheduled", time.Now()).Where("is_page = ?", true).Preload("Medium").Preload("Format").Preload("Tags").Preload("Categories").Preload("Space").Find(&pages).Error
+	if err != nil {
+		return err
+	}
+
+	for _, page := range pages {
+		if err = publishScheduled(page); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
+}
+
+func publishScheduled(page model.Post) error {
+	tx := config.DB.Begin()
+
+	// only publish the page if it was not rescheduled in the meantime
+	res := tx.Model(&model.Post{}).Where("id = ? AND status = ?", page.ID, "scheduled").Update("status", "publish")
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil
+	}
+
+	page.Status = "publish"
+
+	result := &pageData{}
+	result.Post = page
+	result.Authors = make([]model.Author, 0)
+
+	pageAuthors := []model.PostAuthor{}
+	tx.Model(&model.PostAuthor{}).Where(&model.PostAuthor{
+		PostID: page.ID,
+	}).Find(&pageAuthors)
+
+	if len(pageAuthors) > 0 && page.Space != nil {
+		authors := author.Mapper(page.Space.OrganisationID, int(page.UpdatedByID))
+		for _, pageAuthor := range pageAuthors {
+			if author, found := authors[fmt.Sprint(pageAuthor.AuthorID)]; found {
+				result.Authors = append(result.Authors, author)
+			}
+		}
+	}
+
+	if config.SearchEnabled() {
+		_ = search.UpdateDocument(map[string]interface{}{
+			"id":             page.ID,
+			"kind":           "page",
+			"status":         page.Status,
+			"published_date": page.PublishedDate.Unix(),
+		})
+	}
+
+	if util.CheckNats() {
+		if err := util.Outbox(tx, "page.updated", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	tx.Commit()
+
+	return nil
+}
@@ -11,13 +11,14 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/dega-server/util/arrays"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -78,6 +79,13 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if page.Status == "scheduled" {
+		if err = checkSchedule(page); err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
 	result := &pageData{}
 	result.ID = uint(id)
 	result.Tags = make([]model.Tag, 0)
@@ -96,6 +104,15 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// publishing, scheduling and unpublishing a page need the permission to publish
+	if page.Status != "" && page.Status != result.Post.Status && (isPublished(page.Status) || isPublished(result.Post.Status)) {
+		if err = checkPublishPermission(r.Context(), sID, uID); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
 	// fetch all authors
 	authors, err := author.All(r.Context())
 	if err != nil {
@@ -122,7 +139,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(page.Description.RawMessage) > 0 && !reflect.DeepEqual(page.Description, test.NilJsonb()) {
+	if len(page.Description.RawMessage) > 0 && !reflect.DeepEqual(page.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(page.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -133,6 +150,21 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
 
+	locale, translationGroupID, err := translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		ID:      result.ID,
+		Locale:  result.Locale,
+		GroupID: result.TranslationGroupID,
+		Scope:   translation.IsPage(true),
+	}.Resolve(tx, page.Locale, page.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	newTags := make([]model.Tag, 0)
 	if len(page.TagIDs) > 0 {
 		config.DB.Model(&model.Tag{}).Where(page.TagIDs).Find(&newTags)
@@ -173,23 +205,25 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	updatedPage := model.Post{
-		Base:             config.Base{UpdatedByID: uint(uID)},
-		Title:            page.Title,
-		Slug:             pageSlug,
-		Subtitle:         page.Subtitle,
-		Status:           page.Status,
-		PublishedDate:    page.PublishedDate,
-		Excerpt:          page.Excerpt,
-		Description:      page.Description,
-		HTMLDescription:  description,
-		IsHighlighted:    page.IsHighlighted,
-		IsSticky:         page.IsSticky,
-		FormatID:         page.FormatID,
-		FeaturedMediumID: featuredMediumID,
-		Meta:             page.Meta,
-		MetaFields:       page.MetaFields,
-		HeaderCode:       page.HeaderCode,
-		FooterCode:       page.FooterCode,
+		Base:               config.Base{UpdatedByID: uint(uID)},
+		Title:              page.Title,
+		Slug:               pageSlug,
+		Subtitle:           page.Subtitle,
+		Status:             page.Status,
+		PublishedDate:      page.PublishedDate,
+		Excerpt:            page.Excerpt,
+		Description:        page.Description,
+		HTMLDescription:    description,
+		IsHighlighted:      page.IsHighlighted,
+		IsSticky:           page.IsSticky,
+		FormatID:           page.FormatID,
+		FeaturedMediumID:   featuredMediumID,
+		Meta:               page.Meta,
+		MetaFields:         page.MetaFields,
+		HeaderCode:         page.HeaderCode,
+		FooterCode:         page.FooterCode,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
 	}
 
 	tx.Model(&result.Post).Select("IsFeatured", "IsSticky", "IsHighlighted").Omit("Tags", "Categories").Updates(model.Post{
@@ -272,6 +306,13 @@ func update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if err = revision.Create(tx, result.Post, revision.AuthorIDs(result.Authors), nil); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
 	// Update into meili index
 	var meiliPublishDate int64
 	if result.Post.PublishedDate != nil {
@@ -303,17 +344,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
-	tx.Commit()
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("page.updated", result); err != nil {
+		if err = util.Outbox(tx, "page.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
@@ -0,0 +1,93 @@
+package persistedquery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/validationx"
+)
+
+// create - Create persisted query
+// @Summary Create persisted query
+// @Description Allow a graphql query on dega-api for the organisation. The hash is the sha256 of the query, as sent by automatic persisted query clients.
+// @Tags Persisted_Queries
+// @ID add-persisted-query
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param PersistedQuery body persistedQuery true "Persisted Query Object"
+// @Success 201 {object} model.PersistedQuery
+// @Failure 400 {array} string
+// @Router /core/persisted-queries [post]
+func create(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	oID, err := util.GetOrganisation(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	query := &persistedQuery{}
+
+	if err = json.NewDecoder(r.Body).Decode(&query); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	if validationError := validationx.Check(query); validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	sum := sha256.Sum256([]byte(query.Query))
+	hash := hex.EncodeToString(sum[:])
+
+	var sameHashCount int64
+	config.DB.Model(&model.PersistedQuery{}).Where(&model.PersistedQuery{
+		OrganisationID: uint(oID),
+		Hash:           hash,
+	}).Count(&sameHashCount)
+
+	if sameHashCount > 0 {
+		loggerx.Error(errors.New("persisted query already exists"))
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("persisted query already exists", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	result := &model.PersistedQuery{
+		Name:           query.Name,
+		Hash:           hash,
+		Query:          query.Query,
+		OrganisationID: uint(oID),
+	}
+
+	err = config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Create(&result).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
@@ -0,0 +1,61 @@
+package persistedquery
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// delete - Delete persisted query by id
+// @Summary Delete persisted query by id
+// @Description Delete persisted query by id
+// @Tags Persisted_Queries
+// @ID delete-persisted-query-by-id
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param query_id path string true "Persisted Query ID"
+// @Success 200
+// @Failure 400 {array} string
+// @Router  /core/persisted-queries/{query_id} [delete]
+func delete(w http.ResponseWriter, r *http.Request) {
+	oID, err := util.GetOrganisation(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	queryID := chi.URLParam(r, "query_id")
+	id, err := strconv.Atoi(queryID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.PersistedQuery{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.PersistedQuery{
+		OrganisationID: uint(oID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	config.DB.Delete(&result)
+
+	renderx.JSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,30 @@
+package persistedquery
+
+import (
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+)
+
+// persisted query request body
+type persistedQuery struct {
+	Name  string `json:"name" validate:"required,min=3,max=50"`
+	Query string `json:"query" validate:"required"`
+}
+
+var userContext config.ContextKey = "persisted_query_user"
+
+// Router - Group of persisted query router
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "persisted-queries"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", create)
+	r.Route("/{query_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+	})
+
+	return r
+}
@@ -23,8 +23,8 @@ func contains(s []string, e string) bool {
 
 // Composer create keto policy
 func Composer(oID int, sID int, inputPolicy policyReq) model.KetoPolicy {
-	allowedResources := []string{"categories", "formats", "media", "policies", "posts", "pages", "tags", "webhooks", "claims", "claimants", "fact-checks", "ratings", "google", "menus", "episodes", "podcasts"}
-	allowedActions := []string{"get", "create", "update", "delete", "publish"}
+	allowedResources := []string{"categories", "formats", "media", "policies", "posts", "pages", "tags", "webhooks", "claims", "claimants", "fact-checks", "ratings", "google", "menus", "episodes", "podcasts", "workflows", "videos"}
+	allowedActions := []string{"get", "create", "update", "delete", "publish", "purge"}
 	result := model.KetoPolicy{}
 
 	commanPolicyString := fmt.Sprint(":org:", oID, ":app:dega:space:", sID, ":")
@@ -2,14 +2,15 @@ package policy
 
 import (
 	"encoding/json"
+	"github.com/factly/dega-server/config"
 	"net/http"
 
 	"github.com/factly/dega-server/service/core/action/author"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 )
@@ -70,9 +71,9 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("policy.created", result); err != nil {
+		if err = util.Outbox(config.DB, "policy.created", result); err != nil {
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
@@ -89,5 +90,5 @@ func insertIntoMeili(result model.Policy) error {
 		"description": result.Description,
 	}
 
-	return meilisearchx.AddDocument("dega", meiliObj)
+	return search.AddDocument(meiliObj)
 }
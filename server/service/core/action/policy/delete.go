@@ -5,9 +5,9 @@ import (
 	"net/http"
 
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -68,7 +68,7 @@ func delete(w http.ResponseWriter, r *http.Request) {
 	defer resp.Body.Close()
 
 	objectID := fmt.Sprint("policy_", policyId)
-	_, err = meilisearchx.Client.Documents("dega").Delete(objectID)
+	err = search.DeleteObjects(objectID)
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
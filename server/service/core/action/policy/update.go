@@ -3,13 +3,14 @@ package policy
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/factly/dega-server/config"
 	"net/http"
 
 	"github.com/factly/dega-server/service/core/action/author"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -98,7 +99,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 		"description": result.Description,
 	}
 
-	err = meilisearchx.UpdateDocument("dega", meiliObj)
+	err = search.UpdateDocument(meiliObj)
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
@@ -106,9 +107,9 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("policy.updated", result); err != nil {
+		if err = util.Outbox(config.DB, "policy.updated", result); err != nil {
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
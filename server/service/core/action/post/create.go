@@ -11,13 +11,15 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
 	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/schemax"
@@ -102,6 +104,24 @@ func create(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if post.Status == "scheduled" {
+		if err = checkSchedule(&post); err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+
+		stat, err := getPublishPermissions(oID, sID, uID)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		if stat == http.StatusOK {
+			status = "scheduled"
+		}
+	}
+
 	if post.Status == "ready" {
 		status = "ready"
 	}
@@ -176,7 +196,7 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 
 	// Store HTML description
 	var description string
-	if len(post.Description.RawMessage) > 0 && !reflect.DeepEqual(post.Description, test.NilJsonb()) {
+	if len(post.Description.RawMessage) > 0 && !reflect.DeepEqual(post.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(post.Description)
 		if err != nil {
 			return nil, errorx.GetMessage("cannot parse post description", http.StatusUnprocessableEntity)
@@ -210,6 +230,8 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 		} else {
 			result.Post.PublishedDate = post.PublishedDate
 		}
+	} else if status == "scheduled" {
+		result.Post.PublishedDate = post.PublishedDate
 	} else {
 		result.Post.PublishedDate = nil
 	}
@@ -223,6 +245,17 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 
 	tx := config.DB.WithContext(context.WithValue(ctx, userContext, uID)).Begin()
 
+	result.Post.Locale, result.Post.TranslationGroupID, err = translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		Scope:   translation.IsPage(post.IsPage),
+	}.Resolve(tx, post.Locale, post.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		return nil, translation.Message(err)
+	}
+
 	err = tx.Model(&model.Post{}).Create(&result.Post).Error
 
 	if err != nil {
@@ -295,6 +328,8 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 		Authors: result.Authors,
 		Claims:  result.Claims,
 	}, *result.Space, ratings)
+	schemas = permalink.New(*result.Space).Schemas(schemas, result.Post, result.Authors)
+	schemas = translation.Schemas(tx, result.Post, *result.Space, schemas)
 
 	byteArr, err := json.Marshal(schemas)
 	if err != nil {
@@ -308,6 +343,18 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 
 	result.Post.Schemas = postgres.Jsonb{RawMessage: byteArr}
 
+	if err = translation.Refresh(tx, result.Post, *result.Space); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		return nil, errorx.DBError()
+	}
+
+	if err = revision.Create(tx, result.Post, revision.AuthorIDs(result.Authors), result.ClaimOrder); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		return nil, errorx.DBError()
+	}
+
 	// Insert into meili index
 	var meiliPublishDate int64
 	if result.Post.Status == "publish" {
@@ -339,22 +386,31 @@ func createPost(ctx context.Context, post post, status string) (*postData, error
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("post.created", result); err != nil {
-			return nil, errorx.GetMessage("not able to publish event", http.StatusInternalServerError)
+		if err = util.Outbox(tx, "post.created", result); err != nil {
+			tx.Rollback()
+			return nil, errorx.DBError()
 		}
 
 		if result.Post.Status == "publish" {
-			if err = util.NC.Publish("post.published", result); err != nil {
-				return nil, errorx.GetMessage("not able to publish event", http.StatusInternalServerError)
+			if err = util.Outbox(tx, "post.published", result); err != nil {
+				tx.Rollback()
+				return nil, errorx.DBError()
+			}
+		}
+
+		if result.Post.Status == "scheduled" {
+			if err = util.Outbox(tx, "post.scheduled", result); err != nil {
+				tx.Rollback()
+				return nil, errorx.DBError()
 			}
 		}
 	}
 
+	tx.Commit()
+
 	return result, errorx.Message{}
 }
@@ -8,9 +8,9 @@ import (
 	"github.com/factly/dega-server/service/core/model"
 	factcheckModel "github.com/factly/dega-server/service/fact-check/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -61,13 +61,9 @@ func delete(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.Begin()
 
-	// delete all associations
-	if len(result.Tags) > 0 {
-		_ = tx.Model(&result).Association("Tags").Delete(result.Tags)
-	}
-	if len(result.Categories) > 0 {
-		_ = tx.Model(&result).Association("Categories").Delete(result.Categories)
-	}
+	// the post keeps its tags and categories, and its authors and claims are
+	// deleted after it, so that they are restored along with it from the trash
+	tx.Model(&model.Post{}).Delete(&result)
 
 	tx.Model(&model.PostAuthor{}).Where(&model.PostAuthor{
 		PostID: uint(id),
@@ -77,21 +73,24 @@ func delete(w http.ResponseWriter, r *http.Request) {
 		PostID: uint(id),
 	}).Delete(&factcheckModel.PostClaim{})
 
-	tx.Model(&model.Post{}).Delete(&result)
-
-	if config.SearchEnabled() {
-		_ = meilisearchx.DeleteDocument("dega", result.ID, "post")
-	}
-
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("post.deleted", result); err != nil {
+		if err = util.Outbox(tx, "post.deleted", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if config.SearchEnabled() {
+		_ = search.DeleteDocument(result.ID, "post")
+	}
+
 	renderx.JSON(w, http.StatusOK, nil)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/factly/dega-server/service/core/action/author"
 	"github.com/factly/dega-server/service/core/model"
 	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/meilisearchx"
@@ -43,6 +44,7 @@ type paging struct {
 // @Param sort query string false "Sort"
 // @Param category query string false "Category"
 // @Param status query string false "Status"
+// @Param stage query string false "Workflow Stage"
 // @Success 200 {array} postData
 // @Router /core/posts [get]
 func list(w http.ResponseWriter, r *http.Request) {
@@ -91,25 +93,28 @@ func list(w http.ResponseWriter, r *http.Request) {
 		tx.Where("format_id IN (?)", formatIDs)
 	}
 
+	stageIDs := make([]uint, 0)
+	for _, sid := range queryMap["stage"] {
+		sidStr, _ := strconv.Atoi(sid)
+		stageIDs = append(stageIDs, uint(sidStr))
+	}
+
+	if len(stageIDs) > 0 {
+		tx.Where("workflow_stage_id IN (?)", stageIDs)
+	}
+
 	filters := generateFilters(queryMap["tag"], queryMap["category"], queryMap["author"], queryMap["status"])
 	if filters != "" || searchQuery != "" {
 
 		if config.SearchEnabled() {
-			if filters != "" {
-				filters = fmt.Sprint(filters, " AND space_id=", sID)
-			}
 			// Search posts with filter
 			var hits []interface{}
-			var res map[string]interface{}
-
-			if searchQuery != "" {
-				hits, err = meilisearchx.SearchWithQuery("dega", searchQuery, filters, "post")
-			} else {
-				res, err = meilisearchx.SearchWithoutQuery("dega", filters, "post")
-				if _, found := res["hits"]; found {
-					hits = res["hits"].([]interface{})
-				}
-			}
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Filters: filters,
+				Kinds:   []string{"post"},
+			})
 			if err != nil {
 				loggerx.Error(err)
 				errorx.Render(w, errorx.Parser(errorx.NetworkError()))
@@ -4,6 +4,9 @@ import (
 	"time"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/comment"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/action/workflow"
 	"github.com/factly/dega-server/service/core/model"
 	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
 	"github.com/factly/dega-server/util"
@@ -35,6 +38,8 @@ type post struct {
 	TagIDs           []uint         `json:"tag_ids"`
 	ClaimIDs         []uint         `json:"claim_ids"`
 	AuthorIDs        []uint         `json:"author_ids"`
+	Locale           string         `json:"locale"`
+	TranslationOf    uint           `json:"translation_of"`
 }
 
 type postData struct {
@@ -60,6 +65,9 @@ func Router() chi.Router {
 		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
 		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
 		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+		r.Mount("/revisions", revision.Router(entity))
+		r.Mount("/workflow", workflow.PostRouter())
+		r.Mount("/comments", comment.Router(entity))
 	})
 
 	return r
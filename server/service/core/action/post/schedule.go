@@ -0,0 +1,120 @@
+package post
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+)
+
+// checkSchedule validates that a post can be scheduled for publishing
+func checkSchedule(post *post) error {
+	if post.PublishedDate == nil || !post.PublishedDate.After(time.Now()) {
+		return errors.New("cannot schedule post without a future published date")
+	}
+
+	if len(post.AuthorIDs) == 0 {
+		return errors.New("cannot schedule post without author")
+	}
+
+	return nil
+}
+
+// PublishScheduled publishes all the scheduled posts whose published date has arrived
+func PublishScheduled() error {
+	posts := make([]model.Post, 0)
+
+	err := config.DB.Model(&model.Post{}).Where("status = ? AND published_date <= ?", "scheduled", time.Now()).Where("is_page = ?", false).Preload("Medium").Preload("Format").Preload("Tags").Preload("Categories").Preload("Space").Find(&posts).Error
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		if err = publishScheduled(post); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
+}
+
+func publishScheduled(post model.Post) error {
+	tx := config.DB.Begin()
+
+	// only publish the post if it was not rescheduled in the meantime
+	res := tx.Model(&model.Post{}).Where("id = ? AND status = ?", post.ID, "scheduled").Update("status", "publish")
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil
+	}
+
+	post.Status = "publish"
+
+	result := &postData{}
+	result.Post = post
+	result.Authors = make([]model.Author, 0)
+	result.Claims = make([]factCheckModel.Claim, 0)
+
+	if post.Format != nil && post.Format.Slug == "fact-check" {
+		postClaims := []factCheckModel.PostClaim{}
+		tx.Model(&factCheckModel.PostClaim{}).Where(&factCheckModel.PostClaim{
+			PostID: post.ID,
+		}).Preload("Claim").Preload("Claim.Rating").Preload("Claim.Rating.Medium").Preload("Claim.Claimant").Preload("Claim.Claimant.Medium").Find(&postClaims)
+
+		result.ClaimOrder = make([]uint, len(postClaims))
+		for _, postClaim := range postClaims {
+			result.Claims = append(result.Claims, postClaim.Claim)
+			result.ClaimOrder[int(postClaim.Position-1)] = postClaim.ClaimID
+		}
+	}
+
+	postAuthors := []model.PostAuthor{}
+	tx.Model(&model.PostAuthor{}).Where(&model.PostAuthor{
+		PostID: post.ID,
+	}).Find(&postAuthors)
+
+	if len(postAuthors) > 0 && post.Space != nil {
+		authors := author.Mapper(post.Space.OrganisationID, int(post.UpdatedByID))
+		for _, postAuthor := range postAuthors {
+			if author, found := authors[fmt.Sprint(postAuthor.AuthorID)]; found {
+				result.Authors = append(result.Authors, author)
+			}
+		}
+	}
+
+	if config.SearchEnabled() {
+		_ = search.UpdateDocument(map[string]interface{}{
+			"id":             post.ID,
+			"kind":           "post",
+			"status":         post.Status,
+			"published_date": post.PublishedDate.Unix(),
+		})
+	}
+
+	if util.CheckNats() {
+		if err := util.Outbox(tx, "post.updated", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := util.Outbox(tx, "post.published", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	tx.Commit()
+
+	return nil
+}
@@ -12,14 +12,17 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/action/translation"
+	"github.com/factly/dega-server/service/core/action/workflow"
 	"github.com/factly/dega-server/service/core/model"
 	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/dega-server/util/arrays"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/schemax"
@@ -137,7 +140,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(post.Description.RawMessage) > 0 && !reflect.DeepEqual(post.Description, test.NilJsonb()) {
+	if len(post.Description.RawMessage) > 0 && !reflect.DeepEqual(post.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(post.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -148,6 +151,21 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
 
+	locale, translationGroupID, err := translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		ID:      result.ID,
+		Locale:  result.Locale,
+		GroupID: result.TranslationGroupID,
+		Scope:   translation.IsPage(false),
+	}.Resolve(tx, post.Locale, post.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	newTags := make([]model.Tag, 0)
 	if len(post.TagIDs) > 0 {
 		config.DB.Model(&model.Tag{}).Where(post.TagIDs).Find(&newTags)
@@ -188,21 +206,23 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	updatedPost := model.Post{
-		Base:             config.Base{UpdatedByID: uint(uID)},
-		Title:            post.Title,
-		Slug:             postSlug,
-		Subtitle:         post.Subtitle,
-		Excerpt:          post.Excerpt,
-		Description:      post.Description,
-		HTMLDescription:  description,
-		IsHighlighted:    post.IsHighlighted,
-		IsSticky:         post.IsSticky,
-		FormatID:         post.FormatID,
-		FeaturedMediumID: featuredMediumID,
-		Meta:             post.Meta,
-		HeaderCode:       post.HeaderCode,
-		FooterCode:       post.FooterCode,
-		MetaFields:       post.MetaFields,
+		Base:               config.Base{UpdatedByID: uint(uID)},
+		Title:              post.Title,
+		Slug:               postSlug,
+		Subtitle:           post.Subtitle,
+		Excerpt:            post.Excerpt,
+		Description:        post.Description,
+		HTMLDescription:    description,
+		IsHighlighted:      post.IsHighlighted,
+		IsSticky:           post.IsSticky,
+		FormatID:           post.FormatID,
+		FeaturedMediumID:   featuredMediumID,
+		Meta:               post.Meta,
+		HeaderCode:         post.HeaderCode,
+		FooterCode:         post.FooterCode,
+		MetaFields:         post.MetaFields,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
 	}
 
 	oldStatus := result.Post.Status
@@ -251,12 +271,53 @@ func update(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
+	} else if post.Status == "scheduled" {
+		if err = checkSchedule(post); err != nil {
+			tx.Rollback()
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+
+		status, err := getPublishPermissions(oID, sID, uID)
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+		if status == http.StatusOK {
+			updatedPost.Status = "scheduled"
+			updatedPost.PublishedDate = post.PublishedDate
+		} else {
+			tx.Rollback()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
 	} else if post.Status == "ready" {
 		updatedPost.Status = "ready"
-	} else if oldStatus == "ready" && post.Status == "draft" {
+	} else if (oldStatus == "ready" || oldStatus == "scheduled") && post.Status == "draft" {
 		updatedPost.Status = "draft"
 	}
 
+	// in spaces with a workflow the post moves to the stage of its new status
+	if updatedPost.Status != "" && updatedPost.Status != oldStatus {
+		if err = workflow.Route(tx, &result.Post, updatedPost.Status, uID); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			if errors.Is(err, workflow.ErrTransitionNotAllowed) {
+				errorx.Render(w, errorx.Parser(errorx.GetMessage("status change not allowed by the workflow of the post", http.StatusUnprocessableEntity)))
+				return
+			}
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	// Check if post is taken off the schedule
+	if oldStatus == "scheduled" && (post.Status == "draft" || post.Status == "ready") {
+		tx.Model(&result.Post).Select("PublishedDate").Omit("Tags", "Categories").Updates(model.Post{PublishedDate: nil})
+	}
+
 	tx.Model(&result.Post).Select("IsFeatured", "IsSticky", "IsHighlighted", "IsPage").Omit("Tags", "Categories").Updates(model.Post{
 		IsFeatured:    post.IsFeatured,
 		IsSticky:      post.IsSticky,
@@ -400,6 +461,8 @@ func update(w http.ResponseWriter, r *http.Request) {
 		Authors: result.Authors,
 		Claims:  result.Claims,
 	}, *result.Space, ratings)
+	schemas = permalink.New(*result.Space).Schemas(schemas, result.Post, result.Authors)
+	schemas = translation.Schemas(tx, result.Post, *result.Space, schemas)
 
 	byteArr, err := json.Marshal(schemas)
 	if err != nil {
@@ -414,6 +477,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	result.Post.Schemas = postgres.Jsonb{RawMessage: byteArr}
 
+	if err = translation.Refresh(tx, result.Post, *result.Space); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if err = revision.Create(tx, result.Post, revision.AuthorIDs(result.Authors), result.ClaimOrder); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
 	// Update into meili index
 	var meiliPublishDate int64
 	if result.Post.Status == "publish" {
@@ -445,39 +522,52 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
-	tx.Commit()
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("post.updated", result); err != nil {
+		if err = util.Outbox(tx, "post.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 		if result.Post.Status == "publish" {
-			if err = util.NC.Publish("post.published", result); err != nil {
+			if err = util.Outbox(tx, "post.published", result); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+		if oldStatus == "publish" && (result.Post.Status == "draft" || result.Post.Status == "ready" || result.Post.Status == "scheduled") {
+			if err = util.Outbox(tx, "post.unpublished", result); err != nil {
+				tx.Rollback()
 				loggerx.Error(err)
-				errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
 				return
 			}
 		}
-		if oldStatus == "publish" && (result.Post.Status == "draft" || result.Post.Status == "ready") {
-			if err = util.NC.Publish("post.unpublished", result); err != nil {
+		if oldStatus != "scheduled" && result.Post.Status == "scheduled" {
+			if err = util.Outbox(tx, "post.scheduled", result); err != nil {
+				tx.Rollback()
 				loggerx.Error(err)
-				errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
 				return
 			}
 		}
 		if (oldStatus == "publish" || oldStatus == "draft") && result.Post.Status == "ready" {
-			if err = util.NC.Publish("post.ready", result); err != nil {
+			if err = util.Outbox(tx, "post.ready", result); err != nil {
+				tx.Rollback()
 				loggerx.Error(err)
-				errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
 				return
 			}
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
 
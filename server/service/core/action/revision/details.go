@@ -0,0 +1,71 @@
+package revision
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// details - Get post revision by id
+// @Summary Show a post revision by id
+// @Description Get post revision by ID
+// @Tags Revision
+// @ID get-post-revision-by-id
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200 {object} model.PostRevision
+// @Router /core/posts/{post_id}/revisions/{revision_id} [get]
+func details(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, isPage, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	revisionID, err := strconv.Atoi(chi.URLParam(r, "revision_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result, err := find(uint(sID), uint(id), uint(revisionID), isPage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+func find(sID, postID, revisionID uint, isPage bool) (*model.PostRevision, error) {
+	result := &model.PostRevision{}
+	result.ID = revisionID
+
+	err := config.DB.Model(&model.PostRevision{}).Where(&model.PostRevision{
+		PostID:  postID,
+		SpaceID: sID,
+	}).Where("is_page = ?", isPage).First(&result).Error
+
+	return result, err
+}
@@ -0,0 +1,147 @@
+package revision
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util/arrays"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// diff - Get field level diff between two revisions of a post
+// @Summary Show diff between two revisions of a post
+// @Description Get field level diff between two revisions of a post
+// @Tags Revision
+// @ID get-post-revisions-diff
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param from query string true "Revision ID to compare from"
+// @Param to query string true "Revision ID to compare to"
+// @Success 200 {object} diffData
+// @Router /core/posts/{post_id}/revisions/diff [get]
+func diff(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, isPage, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	fromID, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	toID, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	from, err := find(uint(sID), uint(id), uint(fromID), isPage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	to, err := find(uint(sID), uint(id), uint(toID), isPage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result := diffData{
+		From:    *from,
+		To:      *to,
+		Changes: compare(*from, *to),
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+// compare returns the fields which differ between two revisions
+func compare(from, to model.PostRevision) []fieldDiff {
+	changes := make([]fieldDiff, 0)
+
+	if from.Title != to.Title {
+		changes = append(changes, fieldDiff{Field: "title", From: from.Title, To: to.Title})
+	}
+
+	if from.Excerpt != to.Excerpt {
+		changes = append(changes, fieldDiff{Field: "excerpt", From: from.Excerpt, To: to.Excerpt})
+	}
+
+	if !equalJSON(from.Description, to.Description) {
+		changes = append(changes, fieldDiff{Field: "description", From: from.Description, To: to.Description})
+	}
+
+	idFields := []struct {
+		name     string
+		from, to postgres.Jsonb
+	}{
+		{"tag_ids", from.TagIDs, to.TagIDs},
+		{"category_ids", from.CategoryIDs, to.CategoryIDs},
+		{"author_ids", from.AuthorIDs, to.AuthorIDs},
+	}
+
+	for _, field := range idFields {
+		prev, next := toIDs(field.from), toIDs(field.to)
+		added, removed := arrays.Difference(prev, next)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, fieldDiff{Field: field.name, From: prev, To: next, Added: added, Removed: removed})
+		}
+	}
+
+	// claim order is positional so any reordering counts as a change
+	prevClaims, nextClaims := toIDs(from.ClaimOrder), toIDs(to.ClaimOrder)
+	if !equalOrder(prevClaims, nextClaims) {
+		added, removed := arrays.Difference(prevClaims, nextClaims)
+		changes = append(changes, fieldDiff{Field: "claim_order", From: prevClaims, To: nextClaims, Added: added, Removed: removed})
+	}
+
+	return changes
+}
+
+func equalJSON(a, b postgres.Jsonb) bool {
+	var x, y interface{}
+	if json.Unmarshal(a.RawMessage, &x) != nil || json.Unmarshal(b.RawMessage, &y) != nil {
+		return bytes.Equal(a.RawMessage, b.RawMessage)
+	}
+	xb, _ := json.Marshal(x)
+	yb, _ := json.Marshal(y)
+	return bytes.Equal(xb, yb)
+}
+
+func equalOrder(a, b []uint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package revision
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+)
+
+// list response
+type paging struct {
+	Total int64                `json:"total"`
+	Nodes []model.PostRevision `json:"nodes"`
+}
+
+// list - Get all revisions of a post
+// @Summary Show all revisions of a post
+// @Description Get all revisions of a post, latest first
+// @Tags Revision
+// @ID get-all-post-revisions
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} paging
+// @Router /core/posts/{post_id}/revisions [get]
+func list(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, isPage, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	result := paging{}
+	result.Nodes = make([]model.PostRevision, 0)
+
+	err = config.DB.Model(&model.PostRevision{}).Where(&model.PostRevision{
+		PostID:  uint(id),
+		SpaceID: uint(sID),
+	}).Where("is_page = ?", isPage).Count(&result.Total).Order("id desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
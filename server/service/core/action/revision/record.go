@@ -0,0 +1,62 @@
+package revision
+
+import (
+	"encoding/json"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Create records an immutable snapshot of the post in the given transaction
+func Create(tx *gorm.DB, post model.Post, authorIDs, claimOrder []uint) error {
+	tagIDs := make([]uint, 0)
+	for _, tag := range post.Tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	categoryIDs := make([]uint, 0)
+	for _, category := range post.Categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	revision := model.PostRevision{
+		PostID:      post.ID,
+		SpaceID:     post.SpaceID,
+		IsPage:      post.IsPage,
+		Title:       post.Title,
+		Excerpt:     post.Excerpt,
+		Description: post.Description,
+		TagIDs:      toJsonb(tagIDs),
+		CategoryIDs: toJsonb(categoryIDs),
+		AuthorIDs:   toJsonb(authorIDs),
+		ClaimOrder:  toJsonb(claimOrder),
+	}
+
+	return tx.Model(&model.PostRevision{}).Create(&revision).Error
+}
+
+// AuthorIDs returns the ids of given authors
+func AuthorIDs(authors []model.Author) []uint {
+	ids := make([]uint, 0)
+	for _, author := range authors {
+		ids = append(ids, author.ID)
+	}
+	return ids
+}
+
+func toJsonb(ids []uint) postgres.Jsonb {
+	if ids == nil {
+		ids = make([]uint, 0)
+	}
+	byteArr, _ := json.Marshal(ids)
+	return postgres.Jsonb{RawMessage: byteArr}
+}
+
+func toIDs(jsonb postgres.Jsonb) []uint {
+	ids := make([]uint, 0)
+	if len(jsonb.RawMessage) > 0 {
+		_ = json.Unmarshal(jsonb.RawMessage, &ids)
+	}
+	return ids
+}
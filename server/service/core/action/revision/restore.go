@@ -0,0 +1,327 @@
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/translation"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/schemax"
+	"github.com/go-chi/chi"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+type restoreData struct {
+	model.Post
+	Authors    []model.Author         `json:"authors"`
+	Claims     []factCheckModel.Claim `json:"claims"`
+	ClaimOrder []uint                 `json:"claim_order"`
+}
+
+var userContext config.ContextKey = "post_user"
+
+// restore - Restore post to a revision
+// @Summary Restore a post to a revision
+// @Description Restore post to the state recorded in a revision. The restored state is recorded as a new revision.
+// @Tags Revision
+// @ID restore-post-revision
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200 {object} restoreData
+// @Router /core/posts/{post_id}/revisions/{revision_id}/restore [post]
+func restore(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, isPage, err := parent(r)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	revisionID, err := strconv.Atoi(chi.URLParam(r, "revision_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	revision, err := find(uint(sID), uint(id), uint(revisionID), isPage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result := &restoreData{}
+	result.ID = uint(id)
+	result.Authors = make([]model.Author, 0)
+	result.Claims = make([]factCheckModel.Claim, 0)
+
+	// check record exists or not
+	err = config.DB.Where(&model.Post{
+		SpaceID: uint(sID),
+	}).Where("is_page = ?", isPage).First(&result.Post).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	// fetch all authors
+	authors, err := author.All(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	// Store HTML description
+	var description string
+	if len(revision.Description.RawMessage) > 0 && !reflect.DeepEqual(revision.Description, util.NilJsonb()) {
+		description, err = util.HTMLDescription(revision.Description)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot parse post description", http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	tagIDs := toIDs(revision.TagIDs)
+	newTags := make([]model.Tag, 0)
+	if len(tagIDs) > 0 {
+		config.DB.Model(&model.Tag{}).Where(tagIDs).Find(&newTags)
+		err = tx.Model(&result.Post).Association("Tags").Replace(&newTags)
+	} else {
+		err = tx.Model(&result.Post).Association("Tags").Clear()
+	}
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	categoryIDs := toIDs(revision.CategoryIDs)
+	newCategories := make([]model.Category, 0)
+	if len(categoryIDs) > 0 {
+		config.DB.Model(&model.Category{}).Where(categoryIDs).Find(&newCategories)
+		err = tx.Model(&result.Post).Association("Categories").Replace(&newCategories)
+	} else {
+		err = tx.Model(&result.Post).Association("Categories").Clear()
+	}
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	err = tx.Model(&result.Post).Select("Title", "Excerpt", "Description", "HTMLDescription", "UpdatedByID").Omit("Tags", "Categories").Updates(model.Post{
+		Base:            config.Base{UpdatedByID: uint(uID)},
+		Title:           revision.Title,
+		Excerpt:         revision.Excerpt,
+		Description:     revision.Description,
+		HTMLDescription: description,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	// replace post authors
+	err = tx.Where(&model.PostAuthor{
+		PostID: uint(id),
+	}).Delete(&model.PostAuthor{}).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	authorIDs := make([]uint, 0)
+	for _, aID := range toIDs(revision.AuthorIDs) {
+		if author, found := authors[fmt.Sprint(aID)]; found && aID != 0 {
+			err = tx.Model(&model.PostAuthor{}).Create(&model.PostAuthor{
+				AuthorID: aID,
+				PostID:   uint(id),
+			}).Error
+			if err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+			authorIDs = append(authorIDs, aID)
+			result.Authors = append(result.Authors, author)
+		}
+	}
+
+	err = tx.Model(&model.Post{}).Preload("Medium").Preload("Format").Preload("Tags").Preload("Categories").Preload("Space").Preload("Space.Logo").First(&result.Post).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if !isPage && result.Format != nil && result.Format.Slug == "fact-check" {
+		// replace post claims keeping the recorded order
+		err = tx.Where(&factCheckModel.PostClaim{
+			PostID: uint(id),
+		}).Delete(&factCheckModel.PostClaim{}).Error
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+
+		toCreatePostClaims := make([]factCheckModel.PostClaim, 0)
+		for i, cID := range toIDs(revision.ClaimOrder) {
+			postClaim := factCheckModel.PostClaim{}
+			postClaim.ClaimID = cID
+			postClaim.PostID = uint(id)
+			postClaim.Position = uint(i + 1)
+			toCreatePostClaims = append(toCreatePostClaims, postClaim)
+		}
+
+		if len(toCreatePostClaims) > 0 {
+			err = tx.Model(&factCheckModel.PostClaim{}).Create(&toCreatePostClaims).Error
+			if err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+
+		postClaims := []factCheckModel.PostClaim{}
+		tx.Model(&factCheckModel.PostClaim{}).Where(&factCheckModel.PostClaim{
+			PostID: uint(id),
+		}).Preload("Claim").Preload("Claim.Rating").Preload("Claim.Rating.Medium").Preload("Claim.Claimant").Preload("Claim.Claimant.Medium").Find(&postClaims)
+
+		result.ClaimOrder = make([]uint, len(postClaims))
+		for _, postClaim := range postClaims {
+			result.Claims = append(result.Claims, postClaim.Claim)
+			result.ClaimOrder[int(postClaim.Position-1)] = postClaim.ClaimID
+		}
+	}
+
+	if !isPage {
+		ratings := make([]factCheckModel.Rating, 0)
+		config.DB.Model(&factCheckModel.Rating{}).Where(factCheckModel.Rating{
+			SpaceID: uint(sID),
+		}).Order("numeric_value asc").Find(&ratings)
+
+		schemas := schemax.GetSchemas(schemax.PostData{
+			Post:    result.Post,
+			Authors: result.Authors,
+			Claims:  result.Claims,
+		}, *result.Space, ratings)
+		schemas = permalink.New(*result.Space).Schemas(schemas, result.Post, result.Authors)
+		schemas = translation.Schemas(tx, result.Post, *result.Space, schemas)
+
+		byteArr, err := json.Marshal(schemas)
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+		tx.Model(&result.Post).Select("Schemas").Updates(&model.Post{
+			Schemas: postgres.Jsonb{RawMessage: byteArr},
+		})
+
+		result.Post.Schemas = postgres.Jsonb{RawMessage: byteArr}
+
+		// the slug of the post may have changed
+		if err = translation.Refresh(tx, result.Post, *result.Space); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	// record the restored state as the latest revision
+	if err = Create(tx, result.Post, authorIDs, result.ClaimOrder); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	kind := "post"
+	if isPage {
+		kind = "page"
+	}
+
+	// Update into meili index
+	meiliObj := map[string]interface{}{
+		"id":           result.ID,
+		"kind":         kind,
+		"title":        result.Title,
+		"excerpt":      result.Excerpt,
+		"description":  result.Description,
+		"tag_ids":      tagIDs,
+		"category_ids": categoryIDs,
+		"author_ids":   authorIDs,
+	}
+
+	if !isPage && result.Format != nil && result.Format.Slug == "fact-check" {
+		meiliObj["claim_ids"] = result.ClaimOrder
+	}
+
+	if config.SearchEnabled() {
+		_ = search.UpdateDocument(meiliObj)
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, kind+".updated", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
+}
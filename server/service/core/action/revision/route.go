@@ -0,0 +1,51 @@
+package revision
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+)
+
+// revision diff response
+type fieldDiff struct {
+	Field   string      `json:"field"`
+	From    interface{} `json:"from"`
+	To      interface{} `json:"to"`
+	Added   []uint      `json:"added,omitempty"`
+	Removed []uint      `json:"removed,omitempty"`
+}
+
+type diffData struct {
+	From    model.PostRevision `json:"from"`
+	To      model.PostRevision `json:"to"`
+	Changes []fieldDiff        `json:"changes"`
+}
+
+// Router - Group of revision router, mounted under posts and pages
+func Router(entity string) chi.Router {
+	r := chi.NewRouter()
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/diff", diff)
+
+	r.Route("/{revision_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
+		r.With(util.CheckKetoPolicy(entity, "update")).Post("/restore", restore)
+	})
+
+	return r
+}
+
+// parent returns the id of the post or page whose revisions are requested
+func parent(r *http.Request) (int, bool, error) {
+	if pageID := chi.URLParam(r, "page_id"); pageID != "" {
+		id, err := strconv.Atoi(pageID)
+		return id, true, err
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "post_id"))
+	return id, false, err
+}
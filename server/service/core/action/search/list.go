@@ -3,12 +3,9 @@ package search
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 
-	"github.com/factly/x/meilisearchx"
-	"github.com/meilisearch/meilisearch-go"
-
+	index "github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
@@ -51,17 +48,17 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Add spaceId filter
-	var filters string = fmt.Sprint("space_id=", sID)
-	if len(searchQuery.Filters) > 0 {
-		filters = fmt.Sprint(searchQuery.Filters, " AND ", filters)
+	limit := searchQuery.Limit
+	if limit == 0 {
+		limit = 20
 	}
 
-	result, err := meilisearchx.Client.Search("dega").Search(meilisearch.SearchRequest{
-		Query:        searchQuery.Query,
-		Limit:        searchQuery.Limit,
-		Filters:      filters,
+	hits, err := index.Search(index.Query{
+		Text:         searchQuery.Query,
+		SpaceID:      uint(sID),
+		Filters:      searchQuery.Filters,
 		FacetFilters: searchQuery.FacetFilters,
+		Limit:        limit,
 	})
 
 	if err != nil {
@@ -70,5 +67,5 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, result.Hits)
+	renderx.JSON(w, http.StatusOK, hits)
 }
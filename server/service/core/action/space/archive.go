@@ -0,0 +1,251 @@
+package space
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+)
+
+// ArchiveVersion is the version of the archive format written by Export
+const ArchiveVersion = 1
+
+// Archive formats
+const (
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+)
+
+// PostTag row of the post_tags join table
+type PostTag struct {
+	PostID uint `gorm:"column:post_id" json:"post_id"`
+	TagID  uint `gorm:"column:tag_id" json:"tag_id"`
+}
+
+// PostCategory row of the post_categories join table
+type PostCategory struct {
+	PostID     uint `gorm:"column:post_id" json:"post_id"`
+	CategoryID uint `gorm:"column:category_id" json:"category_id"`
+}
+
+// VideoTag row of the video_tags join table
+type VideoTag struct {
+	VideoID uint `gorm:"column:video_id" json:"video_id"`
+	TagID   uint `gorm:"column:tag_id" json:"tag_id"`
+}
+
+// VideoCategory row of the video_categories join table
+type VideoCategory struct {
+	VideoID    uint `gorm:"column:video_id" json:"video_id"`
+	CategoryID uint `gorm:"column:category_id" json:"category_id"`
+}
+
+// PodcastCategory row of the podcast_categories join table
+type PodcastCategory struct {
+	PodcastID  uint `gorm:"column:podcast_id" json:"podcast_id"`
+	CategoryID uint `gorm:"column:category_id" json:"category_id"`
+}
+
+// Archive is a portable copy of everything a space owns. IDs are the ones of
+// the exporting instance and are remapped on import.
+type Archive struct {
+	Version           int                          `json:"version"`
+	ExportedAt        time.Time                    `json:"exported_at"`
+	Space             model.Space                  `json:"space"`
+	Authors           []model.Author               `json:"authors"`
+	TranslationGroups []model.TranslationGroup     `json:"translation_groups"`
+	Media             []model.Medium               `json:"media"`
+	Formats           []model.Format               `json:"formats"`
+	Categories        []model.Category             `json:"categories"`
+	Tags              []model.Tag                  `json:"tags"`
+	Menus             []model.Menu                 `json:"menus"`
+	WorkflowStages    []model.WorkflowStage        `json:"workflow_stages"`
+	Ratings           []factCheckModel.Rating      `json:"ratings"`
+	Claimants         []factCheckModel.Claimant    `json:"claimants"`
+	Videos            []factCheckModel.Video       `json:"videos"`
+	VideoTags         []VideoTag                   `json:"video_tags"`
+	VideoCategories   []VideoCategory              `json:"video_categories"`
+	VideoAuthors      []factCheckModel.VideoAuthor `json:"video_authors"`
+	Claims            []factCheckModel.Claim       `json:"claims"`
+	Posts             []model.Post                 `json:"posts"`
+	PostTags          []PostTag                    `json:"post_tags"`
+	PostCategories    []PostCategory               `json:"post_categories"`
+	PostAuthors       []model.PostAuthor           `json:"post_authors"`
+	PostClaims        []factCheckModel.PostClaim   `json:"post_claims"`
+	Podcasts          []podcastModel.Podcast       `json:"podcasts"`
+	PodcastCategories []PodcastCategory            `json:"podcast_categories"`
+	Episodes          []podcastModel.Episode       `json:"episodes"`
+	EpisodeAuthors    []podcastModel.EpisodeAuthor `json:"episode_authors"`
+}
+
+// record is a line of an NDJSON archive
+type record struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type header struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// sections returns the record type and a pointer to the slice of every
+// section of the archive, in the order they are written
+func (a *Archive) sections() []struct {
+	name string
+	rows interface{}
+} {
+	return []struct {
+		name string
+		rows interface{}
+	}{
+		{"authors", &a.Authors},
+		{"translation_groups", &a.TranslationGroups},
+		{"media", &a.Media},
+		{"formats", &a.Formats},
+		{"categories", &a.Categories},
+		{"tags", &a.Tags},
+		{"menus", &a.Menus},
+		{"workflow_stages", &a.WorkflowStages},
+		{"ratings", &a.Ratings},
+		{"claimants", &a.Claimants},
+		{"videos", &a.Videos},
+		{"video_tags", &a.VideoTags},
+		{"video_categories", &a.VideoCategories},
+		{"video_authors", &a.VideoAuthors},
+		{"claims", &a.Claims},
+		{"posts", &a.Posts},
+		{"post_tags", &a.PostTags},
+		{"post_categories", &a.PostCategories},
+		{"post_authors", &a.PostAuthors},
+		{"post_claims", &a.PostClaims},
+		{"podcasts", &a.Podcasts},
+		{"podcast_categories", &a.PodcastCategories},
+		{"episodes", &a.Episodes},
+		{"episode_authors", &a.EpisodeAuthors},
+	}
+}
+
+// Write writes the archive as a single JSON document or as NDJSON with one
+// record per line
+func (a *Archive) Write(w io.Writer, format string) error {
+	encoder := json.NewEncoder(w)
+
+	switch format {
+	case FormatJSON:
+		return encoder.Encode(a)
+	case FormatNDJSON:
+	default:
+		return fmt.Errorf("unknown archive format %s", format)
+	}
+
+	if err := writeRecord(encoder, "archive", header{
+		Version:    a.Version,
+		ExportedAt: a.ExportedAt,
+	}); err != nil {
+		return err
+	}
+
+	if err := writeRecord(encoder, "space", a.Space); err != nil {
+		return err
+	}
+
+	for _, section := range a.sections() {
+		rows := reflect.ValueOf(section.rows).Elem()
+		for i := 0; i < rows.Len(); i++ {
+			if err := writeRecord(encoder, section.name, rows.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeRecord(encoder *json.Encoder, recordType string, data interface{}) error {
+	byteArr, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return encoder.Encode(record{
+		Type: recordType,
+		Data: byteArr,
+	})
+}
+
+// ReadArchive reads an archive written by Write in either format
+func ReadArchive(r io.Reader) (*Archive, error) {
+	decoder := json.NewDecoder(r)
+
+	first := json.RawMessage{}
+	if err := decoder.Decode(&first); err != nil {
+		return nil, err
+	}
+
+	rec := record{}
+	if err := json.Unmarshal(first, &rec); err != nil || rec.Type == "" {
+		archive := &Archive{}
+		if err = json.Unmarshal(first, archive); err != nil {
+			return nil, err
+		}
+		return archive, archive.check()
+	}
+
+	archive := &Archive{}
+	sections := make(map[string]reflect.Value)
+	for _, section := range archive.sections() {
+		sections[section.name] = reflect.ValueOf(section.rows).Elem()
+	}
+
+	for {
+		switch rec.Type {
+		case "archive":
+			h := header{}
+			if err := json.Unmarshal(rec.Data, &h); err != nil {
+				return nil, err
+			}
+			archive.Version = h.Version
+			archive.ExportedAt = h.ExportedAt
+		case "space":
+			if err := json.Unmarshal(rec.Data, &archive.Space); err != nil {
+				return nil, err
+			}
+		default:
+			rows, found := sections[rec.Type]
+			if !found {
+				return nil, fmt.Errorf("unknown archive record %s", rec.Type)
+			}
+			row := reflect.New(rows.Type().Elem())
+			if err := json.Unmarshal(rec.Data, row.Interface()); err != nil {
+				return nil, err
+			}
+			rows.Set(reflect.Append(rows, row.Elem()))
+		}
+
+		rec = record{}
+		err := decoder.Decode(&rec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return archive, archive.check()
+}
+
+func (a *Archive) check() error {
+	if a.Version == 0 || a.Version > ArchiveVersion {
+		return fmt.Errorf("unsupported archive version %d", a.Version)
+	}
+	if a.Space.Name == "" {
+		return errors.New("archive has no space")
+	}
+	return nil
+}
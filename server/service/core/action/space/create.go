@@ -4,21 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"regexp"
 	"strconv"
 
+	"github.com/factly/dega-server/util/search"
 	"github.com/spf13/viper"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
 	"github.com/factly/x/validationx"
+	"github.com/jinzhu/gorm/dialects/postgres"
 )
 
 // create - Create space
@@ -62,46 +66,38 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = util.CheckSpaceKetoPermission("create", uint(space.OrganisationID), uint(uID))
+	defaultLocale, supportedLocales, err := locales(space)
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnauthorized)))
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
 		return
 	}
 
-	var superOrgID int
-	if viper.GetBool("create_super_organisation") {
-		superOrgID, err = middlewarex.GetSuperOrganisationID("dega")
-		if err != nil {
-			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-			return
-		}
-
-		// Fetch organisation permissions
-		permission := model.OrganisationPermission{}
-		err = config.DB.Model(&model.OrganisationPermission{}).Where(&model.OrganisationPermission{
-			OrganisationID: uint(space.OrganisationID),
-		}).First(&permission).Error
+	spacePermalinks, err := permalinks(space)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
 
-		if err != nil && space.OrganisationID != superOrgID {
-			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more spaces", http.StatusUnprocessableEntity)))
-			return
-		}
+	theme, err := spaceTheme(space)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
 
-		if err == nil {
-			// Fetch total number of spaces in organisation
-			var totSpaces int64
-			config.DB.Model(&model.Space{}).Where(&model.Space{
-				OrganisationID: space.OrganisationID,
-			}).Count(&totSpaces)
+	err = util.CheckSpaceKetoPermission("create", uint(space.OrganisationID), uint(uID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnauthorized)))
+		return
+	}
 
-			if totSpaces >= permission.Spaces && permission.Spaces > 0 {
-				errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more spaces", http.StatusUnprocessableEntity)))
-				return
-			}
-		}
+	superOrgID, errMessage := checkSpaceQuota(space.OrganisationID)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
 	}
 
 	var spaceSlug string
@@ -126,6 +122,10 @@ func create(w http.ResponseWriter, r *http.Request) {
 		HeaderCode:        space.HeaderCode,
 		FooterCode:        space.FooterCode,
 		MetaFields:        space.MetaFields,
+		DefaultLocale:     defaultLocale,
+		SupportedLocales:  supportedLocales,
+		Permalinks:        spacePermalinks,
+		Theme:             theme,
 	}
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
@@ -140,28 +140,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	if viper.GetBool("create_super_organisation") {
 		// Create SpacePermission for super organisation
-		var spacePermission model.SpacePermission
-		if superOrgID == space.OrganisationID {
-			spacePermission = model.SpacePermission{
-				SpaceID:   result.ID,
-				Media:     -1,
-				Posts:     -1,
-				Podcast:   true,
-				Episodes:  -1,
-				FactCheck: true,
-				Videos:    -1,
-			}
-		} else {
-			spacePermission = model.SpacePermission{
-				SpaceID:   result.ID,
-				Media:     viper.GetInt64("default_number_of_media"),
-				Posts:     viper.GetInt64("default_number_of_posts"),
-				Episodes:  viper.GetInt64("default_number_of_episodes"),
-				Videos:    viper.GetInt64("default_number_of_videos"),
-				Podcast:   false,
-				FactCheck: false,
-			}
-		}
+		spacePermission := newSpacePermission(result.ID, space.OrganisationID, superOrgID)
 		var spacePermContext config.ContextKey = "space_perm_user"
 		if err = tx.WithContext(context.WithValue(r.Context(), spacePermContext, uID)).Create(&spacePermission).Error; err != nil {
 			tx.Rollback()
@@ -187,19 +166,20 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("space.created", result); err != nil {
+		if err = util.Outbox(tx, "space.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
 
@@ -231,3 +211,131 @@ func approveSpaceSlug(slug string) string {
 	}
 	return temp
 }
+
+// checkSpaceQuota checks whether the organisation can create one more space
+// and returns the id of the super organisation
+func checkSpaceQuota(oID int) (int, errorx.Message) {
+	if !viper.GetBool("create_super_organisation") {
+		return 0, errorx.Message{}
+	}
+
+	superOrgID, err := middlewarex.GetSuperOrganisationID("dega")
+	if err != nil {
+		loggerx.Error(err)
+		return 0, errorx.InternalServerError()
+	}
+
+	// Fetch organisation permissions
+	permission := model.OrganisationPermission{}
+	err = config.DB.Model(&model.OrganisationPermission{}).Where(&model.OrganisationPermission{
+		OrganisationID: uint(oID),
+	}).First(&permission).Error
+
+	if err != nil && oID != superOrgID {
+		loggerx.Error(err)
+		return 0, errorx.GetMessage("cannot create more spaces", http.StatusUnprocessableEntity)
+	}
+
+	if err == nil {
+		// Fetch total number of spaces in organisation
+		var totSpaces int64
+		config.DB.Model(&model.Space{}).Where(&model.Space{
+			OrganisationID: oID,
+		}).Count(&totSpaces)
+
+		if totSpaces >= permission.Spaces && permission.Spaces > 0 {
+			return 0, errorx.GetMessage("cannot create more spaces", http.StatusUnprocessableEntity)
+		}
+	}
+
+	return superOrgID, errorx.Message{}
+}
+
+// newSpacePermission returns the default permissions of a new space
+func newSpacePermission(spaceID uint, oID, superOrgID int) model.SpacePermission {
+	if superOrgID == oID {
+		return model.SpacePermission{
+			SpaceID:   spaceID,
+			Media:     -1,
+			Posts:     -1,
+			Podcast:   true,
+			Episodes:  -1,
+			FactCheck: true,
+			Videos:    -1,
+		}
+	}
+	return model.SpacePermission{
+		SpaceID:   spaceID,
+		Media:     viper.GetInt64("default_number_of_media"),
+		Posts:     viper.GetInt64("default_number_of_posts"),
+		Episodes:  viper.GetInt64("default_number_of_episodes"),
+		Videos:    viper.GetInt64("default_number_of_videos"),
+		Podcast:   false,
+		FactCheck: false,
+	}
+}
+
+// locales returns the canonical default locale and supported locales of the
+// space, the supported locales are left unset when none are given
+func locales(space *space) (string, postgres.Jsonb, error) {
+	defaultLocale := ""
+	if space.DefaultLocale != "" {
+		locale, err := translation.Canonical(space.DefaultLocale)
+		if err != nil {
+			return "", postgres.Jsonb{}, err
+		}
+		defaultLocale = locale
+	}
+
+	if len(space.SupportedLocales) == 0 {
+		return defaultLocale, postgres.Jsonb{}, nil
+	}
+
+	supported := make([]string, 0)
+	for _, each := range space.SupportedLocales {
+		locale, err := translation.Canonical(each)
+		if err != nil {
+			return "", postgres.Jsonb{}, err
+		}
+		supported = append(supported, locale)
+	}
+
+	byteArr, err := json.Marshal(supported)
+	if err != nil {
+		return "", postgres.Jsonb{}, err
+	}
+	return defaultLocale, postgres.Jsonb{RawMessage: byteArr}, nil
+}
+
+// permalinks returns the permalink patterns of the space, they are left unset
+// when none are given
+func permalinks(space *space) (postgres.Jsonb, error) {
+	if space.Permalinks.IsZero() {
+		return postgres.Jsonb{}, nil
+	}
+
+	if err := space.Permalinks.Validate(); err != nil {
+		return postgres.Jsonb{}, err
+	}
+
+	byteArr, err := json.Marshal(space.Permalinks)
+	if err != nil {
+		return postgres.Jsonb{}, err
+	}
+	return postgres.Jsonb{RawMessage: byteArr}, nil
+}
+
+var themeName = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// spaceTheme returns the name of the theme of the space, empty for the
+// default theme of the templates
+func spaceTheme(space *space) (string, error) {
+	if space.Theme == nil || *space.Theme == "" {
+		return "", nil
+	}
+
+	if !themeName.MatchString(*space.Theme) {
+		return "", fmt.Errorf("invalid theme name %q, it must be lowercase letters, digits and hyphens", *space.Theme)
+	}
+	return *space.Theme, nil
+}
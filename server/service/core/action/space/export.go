@@ -0,0 +1,206 @@
+package space
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/go-chi/chi"
+)
+
+// export - Export space
+// @Summary Export space
+// @Description Export everything the space owns as a portable JSON or NDJSON archive
+// @Tags Space
+// @ID export-space
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param space_id path string true "Space ID"
+// @Param format query string false "json or ndjson"
+// @Success 200 {object} Archive
+// @Router /core/spaces/{space_id}/export [get]
+func export(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	spaceID := chi.URLParam(r, "space_id")
+	sID, err := strconv.Atoi(spaceID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatJSON
+	}
+	if format != FormatJSON && format != FormatNDJSON {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("format must be json or ndjson", http.StatusBadRequest)))
+		return
+	}
+
+	space := &model.Space{}
+	space.ID = uint(sID)
+
+	err = config.DB.First(&space).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	err = util.CheckSpaceKetoPermission("update", uint(space.OrganisationID), uint(uID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnauthorized)))
+		return
+	}
+
+	archive, err := Export(space.ID, uID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	contentType := "application/json"
+	if format == FormatNDJSON {
+		contentType = "application/x-ndjson"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", space.Slug, format))
+	w.WriteHeader(http.StatusOK)
+
+	if err = archive.Write(w, format); err != nil {
+		loggerx.Error(err)
+	}
+}
+
+// Export reads everything the space owns into an archive. Authors are looked
+// up as the given user so that they can be matched by email on import.
+func Export(sID uint, uID int) (*Archive, error) {
+	archive := &Archive{
+		Version:    ArchiveVersion,
+		ExportedAt: time.Now(),
+	}
+
+	err := config.DB.First(&archive.Space, sID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	bySpace := func(rows interface{}) error {
+		return config.DB.Where("space_id = ?", sID).Order("id asc").Find(rows).Error
+	}
+
+	for _, rows := range []interface{}{
+		&archive.TranslationGroups,
+		&archive.Media,
+		&archive.Formats,
+		&archive.Categories,
+		&archive.Tags,
+		&archive.Menus,
+		&archive.WorkflowStages,
+		&archive.Ratings,
+		&archive.Claimants,
+		&archive.Videos,
+		&archive.Claims,
+		&archive.Posts,
+		&archive.Podcasts,
+		&archive.Episodes,
+	} {
+		if err = bySpace(rows); err != nil {
+			return nil, err
+		}
+	}
+
+	postIDs := make([]uint, 0)
+	for _, each := range archive.Posts {
+		postIDs = append(postIDs, each.ID)
+	}
+	videoIDs := make([]uint, 0)
+	for _, each := range archive.Videos {
+		videoIDs = append(videoIDs, each.ID)
+	}
+	podcastIDs := make([]uint, 0)
+	for _, each := range archive.Podcasts {
+		podcastIDs = append(podcastIDs, each.ID)
+	}
+	episodeIDs := make([]uint, 0)
+	for _, each := range archive.Episodes {
+		episodeIDs = append(episodeIDs, each.ID)
+	}
+
+	joins := []struct {
+		table  string
+		column string
+		ids    []uint
+		rows   interface{}
+	}{
+		{"post_tags", "post_id", postIDs, &archive.PostTags},
+		{"post_categories", "post_id", postIDs, &archive.PostCategories},
+		{"post_authors", "post_id", postIDs, &archive.PostAuthors},
+		{"post_claims", "post_id", postIDs, &archive.PostClaims},
+		{"video_tags", "video_id", videoIDs, &archive.VideoTags},
+		{"video_categories", "video_id", videoIDs, &archive.VideoCategories},
+		{"video_authors", "video_id", videoIDs, &archive.VideoAuthors},
+		{"podcast_categories", "podcast_id", podcastIDs, &archive.PodcastCategories},
+		{"episode_authors", "episode_id", episodeIDs, &archive.EpisodeAuthors},
+	}
+
+	for _, join := range joins {
+		err = config.DB.Table(join.table).Where(fmt.Sprint(join.column, " IN (?)"), join.ids).Find(join.rows).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	archive.Authors = exportAuthors(archive, uID)
+
+	return archive, nil
+}
+
+// exportAuthors returns the users of the organisation who authored content in the space
+func exportAuthors(archive *Archive, uID int) []model.Author {
+	authorIDs := make(map[uint]bool)
+	for _, each := range archive.PostAuthors {
+		authorIDs[each.AuthorID] = true
+	}
+	for _, each := range archive.VideoAuthors {
+		authorIDs[each.AuthorID] = true
+	}
+	for _, each := range archive.EpisodeAuthors {
+		authorIDs[each.AuthorID] = true
+	}
+
+	authors := make([]model.Author, 0)
+	if len(authorIDs) == 0 {
+		return authors
+	}
+
+	for _, each := range author.Mapper(archive.Space.OrganisationID, uID) {
+		if authorIDs[each.ID] {
+			authors = append(authors, model.Author{
+				Base:        config.Base{ID: each.ID},
+				Email:       each.Email,
+				FirstName:   each.FirstName,
+				LastName:    each.LastName,
+				DisplayName: each.DisplayName,
+			})
+		}
+	}
+	return authors
+}
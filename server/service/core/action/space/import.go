@@ -0,0 +1,536 @@
+package space
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// importSpace - Import space
+// @Summary Import space
+// @Description Create a new space in the organisation from an archive written by export
+// @Tags Space
+// @ID import-space
+// @Accept multipart/form-data
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param organisation_id formData string true "Organisation ID"
+// @Param file formData file true "Archive"
+// @Success 201 {object} model.Space
+// @Router /core/spaces/import [post]
+func importSpace(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("file is required", http.StatusBadRequest)))
+		return
+	}
+	defer file.Close()
+
+	oID, err := strconv.Atoi(r.FormValue("organisation_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	err = util.CheckSpaceKetoPermission("create", uint(oID), uint(uID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnauthorized)))
+		return
+	}
+
+	archive, err := ReadArchive(file)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	superOrgID, errMessage := checkSpaceQuota(oID)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
+	}
+
+	result, err := Import(archive, oID, uID, superOrgID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
+
+// idMap maps the IDs of an archive to the IDs created on import
+type idMap map[uint]uint
+
+func (m idMap) id(id uint) uint {
+	return m[id]
+}
+
+func (m idMap) ptr(id *uint) *uint {
+	if id == nil {
+		return nil
+	}
+	newID, found := m[*id]
+	if !found {
+		return nil
+	}
+	return &newID
+}
+
+// importer holds the ID mappings of an import
+type importer struct {
+	tx      *gorm.DB
+	sID     uint
+	uID     uint
+	authors idMap
+
+	translationGroups idMap
+	media             idMap
+	formats           idMap
+	categories        idMap
+	tags              idMap
+	workflowStages    idMap
+	ratings           idMap
+	claimants         idMap
+	videos            idMap
+	claims            idMap
+	posts             idMap
+	podcasts          idMap
+	episodes          idMap
+}
+
+// Import creates a new space in the organisation out of the archive. All the
+// IDs are remapped and authors are matched with the users of the organisation
+// by email, falling back to the importing user. superOrgID decides the
+// permissions of the new space when create_super_organisation is set.
+func Import(archive *Archive, oID, uID, superOrgID int) (*model.Space, error) {
+	result := archive.Space
+	result.Base = config.Base{
+		CreatedByID: uint(uID),
+		UpdatedByID: uint(uID),
+	}
+	result.Slug = approveSpaceSlug(result.Slug)
+	result.OrganisationID = oID
+	result.LogoID, result.LogoMobileID, result.FavIconID, result.MobileIconID = nil, nil, nil, nil
+	result.Logo, result.LogoMobile, result.FavIcon, result.MobileIcon = nil, nil, nil, nil
+
+	tx := config.DB.WithContext(context.WithValue(context.Background(), userContext, uID)).Begin()
+
+	err := tx.Omit(clause.Associations).Create(&result).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if viper.GetBool("create_super_organisation") {
+		spacePermission := newSpacePermission(result.ID, oID, superOrgID)
+		spacePermission.CreatedByID = uint(uID)
+		spacePermission.UpdatedByID = uint(uID)
+		if err = tx.Create(&spacePermission).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	imp := &importer{
+		tx:                tx,
+		sID:               result.ID,
+		uID:               uint(uID),
+		authors:           mapAuthors(archive.Authors, oID, uID),
+		translationGroups: make(idMap),
+		media:             make(idMap),
+		formats:           make(idMap),
+		categories:        make(idMap),
+		tags:              make(idMap),
+		workflowStages:    make(idMap),
+		ratings:           make(idMap),
+		claimants:         make(idMap),
+		videos:            make(idMap),
+		claims:            make(idMap),
+		posts:             make(idMap),
+		podcasts:          make(idMap),
+		episodes:          make(idMap),
+	}
+
+	if err = imp.run(archive, &result); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "space.created", result); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	tx.Commit()
+
+	if config.SearchEnabled() {
+		if err = util.ReindexAllEntities(result.ID); err != nil {
+			loggerx.Error(err)
+		}
+	}
+
+	return &result, nil
+}
+
+func (imp *importer) run(archive *Archive, space *model.Space) error {
+	// the variants of an entity are tied together by the groups of the new
+	// space, archives written before the groups were exported leave them out
+	for _, each := range archive.TranslationGroups {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.translationGroups[old] = each.ID
+	}
+
+	for _, each := range archive.Media {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.media[old] = each.ID
+	}
+
+	// space logos point to the media of the space
+	err := imp.tx.Model(space).Select("LogoID", "LogoMobileID", "FavIconID", "MobileIconID").Updates(&model.Space{
+		LogoID:       imp.media.ptr(archive.Space.LogoID),
+		LogoMobileID: imp.media.ptr(archive.Space.LogoMobileID),
+		FavIconID:    imp.media.ptr(archive.Space.FavIconID),
+		MobileIconID: imp.media.ptr(archive.Space.MobileIconID),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	for _, each := range archive.Formats {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.SpaceID = imp.sID
+		if err = imp.create(&each); err != nil {
+			return err
+		}
+		imp.formats[old] = each.ID
+	}
+
+	// parents are set once all the categories exist
+	for _, each := range archive.Categories {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.ParentID = nil
+		each.TranslationGroupID = imp.translationGroups.ptr(each.TranslationGroupID)
+		each.SpaceID = imp.sID
+		if err = imp.create(&each); err != nil {
+			return err
+		}
+		imp.categories[old] = each.ID
+	}
+	for _, each := range archive.Categories {
+		if each.ParentID == nil {
+			continue
+		}
+		err = imp.tx.Model(&model.Category{}).Where("id = ?", imp.categories.id(each.ID)).
+			UpdateColumn("parent_id", imp.categories.ptr(each.ParentID)).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.Tags {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.TranslationGroupID = imp.translationGroups.ptr(each.TranslationGroupID)
+		each.SpaceID = imp.sID
+		if err = imp.create(&each); err != nil {
+			return err
+		}
+		imp.tags[old] = each.ID
+	}
+
+	for _, each := range archive.Menus {
+		each.Base = imp.base(each.Base)
+		each.SpaceID = imp.sID
+		if err = imp.create(&each); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.WorkflowStages {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.SpaceID = imp.sID
+		if err = imp.create(&each); err != nil {
+			return err
+		}
+		imp.workflowStages[old] = each.ID
+	}
+
+	if err = imp.importFactChecks(archive); err != nil {
+		return err
+	}
+
+	if err = imp.importPosts(archive); err != nil {
+		return err
+	}
+
+	return imp.importPodcasts(archive)
+}
+
+func (imp *importer) importFactChecks(archive *Archive) error {
+	for _, each := range archive.Ratings {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.ratings[old] = each.ID
+	}
+
+	for _, each := range archive.Claimants {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.claimants[old] = each.ID
+	}
+
+	for _, each := range archive.Videos {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.videos[old] = each.ID
+	}
+
+	for _, each := range archive.VideoTags {
+		if err := imp.join("video_tags", &VideoTag{
+			VideoID: imp.videos.id(each.VideoID),
+			TagID:   imp.tags.id(each.TagID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.VideoCategories {
+		if err := imp.join("video_categories", &VideoCategory{
+			VideoID:    imp.videos.id(each.VideoID),
+			CategoryID: imp.categories.id(each.CategoryID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.VideoAuthors {
+		each.Base = imp.base(each.Base)
+		each.VideoID = imp.videos.id(each.VideoID)
+		each.AuthorID = imp.author(each.AuthorID)
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.Claims {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.ClaimantID = imp.claimants.id(each.ClaimantID)
+		each.RatingID = imp.ratings.id(each.RatingID)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.VideoID = imp.videos.ptr(each.VideoID)
+		each.TranslationGroupID = imp.translationGroups.ptr(each.TranslationGroupID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.claims[old] = each.ID
+	}
+
+	return nil
+}
+
+func (imp *importer) importPosts(archive *Archive) error {
+	for _, each := range archive.Posts {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.FeaturedMediumID = imp.media.ptr(each.FeaturedMediumID)
+		each.FormatID = imp.formats.id(each.FormatID)
+		each.WorkflowStageID = imp.workflowStages.ptr(each.WorkflowStageID)
+		each.TranslationGroupID = imp.translationGroups.ptr(each.TranslationGroupID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.posts[old] = each.ID
+	}
+
+	for _, each := range archive.PostTags {
+		if err := imp.join("post_tags", &PostTag{
+			PostID: imp.posts.id(each.PostID),
+			TagID:  imp.tags.id(each.TagID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.PostCategories {
+		if err := imp.join("post_categories", &PostCategory{
+			PostID:     imp.posts.id(each.PostID),
+			CategoryID: imp.categories.id(each.CategoryID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.PostAuthors {
+		each.Base = imp.base(each.Base)
+		each.PostID = imp.posts.id(each.PostID)
+		each.AuthorID = imp.author(each.AuthorID)
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.PostClaims {
+		each.Base = imp.base(each.Base)
+		each.PostID = imp.posts.id(each.PostID)
+		each.ClaimID = imp.claims.id(each.ClaimID)
+		each.Claim = factCheckModel.Claim{}
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *importer) importPodcasts(archive *Archive) error {
+	for _, each := range archive.Podcasts {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.PrimaryCategoryID = imp.categories.ptr(each.PrimaryCategoryID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.podcasts[old] = each.ID
+	}
+
+	for _, each := range archive.PodcastCategories {
+		if err := imp.join("podcast_categories", &PodcastCategory{
+			PodcastID:  imp.podcasts.id(each.PodcastID),
+			CategoryID: imp.categories.id(each.CategoryID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, each := range archive.Episodes {
+		old := each.ID
+		each.Base = imp.base(each.Base)
+		each.MediumID = imp.media.ptr(each.MediumID)
+		each.PodcastID = imp.podcasts.ptr(each.PodcastID)
+		each.SpaceID = imp.sID
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+		imp.episodes[old] = each.ID
+	}
+
+	for _, each := range archive.EpisodeAuthors {
+		each.Base = imp.base(each.Base)
+		each.EpisodeID = imp.episodes.id(each.EpisodeID)
+		each.AuthorID = imp.author(each.AuthorID)
+		if err := imp.create(&each); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// base keeps the timestamps of a row and drops its ID
+func (imp *importer) base(base config.Base) config.Base {
+	return config.Base{
+		CreatedAt:   base.CreatedAt,
+		UpdatedAt:   base.UpdatedAt,
+		CreatedByID: imp.uID,
+		UpdatedByID: imp.uID,
+	}
+}
+
+func (imp *importer) create(value interface{}) error {
+	return imp.tx.Omit(clause.Associations).Create(value).Error
+}
+
+func (imp *importer) join(table string, value interface{}) error {
+	return imp.tx.Table(table).Create(value).Error
+}
+
+func (imp *importer) author(id uint) uint {
+	if newID, found := imp.authors[id]; found {
+		return newID
+	}
+	return imp.uID
+}
+
+// mapAuthors matches the authors of the archive with the users of the organisation by email
+func mapAuthors(authors []model.Author, oID, uID int) idMap {
+	result := make(idMap)
+	if len(authors) == 0 {
+		return result
+	}
+
+	byEmail := make(map[string]uint)
+	for _, each := range author.Mapper(oID, uID) {
+		byEmail[strings.ToLower(each.Email)] = each.ID
+	}
+
+	for _, each := range authors {
+		if id, found := byEmail[strings.ToLower(each.Email)]; found {
+			result[each.ID] = id
+		}
+	}
+	return result
+}
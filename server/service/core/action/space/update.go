@@ -8,11 +8,12 @@ import (
 	"strconv"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -71,6 +72,27 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defaultLocale, supportedLocales, err := locales(space)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
+	spacePermalinks, err := permalinks(space)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	theme, err := spaceTheme(space)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
 	err = util.CheckSpaceKetoPermission("update", uint(space.OrganisationID), uint(uID))
 	if err != nil {
 		loggerx.Error(err)
@@ -144,6 +166,17 @@ func update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// the theme is kept when it is left out and cleared when it is empty
+	if space.Theme != nil && *space.Theme == "" {
+		err = tx.Model(&result).Updates(map[string]interface{}{"theme": ""}).Error
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
 	var spaceSlug string
 	if result.Slug == space.Slug {
 		spaceSlug = result.Slug
@@ -172,6 +205,10 @@ func update(w http.ResponseWriter, r *http.Request) {
 		HeaderCode:        space.HeaderCode,
 		FooterCode:        space.FooterCode,
 		MetaFields:        space.MetaFields,
+		DefaultLocale:     defaultLocale,
+		SupportedLocales:  supportedLocales,
+		Permalinks:        spacePermalinks,
+		Theme:             theme,
 	}).Preload("Logo").Preload("LogoMobile").Preload("FavIcon").Preload("MobileIcon").First(&result).Error
 
 	if err != nil {
@@ -196,18 +233,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("space.updated", result); err != nil {
+		if err = util.Outbox(tx, "space.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
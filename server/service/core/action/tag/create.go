@@ -8,12 +8,12 @@ import (
 	"reflect"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -89,7 +89,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(tag.Description.RawMessage) > 0 && !reflect.DeepEqual(tag.Description, test.NilJsonb()) {
+	if len(tag.Description.RawMessage) > 0 && !reflect.DeepEqual(tag.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(tag.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -119,6 +119,18 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	result.Locale, result.TranslationGroupID, err = translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+	}.Resolve(tx, tag.Locale, tag.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	err = tx.Model(&model.Tag{}).Create(&result).Error
 
 	if err != nil {
@@ -142,17 +154,19 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
-	tx.Commit()
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("tag.created", result); err != nil {
+		if err = util.Outbox(tx, "tag.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
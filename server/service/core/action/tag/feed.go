@@ -7,7 +7,10 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/post"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util/feed"
+	"github.com/factly/dega-server/util/permalink"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/paginationx"
@@ -47,17 +50,22 @@ func Feeds(w http.ResponseWriter, r *http.Request) {
 		tagIDs = append(tagIDs, each.ID)
 	}
 
-	feed := post.GetFeed(space)
+	locale := translation.GetFeedLocale(r.Context())
+	result := feed.New(space, locale)
+	// the feed of a single tag links to its page
+	if len(tagList) == 1 {
+		result.Link = permalink.New(space).Tag(tagList[0])
+	}
 
 	postList := make([]model.Post, 0)
 	config.DB.Model(&model.Post{}).Joins("JOIN post_tags ON posts.id = post_tags.post_id").Where(&model.Post{
 		Status:  "publish",
 		SpaceID: uint(sID),
-	}).Where("is_page = ?", false).Where("tag_id IN (?)", tagIDs).Order("created_at " + sort).Offset(offset).Limit(limit).Find(&postList)
+	}).Where("is_page = ?", false).Where("tag_id IN (?)", tagIDs).Scopes(translation.InLocale("posts.locale", locale, space)).Preload("Format").Preload("Medium").Preload("Tags").Preload("Categories").Order("created_at " + sort).Offset(offset).Limit(limit).Find(&postList)
 
-	feed.Items = post.GetItemsList(postList, space)
+	result.Items = post.GetItemsList(postList, space)
 
-	if err := feed.WriteRss(w); err != nil {
+	if err := feed.Write(w, r, result); err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
 		return
@@ -19,6 +19,8 @@ type tag struct {
 	HeaderCode       string         `json:"header_code"`
 	FooterCode       string         `json:"footer_code"`
 	MediumID         uint           `json:"medium_id"`
+	Locale           string         `json:"locale"`
+	TranslationOf    uint           `json:"translation_of"`
 }
 
 var userContext config.ContextKey = "tag_user"
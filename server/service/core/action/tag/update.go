@@ -8,12 +8,12 @@ import (
 	"strconv"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -114,7 +114,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(tag.Description.RawMessage) > 0 && !reflect.DeepEqual(tag.Description, test.NilJsonb()) {
+	if len(tag.Description.RawMessage) > 0 && !reflect.DeepEqual(tag.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(tag.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -125,6 +125,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.Begin()
 
+	locale, translationGroupID, err := translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		ID:      result.ID,
+		Locale:  result.Locale,
+		GroupID: result.TranslationGroupID,
+	}.Resolve(tx, tag.Locale, tag.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	mediumID := &tag.MediumID
 	result.MediumID = &tag.MediumID
 	if tag.MediumID == 0 {
@@ -140,17 +154,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx.Model(&result).Select("IsFeatured").Updates(model.Tag{IsFeatured: tag.IsFeatured})
 	err = tx.Model(&result).Updates(model.Tag{
-		Base:             config.Base{UpdatedByID: uint(uID)},
-		Name:             tag.Name,
-		Slug:             tagSlug,
-		Description:      tag.Description,
-		BackgroundColour: tag.BackgroundColour,
-		HTMLDescription:  description,
-		MetaFields:       tag.MetaFields,
-		Meta:             tag.Meta,
-		HeaderCode:       tag.HeaderCode,
-		FooterCode:       tag.FooterCode,
-		MediumID:         mediumID,
+		Base:               config.Base{UpdatedByID: uint(uID)},
+		Name:               tag.Name,
+		Slug:               tagSlug,
+		Description:        tag.Description,
+		BackgroundColour:   tag.BackgroundColour,
+		HTMLDescription:    description,
+		MetaFields:         tag.MetaFields,
+		Meta:               tag.Meta,
+		HeaderCode:         tag.HeaderCode,
+		FooterCode:         tag.FooterCode,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
+		MediumID:           mediumID,
 	}).Preload("Medium").First(&result).Error
 
 	if err != nil {
@@ -171,17 +187,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
-	tx.Commit()
 
 	if util.CheckNats() {
-		if err = util.NC.Publish("tag.updated", result); err != nil {
+		if err = util.Outbox(tx, "tag.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
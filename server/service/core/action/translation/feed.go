@@ -0,0 +1,50 @@
+package translation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+var feedLocale config.ContextKey = "feed_locale"
+
+// FeedLocale - middleware that checks the locale in the url of the feeds
+// split per language and puts it in the context
+func FeedLocale(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale, err := Canonical(chi.URLParam(r, "locale"))
+		if err != nil {
+			errorx.Render(w, errorx.Parser(Message(err)))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), feedLocale, locale)))
+	})
+}
+
+// GetFeedLocale returns the locale of the feed, it is empty for the feeds of
+// all the locales
+func GetFeedLocale(ctx context.Context) string {
+	locale, _ := ctx.Value(feedLocale).(string)
+	return locale
+}
+
+// InLocale narrows a query down to the entities in the locale, the entities
+// without a locale are in the default locale of the space. An empty locale
+// leaves the query as it is.
+func InLocale(column, locale string, space model.Space) func(tx *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if locale == "" {
+			return tx
+		}
+		if locale == space.DefaultLocale {
+			return tx.Where(column+" IN (?)", []string{locale, ""})
+		}
+		return tx.Where(column+" = ?", locale)
+	}
+}
@@ -0,0 +1,98 @@
+package translation
+
+import (
+	"encoding/json"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+const languageSchemaType = "WebPage"
+
+// LanguageSchema is the schema.org page of a post in its locale, its
+// translations are rendered as hreflang alternates
+type LanguageSchema struct {
+	Context         string      `json:"@context"`
+	Type            string      `json:"@type"`
+	URL             string      `json:"url"`
+	InLanguage      string      `json:"inLanguage"`
+	WorkTranslation []Alternate `json:"workTranslation,omitempty"`
+}
+
+// Alternate is a translation of a post
+type Alternate struct {
+	Type       string `json:"@type"`
+	URL        string `json:"url"`
+	InLanguage string `json:"inLanguage"`
+}
+
+// Schemas appends the language schema of the post to its schemas, the posts
+// without a locale in a space without a default locale have none
+func Schemas(tx *gorm.DB, post model.Post, space model.Space, schemas []interface{}) []interface{} {
+	locale := Locale(post.Locale, space)
+	if locale == "" {
+		return schemas
+	}
+
+	urls := permalink.New(space)
+	schema := LanguageSchema{
+		Context:    "https://schema.org",
+		Type:       languageSchemaType,
+		URL:        urls.Post(post),
+		InLanguage: locale,
+	}
+
+	for _, each := range variants(tx, post) {
+		schema.WorkTranslation = append(schema.WorkTranslation, Alternate{
+			Type:       languageSchemaType,
+			URL:        urls.Post(each),
+			InLanguage: Locale(each.Locale, space),
+		})
+	}
+
+	return append(schemas, schema)
+}
+
+// Refresh regenerates the language schema of the other posts in the
+// translation group of the post, for them to list it as a translation
+func Refresh(tx *gorm.DB, post model.Post, space model.Space) error {
+	for _, each := range variants(tx, post) {
+		existing := make([]json.RawMessage, 0)
+		_ = json.Unmarshal(each.Schemas.RawMessage, &existing)
+
+		schemas := make([]interface{}, 0)
+		for _, schema := range existing {
+			typed := struct {
+				Type string `json:"@type"`
+			}{}
+			_ = json.Unmarshal(schema, &typed)
+			if typed.Type != languageSchemaType {
+				schemas = append(schemas, schema)
+			}
+		}
+
+		byteArr, err := json.Marshal(Schemas(tx, each, space, schemas))
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.Post{}).Where("id = ?", each.ID).UpdateColumn("schemas", postgres.Jsonb{RawMessage: byteArr}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// variants returns the other posts in the translation group of the post
+func variants(tx *gorm.DB, post model.Post) []model.Post {
+	result := make([]model.Post, 0)
+	if post.TranslationGroupID == nil {
+		return result
+	}
+
+	tx.Model(&model.Post{}).Where("translation_group_id = ? AND id <> ?", *post.TranslationGroupID, post.ID).Preload("Format").Order("id").Find(&result)
+	return result
+}
@@ -0,0 +1,187 @@
+package translation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"golang.org/x/text/language"
+	"gorm.io/gorm"
+)
+
+// invalidError is returned for the locales and translations which can not be
+// stored on an entity
+type invalidError struct {
+	message string
+}
+
+func (e invalidError) Error() string {
+	return e.message
+}
+
+// Message returns the error message to render for an error of Resolve
+func Message(err error) errorx.Message {
+	invalid := invalidError{}
+	if errors.As(err, &invalid) {
+		return errorx.GetMessage(invalid.message, http.StatusUnprocessableEntity)
+	}
+	return errorx.DBError()
+}
+
+// Entity is a post, page, claim, category or tag being created or updated
+type Entity struct {
+	Table   string
+	SpaceID uint
+	// ID is zero for the entities being created
+	ID      uint
+	Locale  string
+	GroupID *uint
+	// Scope narrows the table down to the entities of the kind
+	Scope func(tx *gorm.DB) *gorm.DB
+}
+
+// member of a translation group
+type member struct {
+	ID                 uint
+	Locale             string
+	TranslationGroupID *uint
+}
+
+// Canonical returns the canonical form of a BCP 47 locale, like en-US for en_us
+func Canonical(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", invalidError{message: "invalid locale " + locale}
+	}
+	return tag.String(), nil
+}
+
+// Locales returns the locales of the space, the default locale first
+func Locales(space model.Space) []string {
+	result := make([]string, 0)
+	if space.DefaultLocale != "" {
+		result = append(result, space.DefaultLocale)
+	}
+
+	supported := make([]string, 0)
+	_ = json.Unmarshal(space.SupportedLocales.RawMessage, &supported)
+	for _, each := range supported {
+		if each != space.DefaultLocale {
+			result = append(result, each)
+		}
+	}
+	return result
+}
+
+// Locale returns the locale of an entity, the entities without a locale are
+// in the default locale of their space
+func Locale(locale string, space model.Space) string {
+	if locale == "" {
+		return space.DefaultLocale
+	}
+	return locale
+}
+
+// Resolve checks the locale of the entity and the entity it is a translation
+// of. It returns the locale and the translation group to store on the entity,
+// the translated entity gets a group when it has none yet. An empty locale
+// leaves the locale of the entity as it is.
+func (e Entity) Resolve(tx *gorm.DB, locale string, translationOf uint) (string, *uint, error) {
+	if locale == "" && translationOf == 0 {
+		return "", e.GroupID, nil
+	}
+
+	space := model.Space{}
+	err := tx.Model(&model.Space{}).Select("id, default_locale, supported_locales").Where("id = ?", e.SpaceID).First(&space).Error
+	if err != nil {
+		return "", nil, err
+	}
+
+	if locale != "" {
+		if locale, err = Canonical(locale); err != nil {
+			return "", nil, err
+		}
+		if locales := Locales(space); len(locales) > 0 && !contains(locales, locale) {
+			return "", nil, invalidError{message: "locale " + locale + " is not supported by the space"}
+		}
+	}
+
+	groupID := e.GroupID
+	if translationOf > 0 {
+		if translationOf == e.ID {
+			return "", nil, invalidError{message: "cannot be a translation of itself"}
+		}
+
+		query := tx.Table(e.Table).Select("id, locale, translation_group_id").Where("space_id = ? AND id = ? AND deleted_at IS NULL", e.SpaceID, translationOf)
+		if e.Scope != nil {
+			query = query.Scopes(e.Scope)
+		}
+
+		source := member{}
+		err = query.First(&source).Error
+		if err != nil {
+			return "", nil, invalidError{message: "translated entity not found"}
+		}
+
+		if source.TranslationGroupID == nil {
+			if Locale(source.Locale, space) == "" {
+				return "", nil, invalidError{message: "translated entity has no locale"}
+			}
+
+			group := model.TranslationGroup{SpaceID: e.SpaceID}
+			if err = tx.Create(&group).Error; err != nil {
+				return "", nil, err
+			}
+			// the members of a group always have a locale
+			err = tx.Table(e.Table).Where("id = ?", source.ID).UpdateColumns(map[string]interface{}{
+				"locale":               Locale(source.Locale, space),
+				"translation_group_id": group.ID,
+			}).Error
+			if err != nil {
+				return "", nil, err
+			}
+			source.TranslationGroupID = &group.ID
+		}
+		groupID = source.TranslationGroupID
+	}
+
+	if groupID == nil {
+		return locale, nil, nil
+	}
+
+	if locale == "" {
+		locale = Locale(e.Locale, space)
+	}
+	if locale == "" {
+		return "", nil, invalidError{message: "locale is required for translations"}
+	}
+
+	var count int64
+	err = tx.Table(e.Table).Where("translation_group_id = ? AND locale = ? AND id <> ? AND deleted_at IS NULL", *groupID, locale, e.ID).Count(&count).Error
+	if err != nil {
+		return "", nil, err
+	}
+	if count > 0 {
+		return "", nil, invalidError{message: "translation in locale " + locale + " exists"}
+	}
+
+	return locale, groupID, nil
+}
+
+// IsPage narrows the posts table down to the posts or the pages
+func IsPage(page bool) func(tx *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("is_page = ?", page)
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, each := range list {
+		if each == value {
+			return true
+		}
+	}
+	return false
+}
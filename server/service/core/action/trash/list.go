@@ -0,0 +1,59 @@
+package trash
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// list - Get deleted entities
+// @Summary Show the trash of an entity
+// @Description Get the deleted entities of a kind, most recently deleted first. purge_at is set when deleted entities are purged after trash_retention_days.
+// @Tags Trash
+// @ID get-trash
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param entity path string true "posts, pages, categories, tags, formats, media, menus, claims, claimants, ratings, videos, podcasts or episodes"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} paging
+// @Router /core/trash/{entity} [get]
+func list(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	k := kinds[chi.URLParam(r, "entity")]
+
+	result := paging{}
+	result.Nodes = make([]item, 0)
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	err = k.deleted().Where("space_id = ?", sID).Count(&result.Total).Select(k.columns()).Order("deleted_at desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if days := config.TrashRetentionDays(); days > 0 {
+		for i := range result.Nodes {
+			purgeAt := result.Nodes[i].DeletedAt.AddDate(0, 0, days)
+			result.Nodes[i].PurgeAt = &purgeAt
+		}
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,281 @@
+package trash
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/medium"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// referencedError is returned when an entity can not be purged as other
+// entities, deleted ones included, still refer to it
+type referencedError struct {
+	entity string
+	by     string
+}
+
+func (e referencedError) Error() string {
+	return fmt.Sprint(e.entity, " is referred to by some ", e.by)
+}
+
+// purge - Purge deleted entity
+// @Summary Purge a deleted entity
+// @Description Delete a deleted entity for good along with its associations, the files of a medium are deleted from the storage.
+// @Tags Trash
+// @ID purge-trash-item
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param entity path string true "posts, pages, categories, tags, formats, media, menus, claims, claimants, ratings, videos, podcasts or episodes"
+// @Param item_id path string true "ID of the deleted entity"
+// @Success 200
+// @Failure 422 {array} string
+// @Router /core/trash/{entity}/{item_id} [delete]
+func purge(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "item_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	k := kinds[chi.URLParam(r, "entity")]
+
+	deleted := item{}
+	err = k.deleted().Select(k.columns()).Where("space_id = ? AND id = ?", sID, id).First(&deleted).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	if err = k.purgeItem(deleted.ID); err != nil {
+		loggerx.Error(err)
+		referenced := referencedError{}
+		if errors.As(err, &referenced) {
+			errorx.Render(w, errorx.Parser(errorx.CannotDelete(referenced.entity, referenced.by)))
+			return
+		}
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, nil)
+}
+
+// PurgeExpired purges the entities deleted before the given time
+func PurgeExpired(before time.Time) error {
+	for _, entity := range entities {
+		k := kinds[entity]
+
+		expired := make([]item, 0)
+		err := k.deleted().Select(k.columns()).Where("deleted_at < ?", before).Order("id").Find(&expired).Error
+		if err != nil {
+			return err
+		}
+
+		for _, each := range expired {
+			if err = k.purgeItem(each.ID); err != nil {
+				loggerx.Error(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// purgeItem deletes a deleted entity of the kind for good
+func (k kind) purgeItem(id uint) error {
+	result := k.new()
+	err := config.DB.Unscoped().First(result, id).Error
+	if err != nil {
+		return err
+	}
+
+	tx := config.DB.Begin()
+
+	if k.purge != nil {
+		if err = k.purge(tx, id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Unscoped().Delete(result).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, k.name+".purged", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return err
+	}
+
+	if purged, ok := result.(*model.Medium); ok {
+		medium.RemoveFiles(purged.URL)
+	}
+
+	return nil
+}
+
+// unlink deletes the rows of the join tables linking to the entity
+func unlink(tx *gorm.DB, column string, id uint, tables ...string) error {
+	for _, table := range tables {
+		if err := tx.Exec(fmt.Sprint("DELETE FROM ", table, " WHERE ", column, " = ?"), id).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgePost deletes the links, authors, claims, revisions, reviewers,
+// workflow transitions and comments of a post
+func purgePost(tx *gorm.DB, id uint) error {
+	if err := unlink(tx, "post_id", id, "post_tags", "post_categories"); err != nil {
+		return err
+	}
+
+	for _, dependent := range []interface{}{&model.PostAuthor{}, &factCheckModel.PostClaim{}, &model.PostRevision{}, &model.PostReviewer{}, &model.PostTransition{}} {
+		if err := tx.Unscoped().Where("post_id = ?", id).Delete(dependent).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Unscoped().Where("entity_type = ? AND entity_id = ?", "post", id).Delete(&model.Comment{}).Error
+}
+
+// purgeEpisode deletes the authors of an episode
+func purgeEpisode(tx *gorm.DB, id uint) error {
+	return tx.Unscoped().Where("episode_id = ?", id).Delete(&podcastModel.EpisodeAuthor{}).Error
+}
+
+// purgePodcast unlinks the categories and episodes of a podcast
+func purgePodcast(tx *gorm.DB, id uint) error {
+	if err := unlink(tx, "podcast_id", id, "podcast_categories"); err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Model(&podcastModel.Episode{}).Where("podcast_id = ?", id).UpdateColumn("podcast_id", nil).Error
+}
+
+// purgeClaim deletes the links of a claim to posts and its comments
+func purgeClaim(tx *gorm.DB, id uint) error {
+	if err := tx.Unscoped().Where("claim_id = ?", id).Delete(&factCheckModel.PostClaim{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Where("entity_type = ? AND entity_id = ?", "claim", id).Delete(&model.Comment{}).Error
+}
+
+// purgeVideo deletes the links and authors of a video and unlinks its claims
+func purgeVideo(tx *gorm.DB, id uint) error {
+	if err := unlink(tx, "video_id", id, "video_tags", "video_categories"); err != nil {
+		return err
+	}
+
+	if err := tx.Unscoped().Where("video_id = ?", id).Delete(&factCheckModel.VideoAuthor{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Model(&factCheckModel.Claim{}).Where("video_id = ?", id).UpdateColumn("video_id", nil).Error
+}
+
+// referencedByClaims keeps claimants and ratings which claims refer to
+func referencedByClaims(entity string) func(tx *gorm.DB, id uint) error {
+	return func(tx *gorm.DB, id uint) error {
+		var count int64
+		if err := tx.Unscoped().Model(&factCheckModel.Claim{}).Where(entity+"_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return referencedError{entity: entity, by: "claim"}
+		}
+		return nil
+	}
+}
+
+// purgeCategory unlinks a category from posts, podcasts, videos and its
+// child categories
+func purgeCategory(tx *gorm.DB, id uint) error {
+	if err := unlink(tx, "category_id", id, "post_categories", "podcast_categories", "video_categories"); err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Model(&model.Category{}).Where("parent_id = ?", id).UpdateColumn("parent_id", nil).Error
+}
+
+// purgeTag unlinks a tag from posts and videos
+func purgeTag(tx *gorm.DB, id uint) error {
+	return unlink(tx, "tag_id", id, "post_tags", "video_tags")
+}
+
+// purgeFormat keeps formats which posts refer to
+func purgeFormat(tx *gorm.DB, id uint) error {
+	var count int64
+	if err := tx.Unscoped().Model(&model.Post{}).Where("format_id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return referencedError{entity: "format", by: "post"}
+	}
+	return nil
+}
+
+// columns referring to media
+var mediumReferences = []struct {
+	table  string
+	column string
+}{
+	{"posts", "featured_medium_id"},
+	{"categories", "medium_id"},
+	{"tags", "medium_id"},
+	{"formats", "medium_id"},
+	{"ratings", "medium_id"},
+	{"claimants", "medium_id"},
+	{"claims", "medium_id"},
+	{"podcasts", "medium_id"},
+	{"episodes", "medium_id"},
+	{"spaces", "logo_id"},
+	{"spaces", "logo_mobile_id"},
+	{"spaces", "fav_icon_id"},
+	{"spaces", "mobile_icon_id"},
+}
+
+// purgeMedium unsets the medium wherever it is still referred to, like by
+// the entities it was force deleted from
+func purgeMedium(tx *gorm.DB, id uint) error {
+	for _, reference := range mediumReferences {
+		err := tx.Exec(fmt.Sprint("UPDATE ", reference.table, " SET ", reference.column, " = NULL WHERE ", reference.column, " = ?"), id).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package trash
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// restore - Restore deleted entity
+// @Summary Restore a deleted entity
+// @Description Restore a deleted entity with the associations deleted along with it. It gets a new slug when its slug was taken since.
+// @Tags Trash
+// @ID restore-trash-item
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param entity path string true "posts, pages, categories, tags, formats, media, menus, claims, claimants, ratings, videos, podcasts or episodes"
+// @Param item_id path string true "ID of the deleted entity"
+// @Success 200
+// @Router /core/trash/{entity}/{item_id}/restore [post]
+func restore(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "item_id"))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	k := kinds[chi.URLParam(r, "entity")]
+
+	deleted := item{}
+	err = k.deleted().Select(k.columns()).Where("space_id = ? AND id = ?", sID, id).First(&deleted).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	tx := config.DB.Begin()
+
+	err = tx.Table(k.table).Where("id = ?", deleted.ID).UpdateColumns(map[string]interface{}{
+		"deleted_at":    nil,
+		"slug":          slugx.Approve(&config.DB, deleted.Slug, sID, k.table),
+		"updated_at":    time.Now(),
+		"updated_by_id": uID,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if k.restore != nil {
+		if err = k.restore(tx, deleted.ID, deleted.DeletedAt); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	result := k.new()
+	err = tx.First(result, deleted.ID).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, k.name+".restored", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if config.SearchEnabled() {
+		_ = k.reindex(uint(sID))
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+// restorePost restores the authors and claims of a post deleted along with it
+func restorePost(tx *gorm.DB, id uint, deletedAt time.Time) error {
+	err := tx.Unscoped().Model(&model.PostAuthor{}).Where("post_id = ? AND deleted_at >= ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Model(&factCheckModel.PostClaim{}).Where("post_id = ? AND deleted_at >= ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error
+}
+
+// restoreEpisode restores the authors of an episode deleted along with it
+func restoreEpisode(tx *gorm.DB, id uint, deletedAt time.Time) error {
+	return tx.Unscoped().Model(&podcastModel.EpisodeAuthor{}).Where("episode_id = ? AND deleted_at >= ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error
+}
+
+// restoreVideo restores the authors of a video deleted along with it
+func restoreVideo(tx *gorm.DB, id uint, deletedAt time.Time) error {
+	return tx.Unscoped().Model(&factCheckModel.VideoAuthor{}).Where("video_id = ? AND deleted_at >= ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error
+}
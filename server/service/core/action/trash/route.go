@@ -0,0 +1,125 @@
+package trash
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// deleted entity in the trash
+type item struct {
+	ID        uint       `json:"id"`
+	Title     string     `json:"title"`
+	Slug      string     `json:"slug"`
+	SpaceID   uint       `json:"space_id"`
+	DeletedAt time.Time  `json:"deleted_at"`
+	PurgeAt   *time.Time `json:"purge_at,omitempty"`
+}
+
+// list response
+type paging struct {
+	Total int64  `json:"total"`
+	Nodes []item `json:"nodes"`
+}
+
+// kind of entity kept in the trash once deleted
+type kind struct {
+	// subject of the events and kind of the search documents
+	name  string
+	model interface{}
+	table string
+	title string
+	// narrows the table down to the entities of the kind
+	scope   func(tx *gorm.DB) *gorm.DB
+	reindex func(spaceID uint) error
+	// restore brings back the associations deleted along with the entity
+	restore func(tx *gorm.DB, id uint, deletedAt time.Time) error
+	// purge removes what refers to the entity before it is deleted for good
+	purge func(tx *gorm.DB, id uint) error
+}
+
+// entities kept in the trash, in the order they are purged so that the
+// entities referring to others go first
+var entities = []string{"posts", "pages", "episodes", "podcasts", "claims", "videos", "claimants", "ratings", "categories", "tags", "formats", "menus", "media"}
+
+var kinds = map[string]kind{
+	"posts":      {name: "post", model: &model.Post{}, table: "posts", title: "title", scope: isPage(false), reindex: util.AddPosts, restore: restorePost, purge: purgePost},
+	"pages":      {name: "page", model: &model.Post{}, table: "posts", title: "title", scope: isPage(true), reindex: util.AddPosts, restore: restorePost, purge: purgePost},
+	"episodes":   {name: "episode", model: &podcastModel.Episode{}, table: "episodes", title: "title", reindex: util.AddEpisode, restore: restoreEpisode, purge: purgeEpisode},
+	"podcasts":   {name: "podcast", model: &podcastModel.Podcast{}, table: "podcasts", title: "title", reindex: util.AddPodcast, purge: purgePodcast},
+	"claims":     {name: "claim", model: &factCheckModel.Claim{}, table: "claims", title: "claim", reindex: util.AddClaim, purge: purgeClaim},
+	"videos":     {name: "video", model: &factCheckModel.Video{}, table: "videos", title: "title", reindex: util.AddVideo, restore: restoreVideo, purge: purgeVideo},
+	"claimants":  {name: "claimant", model: &factCheckModel.Claimant{}, table: "claimants", title: "name", reindex: util.AddClaimant, purge: referencedByClaims("claimant")},
+	"ratings":    {name: "rating", model: &factCheckModel.Rating{}, table: "ratings", title: "name", reindex: util.AddRating, purge: referencedByClaims("rating")},
+	"categories": {name: "category", model: &model.Category{}, table: "categories", title: "name", reindex: util.AddCategories, purge: purgeCategory},
+	"tags":       {name: "tag", model: &model.Tag{}, table: "tags", title: "name", reindex: util.AddTags, purge: purgeTag},
+	"formats":    {name: "format", model: &model.Format{}, table: "formats", title: "name", reindex: util.AddFormats, purge: purgeFormat},
+	"menus":      {name: "menu", model: &model.Menu{}, table: "menus", title: "name", reindex: util.AddMenu},
+	"media":      {name: "media", model: &model.Medium{}, table: "media", title: "name", reindex: util.AddMedium, purge: purgeMedium},
+}
+
+// Router - Group of trash router, for the deleted entities of the kinds above
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	r.Route("/{entity}", func(r chi.Router) {
+		r.With(check("get")).Get("/", list)
+		r.Route("/{item_id}", func(r chi.Router) {
+			r.With(check("delete")).Post("/restore", restore)
+			r.With(check("purge")).Delete("/", purge)
+		})
+	})
+
+	return r
+}
+
+// check returns middleware that checks the permission of user for the action
+// on the entity in the url, the entities which can not be trashed are not found
+func check(action string) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			entity := chi.URLParam(r, "entity")
+			if _, found := kinds[entity]; !found {
+				errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+				return
+			}
+
+			util.CheckKetoPolicy(entity, action)(h).ServeHTTP(w, r)
+		})
+	}
+}
+
+func isPage(page bool) func(tx *gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("is_page = ?", page)
+	}
+}
+
+// deleted returns the query for the deleted entities of the kind
+func (k kind) deleted() *gorm.DB {
+	tx := config.DB.Unscoped().Model(k.model).Where("deleted_at IS NOT NULL")
+	if k.scope != nil {
+		tx = tx.Scopes(k.scope)
+	}
+	return tx
+}
+
+// columns returns the columns of the deleted entities listed in the trash
+func (k kind) columns() string {
+	return fmt.Sprint("id, ", k.title, " AS title, slug, space_id, deleted_at")
+}
+
+// new returns a new entity of the kind
+func (k kind) new() interface{} {
+	return reflect.New(reflect.TypeOf(k.model).Elem()).Interface()
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -62,6 +63,21 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userlist, errMessage := spaceUsers(r.Context(), oID, sID)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
+	}
+
+	result := paging{}
+	result.Nodes = userlist
+	result.Total = len(userlist)
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+// spaceUsers returns the users having access to the space along with their policies
+func spaceUsers(ctx context.Context, oID, sID int) ([]userPolicy, errorx.Message) {
 	userIDsMap := make(map[uint][]policyRes)
 
 	// get all the admins of the organisation
@@ -70,8 +86,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 	resp, err := util.KetoGetRequest("/engines/acp/ory/regex/roles/" + adminRoleID)
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.NetworkError()))
-		return
+		return nil, errorx.NetworkError()
 	}
 
 	defer resp.Body.Close()
@@ -80,8 +95,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 	err = json.NewDecoder(resp.Body).Decode(&adminRole)
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
-		return
+		return nil, errorx.DecodeError()
 	}
 
 	for _, member := range adminRole.Members {
@@ -98,8 +112,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 	policyList, err := policy.GetAllPolicies()
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
+		return nil, errorx.InternalServerError()
 	}
 
 	prefixName := fmt.Sprint("id:org:", oID, ":app:dega:space:", sID, ":")
@@ -127,15 +140,12 @@ func list(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Fetch all the users
-	userMap, err := author.All(r.Context())
+	userMap, err := author.All(ctx)
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
+		return nil, errorx.InternalServerError()
 	}
 
-	result := paging{}
-
 	userlist := make([]userPolicy, 0)
 
 	for usrID, pol := range userIDsMap {
@@ -149,8 +159,20 @@ func list(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	result.Nodes = userlist
-	result.Total = len(userlist)
+	return userlist, errorx.Message{}
+}
 
-	renderx.JSON(w, http.StatusOK, result)
+// SpaceUsers returns the users having access to the space mapped by their id
+func SpaceUsers(ctx context.Context, oID, sID int) (map[string]model.Author, errorx.Message) {
+	userlist, errMessage := spaceUsers(ctx, oID, sID)
+	if errMessage.Code != 0 {
+		return nil, errMessage
+	}
+
+	users := make(map[string]model.Author)
+	for _, each := range userlist {
+		users[fmt.Sprint(each.ID)] = each.Author
+	}
+
+	return users, errorx.Message{}
 }
@@ -1,26 +1,27 @@
 package webhook
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
-	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/dega-server/util"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/factly/x/validationx"
-	"github.com/spf13/viper"
 )
 
 // create - Create Webhook
 // @Summary Create Webhook
-// @Description Create Webhook
+// @Description Create Webhook. The response carries the secret used to sign deliveries.
 // @Tags Webhooks
 // @ID add-webhook
 // @Consume json
@@ -28,7 +29,7 @@ import (
 // @Param X-User header string true "User ID"
 // @Param X-Space header string true "Space ID"
 // @Param Webhook body webhook true "Webhook Object"
-// @Success 201 {object} model.Webhook
+// @Success 201 {object} webhookSecret
 // @Failure 400 {array} string
 // @Router /core/webhooks [post]
 func create(w http.ResponseWriter, r *http.Request) {
@@ -67,36 +68,65 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks"
-
-	resp, err := requestx.Request("POST", hukzURL, webhook, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
+	events, errMessage := findEvents(webhook.EventIDs)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
+		return
+	}
 
+	secret, err := delivery.NewSecret()
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
 		return
 	}
 
-	if resp.StatusCode > 500 {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
+	result := &model.Webhook{
+		Name:    webhook.Name,
+		URL:     webhook.URL,
+		Enabled: webhook.Enabled,
+		Secret:  secret,
+		Events:  events,
+		Tags:    webhook.Tags,
+		SpaceID: uint(sID),
 	}
 
-	var webhookRes model.Webhook
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
 
-	if err = json.NewDecoder(resp.Body).Decode(&webhookRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err = tx.Model(&model.Webhook{}).Omit("Events.*").Create(&result).Error; err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusCreated, webhookRes)
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusCreated, webhookSecret{
+		Webhook: *result,
+		Secret:  result.Secret,
+	})
+}
+
+// findEvents fetches the events a webhook subscribes to
+func findEvents(ids []uint) ([]model.Event, errorx.Message) {
+	events := make([]model.Event, 0)
+	if err := config.DB.Model(&model.Event{}).Where(ids).Find(&events).Error; err != nil {
+		loggerx.Error(err)
+		return nil, errorx.DBError()
+	}
+
+	if len(events) != len(ids) {
+		return nil, errorx.GetMessage("event not found", http.StatusUnprocessableEntity)
+	}
+
+	return events, errorx.Message{}
 }
 
+// AddTags adds the app and space tags to the webhook
 func AddTags(webhook *webhook, sID int) error {
 	tags := make(map[string]string)
-	if len(webhook.Tags.RawMessage) > 0 && !reflect.DeepEqual(webhook.Tags, test.NilJsonb()) {
+	if len(webhook.Tags.RawMessage) > 0 && !reflect.DeepEqual(webhook.Tags, util.NilJsonb()) {
 		err := json.Unmarshal(webhook.Tags.RawMessage, &tags)
 		if err != nil {
 			return err
@@ -1,17 +1,16 @@
 package webhook
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // delete - Delete webhook by id
@@ -26,42 +25,45 @@ import (
 // @Failure 400 {array} string
 // @Router  /core/webhooks/{webhook_id} [delete]
 func delete(w http.ResponseWriter, r *http.Request) {
-	webhookID := chi.URLParam(r, "webhook_id")
-	id, err := strconv.Atoi(webhookID)
-
+	sID, err := middlewarex.GetSpace(r.Context())
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
 		return
 	}
 
-	uID, err := middlewarex.GetUser(r.Context())
+	webhookID := chi.URLParam(r, "webhook_id")
+	id, err := strconv.Atoi(webhookID)
+
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks/" + fmt.Sprint(id)
+	result := &model.Webhook{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.Webhook{
+		SpaceID: uint(sID),
+	}).First(&result).Error
 
-	resp, err := requestx.Request("DELETE", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
 		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
 		return
 	}
 
-	if resp.StatusCode > 500 {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	tx := config.DB.Begin()
+	if err = tx.Model(&result).Association("Events").Clear(); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
+	tx.Delete(&result)
+	tx.Commit()
 
 	renderx.JSON(w, http.StatusOK, nil)
 }
@@ -1,19 +1,16 @@
 package webhook
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // details - Get webhook by id
@@ -28,50 +25,34 @@ import (
 // @Success 200 {object} model.Webhook
 // @Router /core/webhooks/{webhook_id} [get]
 func details(w http.ResponseWriter, r *http.Request) {
-	webhookID := chi.URLParam(r, "webhook_id")
-	id, err := strconv.Atoi(webhookID)
-
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
-		return
-	}
-
-	uID, err := middlewarex.GetUser(r.Context())
+	sID, err := middlewarex.GetSpace(r.Context())
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks/" + fmt.Sprint(id)
+	webhookID := chi.URLParam(r, "webhook_id")
+	id, err := strconv.Atoi(webhookID)
 
-	resp, err := requestx.Request("GET", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
 	if err != nil {
 		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
 		return
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
-		return
-	}
+	result := &model.Webhook{}
+	result.ID = uint(id)
 
-	if resp.StatusCode > 500 {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
+	err = config.DB.Model(&model.Webhook{}).Preload("Events").Where(&model.Webhook{
+		SpaceID: uint(sID),
+	}).First(&result).Error
 
-	var webhookRes model.Webhook
-
-	if err = json.NewDecoder(resp.Body).Decode(&webhookRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, webhookRes)
-
+	renderx.JSON(w, http.StatusOK, result)
 }
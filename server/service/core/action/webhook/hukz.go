@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+)
+
+// HukzRouter webhooks endpoint router proxying the webhooks of the space to
+// hukz, used when hukz delivers the webhooks instead of dega-server
+func HukzRouter() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "webhooks"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", hukzList("/webhooks"))
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", hukzSave)
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/logs", hukzList("/webhooks/logs"))
+	r.Route("/{webhook_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", hukzWebhook)
+		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", hukzSave)
+		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", hukzWebhook)
+	})
+
+	return r
+}
+
+// hukzList lists the webhooks or the webhook logs of the space from hukz
+func hukzList(path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		uID, err := middlewarex.GetUser(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		sID, err := middlewarex.GetSpace(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		query := url.Values{}
+		query.Add("tag", "app:dega")
+		query.Add("tag", "space:"+fmt.Sprint(sID))
+		query.Set("limit", r.URL.Query().Get("limit"))
+		query.Set("page", r.URL.Query().Get("page"))
+
+		util.HukzRequest(w, http.MethodGet, path+"?"+query.Encode(), nil, uID, http.StatusOK)
+	}
+}
+
+// hukzSave creates or updates a webhook of the space in hukz
+func hukzSave(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	path := "/webhooks"
+	method, status := http.MethodPost, http.StatusCreated
+	if webhookID := chi.URLParam(r, "webhook_id"); webhookID != "" {
+		id, err := strconv.Atoi(webhookID)
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+			return
+		}
+		path = "/webhooks/" + fmt.Sprint(id)
+		method, status = http.MethodPut, http.StatusOK
+	}
+
+	webhook := &webhook{}
+
+	if err = json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	if validationError := validationx.Check(webhook); validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	// append app and space tag even if not provided
+	if err = AddTags(webhook, sID); err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	util.HukzRequest(w, method, path, webhook, uID, status)
+}
+
+// hukzWebhook gets or deletes a webhook of hukz
+func hukzWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID := chi.URLParam(r, "webhook_id")
+	id, err := strconv.Atoi(webhookID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	util.HukzRequest(w, r.Method, "/webhooks/"+fmt.Sprint(id), nil, uID, http.StatusOK)
+}
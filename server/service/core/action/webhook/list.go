@@ -1,17 +1,15 @@
 package webhook
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
-	"github.com/spf13/viper"
 )
 
 type paging struct {
@@ -32,14 +30,6 @@ type paging struct {
 // @Success 200 {object} paging
 // @Router /core/webhooks [get]
 func list(w http.ResponseWriter, r *http.Request) {
-
-	uID, err := middlewarex.GetUser(r.Context())
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
-		return
-	}
-
 	sID, err := middlewarex.GetSpace(r.Context())
 	if err != nil {
 		loggerx.Error(err)
@@ -47,28 +37,20 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks?tag=app:dega&tag=space:" + fmt.Sprint(sID) + "&limit=" + r.URL.Query().Get("limit") + "&page=" + r.URL.Query().Get("page")
-
-	resp, err := requestx.Request("GET", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
+	result := paging{}
+	result.Nodes = make([]model.Webhook, 0)
 
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
-	}
+	offset, limit := paginationx.Parse(r.URL.Query())
 
-	var webhooksPaging paging
+	err = config.DB.Model(&model.Webhook{}).Preload("Events").Where(&model.Webhook{
+		SpaceID: uint(sID),
+	}).Count(&result.Total).Order("id desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
 
-	if err = json.NewDecoder(resp.Body).Decode(&webhooksPaging); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, webhooksPaging)
+	renderx.JSON(w, http.StatusOK, result)
 }
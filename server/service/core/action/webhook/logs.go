@@ -1,17 +1,15 @@
 package webhook
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
-	"github.com/spf13/viper"
 )
 
 type logPaging struct {
@@ -19,7 +17,7 @@ type logPaging struct {
 	Nodes []model.WebhookLog `json:"nodes"`
 }
 
-// list - Get all webhooks logs
+// logs - Get all webhooks logs
 // @Summary Show all webhooks logs
 // @Description Get all webhooks logs
 // @Tags Webhooks
@@ -27,18 +25,13 @@ type logPaging struct {
 // @Produce json
 // @Param X-User header string true "User ID"
 // @Param X-Space header string true "Space ID"
+// @Param webhook query string false "Webhook ID"
+// @Param status query string false "pending, retrying, delivered or dead"
 // @Param limit query string false "limit per page"
 // @Param page query string false "page number"
-// @Success 200 {object} paging
+// @Success 200 {object} logPaging
 // @Router /core/webhooks/logs [get]
 func logs(w http.ResponseWriter, r *http.Request) {
-	uID, err := middlewarex.GetUser(r.Context())
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
-		return
-	}
-
 	sID, err := middlewarex.GetSpace(r.Context())
 	if err != nil {
 		loggerx.Error(err)
@@ -46,28 +39,30 @@ func logs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks/logs?tag=app:dega&tag=space:" + fmt.Sprint(sID) + "&limit=" + r.URL.Query().Get("limit") + "&page=" + r.URL.Query().Get("page")
+	result := logPaging{}
+	result.Nodes = make([]model.WebhookLog, 0)
+
+	offset, limit := paginationx.Parse(r.URL.Query())
 
-	resp, err := requestx.Request("GET", hukzURL, nil, map[string]string{
-		"X-User": fmt.Sprint(uID),
+	tx := config.DB.Model(&model.WebhookLog{}).Where(&model.WebhookLog{
+		SpaceID: uint(sID),
 	})
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
+
+	if webhookID := r.URL.Query().Get("webhook"); webhookID != "" {
+		tx.Where("webhook_id = ?", webhookID)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
-		return
+	if status := r.URL.Query().Get("status"); status != "" {
+		tx.Where("status = ?", status)
 	}
 
-	var webhooksPaging logPaging
+	err = tx.Count(&result.Total).Order("id desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
 
-	if err = json.NewDecoder(resp.Body).Decode(&webhooksPaging); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, webhooksPaging)
+	renderx.JSON(w, http.StatusOK, result)
 }
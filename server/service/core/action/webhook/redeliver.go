@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// redeliver - Redeliver webhook log
+// @Summary Redeliver webhook log
+// @Description Queue the payload of a webhook log to be sent again. A new log is created for the attempt.
+// @Tags Webhooks
+// @ID redeliver-webhook-log
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param log_id path string true "Webhook Log ID"
+// @Success 202 {object} model.WebhookLog
+// @Router /core/webhooks/logs/{log_id}/redeliver [post]
+func redeliver(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	logID := chi.URLParam(r, "log_id")
+	id, err := strconv.Atoi(logID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	webhookLog := model.WebhookLog{}
+	webhookLog.ID = uint(id)
+
+	err = config.DB.Model(&model.WebhookLog{}).Where(&model.WebhookLog{
+		SpaceID: uint(sID),
+	}).First(&webhookLog).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result, err := delivery.Redeliver(webhookLog, uID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusAccepted, result)
+}
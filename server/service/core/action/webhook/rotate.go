@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// rotate - Rotate webhook secret
+// @Summary Rotate the secret of a webhook
+// @Description Replace the secret used to sign deliveries. The response carries the new secret, which is not returned again.
+// @Tags Webhooks
+// @ID rotate-webhook-secret
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param webhook_id path string true "Webhook ID"
+// @Success 200 {object} webhookSecret
+// @Router /core/webhooks/{webhook_id}/secret [post]
+func rotate(w http.ResponseWriter, r *http.Request) {
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	webhookID := chi.URLParam(r, "webhook_id")
+	id, err := strconv.Atoi(webhookID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.Webhook{}
+	result.ID = uint(id)
+
+	err = config.DB.Model(&model.Webhook{}).Where(&model.Webhook{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	secret, err := delivery.NewSecret()
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	err = config.DB.Model(result).Updates(model.Webhook{
+		Base:   config.Base{UpdatedByID: uint(uID)},
+		Secret: secret,
+	}).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, webhookSecret{
+		Webhook: *result,
+		Secret:  secret,
+	})
+}
@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/util"
 	"github.com/go-chi/chi"
 	"github.com/jinzhu/gorm/dialects/postgres"
@@ -8,12 +10,21 @@ import (
 
 type webhook struct {
 	Name     string         `json:"name"`
-	URL      string         `json:"url" validate:"required"`
+	URL      string         `json:"url" validate:"required,url"`
 	Enabled  bool           `json:"enabled"`
-	EventIDs []uint         `json:"event_ids" validate:"required"`
+	EventIDs []uint         `json:"event_ids" validate:"required,min=1"`
 	Tags     postgres.Jsonb `json:"tags" swaggertype:"primitive,string"`
 }
 
+// webhookSecret is a webhook along with the secret signing its deliveries,
+// which is only returned when the secret is generated
+type webhookSecret struct {
+	model.Webhook
+	Secret string `json:"secret"`
+}
+
+var userContext config.ContextKey = "webhook_user"
+
 // Router webhooks endpoint router
 func Router() chi.Router {
 	r := chi.NewRouter()
@@ -23,10 +34,12 @@ func Router() chi.Router {
 	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
 	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", create)
 	r.With(util.CheckKetoPolicy(entity, "get")).Get("/logs", logs)
+	r.With(util.CheckKetoPolicy(entity, "update")).Post("/logs/{log_id}/redeliver", redeliver)
 	r.Route("/{webhook_id}", func(r chi.Router) {
 		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
 		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
 		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+		r.With(util.CheckKetoPolicy(entity, "update")).Post("/secret", rotate)
 	})
 
 	return r
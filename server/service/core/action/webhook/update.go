@@ -3,19 +3,17 @@ package webhook
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/model"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
-	"github.com/factly/x/requestx"
 	"github.com/factly/x/validationx"
 	"github.com/go-chi/chi"
-	"github.com/spf13/viper"
 )
 
 // update - Update webhook by id
@@ -55,6 +53,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	result := &model.Webhook{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.Webhook{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
 	webhook := &webhook{}
 
 	if err = json.NewDecoder(r.Body).Decode(&webhook); err != nil {
@@ -76,33 +88,39 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hukzURL := viper.GetString("hukz_url") + "/webhooks/" + fmt.Sprint(id)
-
-	resp, err := requestx.Request("PUT", hukzURL, webhook, map[string]string{
-		"X-User": fmt.Sprint(uID),
-	})
-	if err != nil {
-		loggerx.Error(err)
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	events, errMessage := findEvents(webhook.EventIDs)
+	if errMessage.Code != 0 {
+		errorx.Render(w, errorx.Parser(errMessage))
 		return
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
-		return
-	}
+	tx := config.DB.Begin()
 
-	if resp.StatusCode > 500 {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err = tx.Model(&result).Omit("Events.*").Association("Events").Replace(&events); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	var webhookRes model.Webhook
+	err = tx.Model(&result).Select("Name", "URL", "Enabled", "Tags", "UpdatedByID").Updates(model.Webhook{
+		Base:    config.Base{UpdatedByID: uint(uID)},
+		Name:    webhook.Name,
+		URL:     webhook.URL,
+		Enabled: webhook.Enabled,
+		Tags:    webhook.Tags,
+	}).Error
 
-	if err = json.NewDecoder(resp.Body).Decode(&webhookRes); err != nil {
-		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
 		return
 	}
 
-	renderx.JSON(w, http.StatusOK, webhookRes)
+	tx.Model(&model.Webhook{}).Preload("Events").First(&result)
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
 }
@@ -0,0 +1,93 @@
+package wordpress
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+)
+
+// maxFileSize is the largest WXR export accepted over HTTP
+const maxFileSize = 256 << 20
+
+// create - Start WordPress import
+// @Summary Start WordPress import
+// @Description Start importing a WordPress WXR export into the space, the published posts are imported as drafts without the permission to publish them. The import runs in the background, poll the job for progress.
+// @Tags WordPress Import
+// @ID add-wordpress-import
+// @Accept multipart/form-data
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param file formData file true "WXR export"
+// @Success 202 {object} model.ImportJob
+// @Failure 400 {array} string
+// @Router /core/import/wordpress [post]
+func create(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	oID, err := util.GetOrganisation(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxFileSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("file is required", http.StatusBadRequest)))
+		return
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	// without the permission to publish, the published posts are imported as drafts
+	stat, err := util.CheckPublishPermission("posts", oID, sID, uID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	result, err := NewJob(r.Context(), sID, uID, header.Filename)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	job := *result
+	go func() {
+		if err := Import(&job, bytes.NewReader(data), oID, uID, stat == http.StatusOK, nil); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	renderx.JSON(w, http.StatusAccepted, result)
+}
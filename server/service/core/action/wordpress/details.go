@@ -0,0 +1,59 @@
+package wordpress
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// details - Get WordPress import by id
+// @Summary Show a WordPress import by id
+// @Description Get the progress and errors of a WordPress import job
+// @Tags WordPress Import
+// @ID get-wordpress-import-by-id
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param job_id path string true "Import Job ID"
+// @Success 200 {object} model.ImportJob
+// @Router /core/import/wordpress/{job_id} [get]
+func details(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	jobID := chi.URLParam(r, "job_id")
+	id, err := strconv.Atoi(jobID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.ImportJob{}
+	result.ID = uint(id)
+
+	err = config.DB.Model(&model.ImportJob{}).Where(&model.ImportJob{
+		Source:  "wordpress",
+		SpaceID: uint(sID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,285 @@
+package wordpress
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// editorjsVersion is the EditorJS version the admin UI saves descriptions with
+const editorjsVersion = "2.19.0"
+
+var blankLines = regexp.MustCompile(`\n\s*\n`)
+
+type block struct {
+	Type string                 `json:"type"`
+	Data map[string]interface{} `json:"data"`
+}
+
+type editorjs struct {
+	Time    int64   `json:"time"`
+	Blocks  []block `json:"blocks"`
+	Version string  `json:"version"`
+}
+
+// inline elements are kept as markup inside paragraph blocks
+var inline = map[atom.Atom]bool{
+	atom.A: true, atom.Abbr: true, atom.B: true, atom.Br: true, atom.Cite: true,
+	atom.Code: true, atom.Del: true, atom.Em: true, atom.I: true, atom.Mark: true,
+	atom.S: true, atom.Small: true, atom.Span: true, atom.Strong: true, atom.Sub: true,
+	atom.Sup: true, atom.U: true,
+}
+
+// converter builds EditorJS blocks out of WordPress post content
+type converter struct {
+	blocks []block
+	text   bytes.Buffer
+}
+
+// toEditorJS converts WordPress HTML into an EditorJS document
+func toEditorJS(content string) (postgres.Jsonb, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return postgres.Jsonb{}, err
+	}
+
+	c := &converter{blocks: make([]block, 0)}
+	for _, node := range nodes {
+		c.node(node)
+	}
+	c.flush()
+
+	byteArr, err := json.Marshal(editorjs{
+		Time:    time.Now().UnixNano() / int64(time.Millisecond),
+		Blocks:  c.blocks,
+		Version: editorjsVersion,
+	})
+	if err != nil {
+		return postgres.Jsonb{}, err
+	}
+	return postgres.Jsonb{RawMessage: byteArr}, nil
+}
+
+// node converts a top level node. Text and inline elements are collected until
+// a block element is found since classic WordPress content relies on blank
+// lines instead of <p> tags.
+func (c *converter) node(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.text.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	if inline[n.DataAtom] {
+		_ = html.Render(&c.text, n)
+		return
+	}
+
+	c.flush()
+
+	switch n.DataAtom {
+	case atom.P:
+		c.paragraph(innerHTML(n))
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.add("header", map[string]interface{}{
+			"text":  text(n),
+			"level": int(n.Data[1] - '0'),
+		})
+	case atom.Ul, atom.Ol:
+		style := "unordered"
+		if n.DataAtom == atom.Ol {
+			style = "ordered"
+		}
+		items := make([]string, 0)
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Li {
+				items = append(items, text(child))
+			}
+		}
+		c.add("list", map[string]interface{}{
+			"style": style,
+			"items": items,
+		})
+	case atom.Blockquote:
+		if strings.Contains(attr(n, "class"), "twitter-tweet") || strings.Contains(attr(n, "class"), "instagram-media") {
+			c.raw(n)
+			return
+		}
+		c.add("quote", map[string]interface{}{
+			"text":      text(n),
+			"caption":   "",
+			"alignment": "left",
+		})
+	case atom.Pre:
+		c.add("code", map[string]interface{}{
+			"code": text(n),
+		})
+	case atom.Hr:
+		c.add("delimiter", map[string]interface{}{})
+	case atom.Img:
+		c.image(n, "")
+	case atom.Figure:
+		c.figure(n)
+	case atom.Table:
+		c.table(n)
+	case atom.Iframe, atom.Video, atom.Audio, atom.Script, atom.Object, atom.Embed:
+		c.raw(n)
+	default:
+		// layout elements such as div and section only wrap other blocks
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			c.node(child)
+		}
+		c.flush()
+	}
+}
+
+// flush turns the collected inline content into paragraphs
+func (c *converter) flush() {
+	content := c.text.String()
+	c.text.Reset()
+
+	for _, each := range blankLines.Split(content, -1) {
+		c.paragraph(each)
+	}
+}
+
+func (c *converter) paragraph(content string) {
+	content = strings.TrimSpace(content)
+	if content == "" || content == "&nbsp;" {
+		return
+	}
+	content = strings.ReplaceAll(content, "\n", "<br>")
+	c.add("paragraph", map[string]interface{}{
+		"text": content,
+	})
+}
+
+func (c *converter) figure(n *html.Node) {
+	var img, media *html.Node
+	caption := ""
+	walk(n, func(child *html.Node) {
+		switch child.DataAtom {
+		case atom.Img:
+			if img == nil {
+				img = child
+			}
+		case atom.Iframe, atom.Video, atom.Audio:
+			if media == nil {
+				media = child
+			}
+		case atom.Figcaption:
+			caption = text(child)
+		}
+	})
+
+	switch {
+	case img != nil:
+		c.image(img, caption)
+	case media != nil:
+		c.raw(n)
+	default:
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			c.node(child)
+		}
+		c.flush()
+	}
+}
+
+func (c *converter) image(n *html.Node, caption string) {
+	src := attr(n, "src")
+	if src == "" {
+		return
+	}
+	c.add("uppy", map[string]interface{}{
+		"url": map[string]interface{}{
+			"raw": src,
+		},
+		"caption":  caption,
+		"alt_text": attr(n, "alt"),
+	})
+}
+
+func (c *converter) table(n *html.Node) {
+	content := make([][]string, 0)
+	walk(n, func(child *html.Node) {
+		if child.DataAtom != atom.Tr {
+			return
+		}
+		row := make([]string, 0)
+		for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+				row = append(row, text(cell))
+			}
+		}
+		content = append(content, row)
+	})
+	if len(content) == 0 {
+		return
+	}
+	c.add("table", map[string]interface{}{
+		"content": content,
+	})
+}
+
+func (c *converter) raw(n *html.Node) {
+	var buf bytes.Buffer
+	_ = html.Render(&buf, n)
+	c.add("raw", map[string]interface{}{
+		"html": buf.String(),
+	})
+}
+
+func (c *converter) add(blockType string, data map[string]interface{}) {
+	c.blocks = append(c.blocks, block{
+		Type: blockType,
+		Data: data,
+	})
+}
+
+// walk calls fn for every descendant of n
+func walk(n *html.Node, fn func(*html.Node)) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		fn(child)
+		walk(child, fn)
+	}
+}
+
+func innerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		_ = html.Render(&buf, child)
+	}
+	return buf.String()
+}
+
+func text(n *html.Node) string {
+	var buf bytes.Buffer
+	walk(n, func(child *html.Node) {
+		if child.Type == html.TextNode {
+			buf.WriteString(child.Data)
+		}
+	})
+	return strings.TrimSpace(buf.String())
+}
+
+func attr(n *html.Node, key string) string {
+	for _, each := range n.Attr {
+		if each.Key == key {
+			return each.Val
+		}
+	}
+	return ""
+}
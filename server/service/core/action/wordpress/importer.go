@@ -0,0 +1,614 @@
+package wordpress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	authorAction "github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/action/revision"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/factly/x/schemax"
+	"github.com/factly/x/slugx"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/spf13/viper"
+)
+
+// Import job statuses
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+)
+
+// progressInterval is the number of items processed between progress updates
+const progressInterval = 10
+
+// itemError is an item which could not be imported
+type itemError struct {
+	WordpressID int    `json:"wordpress_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Error       string `json:"error"`
+}
+
+// NewJob creates a queued import job for the space
+func NewJob(ctx context.Context, sID, uID int, fileName string) (*model.ImportJob, error) {
+	job := &model.ImportJob{
+		Source:   "wordpress",
+		FileName: fileName,
+		Status:   JobQueued,
+		Errors:   toJsonb(make([]itemError, 0)),
+		SpaceID:  uint(sID),
+	}
+
+	err := config.DB.WithContext(context.WithValue(ctx, userContext, uID)).Model(&model.ImportJob{}).Create(job).Error
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+type importer struct {
+	job      *model.ImportJob
+	oID      int
+	uID      int
+	publish  bool
+	space    model.Space
+	format   model.Format
+	errors   []itemError
+	progress func(model.ImportJob)
+
+	// remaining quota, negative when the space is unlimited
+	postQuota  int64
+	mediaQuota int64
+
+	authors    map[string]uint
+	categories map[string]uint
+	tags       map[string]uint
+	media      map[int]uint
+}
+
+// Import imports a WXR export into the space of the job. The published items
+// are imported as drafts unless publish is set. The job is updated as items
+// are processed and progress, when not nil, is called with every update.
+func Import(job *model.ImportJob, r io.Reader, oID, uID int, publish bool, progress func(model.ImportJob)) error {
+	imp := &importer{
+		job:        job,
+		oID:        oID,
+		uID:        uID,
+		publish:    publish,
+		errors:     make([]itemError, 0),
+		progress:   progress,
+		postQuota:  -1,
+		mediaQuota: -1,
+		authors:    make(map[string]uint),
+		categories: make(map[string]uint),
+		tags:       make(map[string]uint),
+		media:      make(map[int]uint),
+	}
+
+	err := imp.run(r)
+	if err != nil {
+		imp.errors = append(imp.errors, itemError{Error: err.Error()})
+		job.Status = JobFailed
+	} else {
+		job.Status = JobCompleted
+	}
+	imp.save()
+
+	return err
+}
+
+func (imp *importer) run(r io.Reader) error {
+	imp.job.Status = JobRunning
+	imp.save()
+
+	export, err := parse(r)
+	if err != nil {
+		return fmt.Errorf("cannot parse export: %v", err)
+	}
+
+	sID := imp.job.SpaceID
+
+	if err = config.DB.Model(&model.Space{}).Preload("Logo").First(&imp.space, sID).Error; err != nil {
+		return errors.New("space not found")
+	}
+
+	if err = imp.quotas(); err != nil {
+		return err
+	}
+
+	format := model.Format{}
+	err = config.DB.Model(&model.Format{}).Where(&model.Format{
+		Slug:    "article",
+		SpaceID: sID,
+	}).First(&format).Error
+	if err != nil {
+		err = config.DB.Model(&model.Format{}).Where(&model.Format{
+			SpaceID: sID,
+		}).Order("id asc").First(&format).Error
+		if err != nil {
+			return errors.New("space has no formats")
+		}
+	}
+	imp.format = format
+
+	imp.job.Total = len(export.Channel.Items)
+	imp.save()
+
+	imp.mapAuthors(export.Channel.Authors)
+	imp.importCategories(export.Channel.Categories)
+	for _, each := range export.Channel.Tags {
+		imp.tag(each.Slug, each.Name, each.Description)
+	}
+
+	// attachments first so that posts can refer to their featured media
+	for _, each := range export.Channel.Items {
+		if each.PostType == "attachment" {
+			imp.importMedium(each)
+			imp.processed()
+		}
+	}
+
+	for _, each := range export.Channel.Items {
+		switch each.PostType {
+		case "attachment":
+			continue
+		case "post", "page":
+			imp.importPost(each)
+		default:
+			imp.job.Skipped++
+		}
+		imp.processed()
+	}
+
+	if config.SearchEnabled() {
+		for _, reindex := range []func(uint) error{util.AddPosts, util.AddCategories, util.AddTags, util.AddMedium} {
+			if err = reindex(sID); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// quotas fetches the remaining posts and media quota of the space
+func (imp *importer) quotas() error {
+	if !viper.GetBool("create_super_organisation") {
+		return nil
+	}
+
+	sID := imp.job.SpaceID
+
+	permission := model.SpacePermission{}
+	err := config.DB.Model(&model.SpacePermission{}).Where(&model.SpacePermission{
+		SpaceID: sID,
+	}).First(&permission).Error
+	if err != nil {
+		return errors.New("space permission not found")
+	}
+
+	if permission.Posts > 0 {
+		var totPosts int64
+		config.DB.Model(&model.Post{}).Where(&model.Post{
+			SpaceID: sID,
+		}).Where("status != 'template'").Count(&totPosts)
+		imp.postQuota = remaining(permission.Posts, totPosts)
+	}
+
+	if permission.Media > 0 {
+		var totMedia int64
+		config.DB.Model(&model.Medium{}).Where(&model.Medium{
+			SpaceID: sID,
+		}).Count(&totMedia)
+		imp.mediaQuota = remaining(permission.Media, totMedia)
+	}
+
+	return nil
+}
+
+// remaining returns the quota left, zero when the space is already over it
+// as a negative quota is unlimited
+func remaining(quota, used int64) int64 {
+	if used >= quota {
+		return 0
+	}
+	return quota - used
+}
+
+// mapAuthors matches WordPress authors with the users of the organisation by email
+func (imp *importer) mapAuthors(authors []author) {
+	users := authorAction.Mapper(imp.oID, imp.uID)
+
+	byEmail := make(map[string]uint)
+	for _, each := range users {
+		byEmail[strings.ToLower(each.Email)] = each.ID
+	}
+
+	for _, each := range authors {
+		if id, found := byEmail[strings.ToLower(each.Email)]; found {
+			imp.authors[each.Login] = id
+		}
+	}
+}
+
+// importCategories creates the categories with parents before their children
+func (imp *importer) importCategories(categories []category) {
+	remaining := categories
+	for len(remaining) > 0 {
+		next := make([]category, 0)
+		for _, each := range remaining {
+			if _, found := imp.categories[each.Parent]; each.Parent != "" && !found {
+				next = append(next, each)
+				continue
+			}
+			imp.category(each.Nicename, each.Name, each.Description, imp.categories[each.Parent])
+		}
+
+		if len(next) == len(remaining) {
+			// parents missing from the export, import them at the top level
+			for _, each := range next {
+				imp.category(each.Nicename, each.Name, each.Description, 0)
+			}
+			break
+		}
+		remaining = next
+	}
+}
+
+// category returns the id of the category with the slug, creating it if needed
+func (imp *importer) category(slug, name, description string, parentID uint) uint {
+	if id, found := imp.categories[slug]; found {
+		return id
+	}
+
+	sID := imp.job.SpaceID
+	result := model.Category{}
+
+	err := config.DB.Model(&model.Category{}).Where(&model.Category{
+		Slug:    slug,
+		SpaceID: sID,
+	}).First(&result).Error
+	if err == nil {
+		imp.categories[slug] = result.ID
+		return result.ID
+	}
+
+	result = model.Category{
+		Base:    imp.base(),
+		Name:    name,
+		Slug:    slugx.Approve(&config.DB, imp.slug(slug, name), int(sID), "categories"),
+		SpaceID: sID,
+	}
+	if parentID != 0 {
+		result.ParentID = &parentID
+	}
+	result.Description, result.HTMLDescription = describe(description)
+
+	if err = config.DB.Model(&model.Category{}).Create(&result).Error; err != nil {
+		imp.fail(0, name, err)
+		return 0
+	}
+
+	imp.job.Categories++
+	imp.categories[slug] = result.ID
+	return result.ID
+}
+
+// tag returns the id of the tag with the slug, creating it if needed
+func (imp *importer) tag(slug, name, description string) uint {
+	if id, found := imp.tags[slug]; found {
+		return id
+	}
+
+	sID := imp.job.SpaceID
+	result := model.Tag{}
+
+	err := config.DB.Model(&model.Tag{}).Where(&model.Tag{
+		Slug:    slug,
+		SpaceID: sID,
+	}).First(&result).Error
+	if err == nil {
+		imp.tags[slug] = result.ID
+		return result.ID
+	}
+
+	result = model.Tag{
+		Base:    imp.base(),
+		Name:    name,
+		Slug:    slugx.Approve(&config.DB, imp.slug(slug, name), int(sID), "tags"),
+		SpaceID: sID,
+	}
+	result.Description, result.HTMLDescription = describe(description)
+
+	if err = config.DB.Model(&model.Tag{}).Create(&result).Error; err != nil {
+		imp.fail(0, name, err)
+		return 0
+	}
+
+	imp.job.Tags++
+	imp.tags[slug] = result.ID
+	return result.ID
+}
+
+func (imp *importer) importMedium(each item) {
+	sID := imp.job.SpaceID
+	url := strings.TrimSpace(each.AttachmentURL)
+	if url == "" {
+		imp.job.Skipped++
+		return
+	}
+
+	existing := model.Medium{}
+	err := config.DB.Model(&model.Medium{}).Where(&model.Medium{
+		SpaceID: sID,
+	}).Where("url->>'raw' = ?", url).First(&existing).Error
+	if err == nil {
+		imp.media[each.PostID] = existing.ID
+		return
+	}
+
+	if imp.mediaQuota == 0 {
+		imp.job.Skipped++
+		imp.fail(each.PostID, each.Title, errors.New("cannot create more media"))
+		return
+	}
+
+	name := path.Base(url)
+	result := model.Medium{
+		Base:        imp.base(),
+		Name:        name,
+		Slug:        slugx.Approve(&config.DB, imp.slug(each.PostName, name), int(sID), "media"),
+		Type:        mime.TypeByExtension(path.Ext(name)),
+		Title:       each.Title,
+		Description: each.content(),
+		Caption:     each.excerpt(),
+		AltText:     each.meta("_wp_attachment_image_alt"),
+		URL:         toJsonb(map[string]string{"raw": url}),
+		SpaceID:     sID,
+	}
+
+	if err = config.DB.Model(&model.Medium{}).Create(&result).Error; err != nil {
+		imp.fail(each.PostID, each.Title, err)
+		return
+	}
+
+	if imp.mediaQuota > 0 {
+		imp.mediaQuota--
+	}
+	imp.job.Media++
+	imp.media[each.PostID] = result.ID
+}
+
+func (imp *importer) importPost(each item) {
+	sID := imp.job.SpaceID
+
+	var status string
+	switch each.Status {
+	case "publish":
+		status = "draft"
+		if imp.publish {
+			status = "publish"
+		}
+	case "draft", "pending", "private", "future":
+		status = "draft"
+	default:
+		// trash, auto-draft and inherit items are not content
+		imp.job.Skipped++
+		return
+	}
+
+	var count int64
+	config.DB.Model(&model.Post{}).Where(&model.Post{
+		SpaceID: sID,
+	}).Where("meta_fields->>'wordpress_id' = ?", strconv.Itoa(each.PostID)).Count(&count)
+	if count > 0 {
+		// already imported by an earlier job
+		imp.job.Skipped++
+		return
+	}
+
+	if imp.postQuota == 0 {
+		imp.job.Skipped++
+		imp.fail(each.PostID, each.Title, errors.New("cannot create more posts"))
+		return
+	}
+
+	description, htmlDescription := describe(each.content())
+
+	post := model.Post{
+		Base:            imp.base(),
+		Title:           each.Title,
+		Slug:            slugx.Approve(&config.DB, imp.slug(each.PostName, each.Title), int(sID), "posts"),
+		Status:          status,
+		IsPage:          each.PostType == "page",
+		IsSticky:        each.IsSticky == 1,
+		Excerpt:         each.excerpt(),
+		Description:     description,
+		HTMLDescription: htmlDescription,
+		FormatID:        imp.format.ID,
+		MetaFields: toJsonb(map[string]interface{}{
+			"wordpress_id":   each.PostID,
+			"wordpress_link": each.Link,
+		}),
+		SpaceID: sID,
+	}
+
+	if status == "publish" {
+		post.PublishedDate = each.publishedDate()
+		if post.PublishedDate == nil {
+			currTime := time.Now()
+			post.PublishedDate = &currTime
+		}
+	}
+
+	if mediumID, found := imp.media[atoi(each.meta("_thumbnail_id"))]; found {
+		post.FeaturedMediumID = &mediumID
+	}
+
+	for _, term := range each.terms("category") {
+		if id := imp.category(term.Nicename, term.Name, "", 0); id != 0 {
+			post.Categories = append(post.Categories, model.Category{Base: config.Base{ID: id}, SpaceID: sID})
+		}
+	}
+	for _, term := range each.terms("post_tag") {
+		if id := imp.tag(term.Nicename, term.Name, ""); id != 0 {
+			post.Tags = append(post.Tags, model.Tag{Base: config.Base{ID: id}, SpaceID: sID})
+		}
+	}
+
+	authorID, found := imp.authors[each.Creator]
+	if !found {
+		authorID = uint(imp.uID)
+	}
+
+	tx := config.DB.Begin()
+
+	err := tx.Model(&model.Post{}).Create(&post).Error
+	if err != nil {
+		tx.Rollback()
+		imp.fail(each.PostID, each.Title, err)
+		return
+	}
+
+	err = tx.Model(&model.PostAuthor{}).Create(&model.PostAuthor{
+		Base:     imp.base(),
+		AuthorID: authorID,
+		PostID:   post.ID,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		imp.fail(each.PostID, each.Title, err)
+		return
+	}
+
+	post.Space = &imp.space
+	authors := []model.Author{{Base: config.Base{ID: authorID}}}
+	schemas := schemax.GetSchemas(schemax.PostData{
+		Post:    post,
+		Authors: authors,
+		Claims:  []factCheckModel.Claim{},
+	}, imp.space, []factCheckModel.Rating{})
+	// the format is only linked for the permalink, the post is saved already
+	linked := post
+	linked.Format = &imp.format
+	schemas = permalink.New(imp.space).Schemas(schemas, linked, authors)
+	post.Schemas = toJsonb(schemas)
+	tx.Model(&post).Select("Schemas").Updates(&model.Post{
+		Schemas: post.Schemas,
+	})
+
+	if err = revision.Create(tx, post, []uint{authorID}, nil); err != nil {
+		tx.Rollback()
+		imp.fail(each.PostID, each.Title, err)
+		return
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "post.created", post); err != nil {
+			tx.Rollback()
+			imp.fail(each.PostID, each.Title, err)
+			return
+		}
+	}
+
+	tx.Commit()
+
+	if imp.postQuota > 0 {
+		imp.postQuota--
+	}
+	if post.IsPage {
+		imp.job.Pages++
+	} else {
+		imp.job.Posts++
+	}
+}
+
+// slug keeps the WordPress slug when it is valid
+func (imp *importer) slug(slug, title string) string {
+	if slug != "" && slugx.Check(slug) {
+		return slug
+	}
+	return slugx.Make(title)
+}
+
+func (imp *importer) base() config.Base {
+	return config.Base{
+		CreatedByID: uint(imp.uID),
+		UpdatedByID: uint(imp.uID),
+	}
+}
+
+func (imp *importer) fail(wordpressID int, title string, err error) {
+	log.Println(err)
+	imp.errors = append(imp.errors, itemError{
+		WordpressID: wordpressID,
+		Title:       title,
+		Error:       err.Error(),
+	})
+}
+
+func (imp *importer) processed() {
+	imp.job.Processed++
+	if imp.job.Processed%progressInterval == 0 {
+		imp.save()
+	}
+}
+
+// save persists the progress of the job
+func (imp *importer) save() {
+	imp.job.Errors = toJsonb(imp.errors)
+	err := config.DB.Model(&model.ImportJob{}).Where("id = ?", imp.job.ID).Select(
+		"Status", "Total", "Processed", "Posts", "Pages", "Categories", "Tags", "Media", "Skipped", "Errors",
+	).Updates(imp.job).Error
+	if err != nil {
+		log.Println(err)
+	}
+
+	if imp.progress != nil {
+		imp.progress(*imp.job)
+	}
+}
+
+// describe converts WordPress HTML into an EditorJS description and its HTML
+func describe(content string) (postgres.Jsonb, string) {
+	if strings.TrimSpace(content) == "" {
+		return postgres.Jsonb{}, ""
+	}
+
+	description, err := toEditorJS(content)
+	if err != nil {
+		log.Println(err)
+		return postgres.Jsonb{}, ""
+	}
+
+	htmlDescription, err := util.HTMLDescription(description)
+	if err != nil {
+		log.Println(err)
+	}
+	return description, htmlDescription
+}
+
+func toJsonb(data interface{}) postgres.Jsonb {
+	byteArr, _ := json.Marshal(data)
+	return postgres.Jsonb{RawMessage: byteArr}
+}
+
+func atoi(value string) int {
+	i, _ := strconv.Atoi(strings.TrimSpace(value))
+	return i
+}
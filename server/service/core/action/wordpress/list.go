@@ -0,0 +1,57 @@
+package wordpress
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+)
+
+type paging struct {
+	Total int64             `json:"total"`
+	Nodes []model.ImportJob `json:"nodes"`
+}
+
+// list - Get all WordPress imports
+// @Summary Show all WordPress imports
+// @Description Get all WordPress import jobs of the space
+// @Tags WordPress Import
+// @ID get-all-wordpress-imports
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Success 200 {object} paging
+// @Router /core/import/wordpress [get]
+func list(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	result := paging{}
+	result.Nodes = make([]model.ImportJob, 0)
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	err = config.DB.Model(&model.ImportJob{}).Where(&model.ImportJob{
+		Source:  "wordpress",
+		SpaceID: uint(sID),
+	}).Count(&result.Total).Order("id desc").Offset(offset).Limit(limit).Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,22 @@
+package wordpress
+
+import (
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+)
+
+var userContext config.ContextKey = "import_user"
+
+// Router - Group of WordPress import router
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "posts"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", create)
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/{job_id}", details)
+
+	return r
+}
@@ -0,0 +1,153 @@
+package wordpress
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+)
+
+const contentNamespace = "http://purl.org/rss/1.0/modules/content/"
+
+// wxr is the root of a WordPress eXtended RSS export
+type wxr struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel channel  `xml:"channel"`
+}
+
+type channel struct {
+	Title      string     `xml:"title"`
+	Authors    []author   `xml:"author"`
+	Categories []category `xml:"category"`
+	Tags       []tag      `xml:"tag"`
+	Items      []item     `xml:"item"`
+}
+
+type author struct {
+	ID          int    `xml:"author_id"`
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+	FirstName   string `xml:"author_first_name"`
+	LastName    string `xml:"author_last_name"`
+}
+
+type category struct {
+	ID          int    `xml:"term_id"`
+	Nicename    string `xml:"category_nicename"`
+	Parent      string `xml:"category_parent"`
+	Name        string `xml:"cat_name"`
+	Description string `xml:"category_description"`
+}
+
+type tag struct {
+	ID          int    `xml:"term_id"`
+	Slug        string `xml:"tag_slug"`
+	Name        string `xml:"tag_name"`
+	Description string `xml:"tag_description"`
+}
+
+type item struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Creator       string     `xml:"creator"`
+	Description   string     `xml:"description"`
+	Encoded       []encoded  `xml:"encoded"`
+	PostID        int        `xml:"post_id"`
+	PostDateGMT   string     `xml:"post_date_gmt"`
+	PostName      string     `xml:"post_name"`
+	Status        string     `xml:"status"`
+	PostParent    int        `xml:"post_parent"`
+	PostType      string     `xml:"post_type"`
+	IsSticky      int        `xml:"is_sticky"`
+	AttachmentURL string     `xml:"attachment_url"`
+	Terms         []term     `xml:"category"`
+	Meta          []postmeta `xml:"postmeta"`
+}
+
+// encoded holds both content:encoded and excerpt:encoded, told apart by namespace
+type encoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type term struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type postmeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// parse decodes a WXR export
+func parse(r io.Reader) (*wxr, error) {
+	export := &wxr{}
+	decoder := xml.NewDecoder(r)
+	// WXR files are often served with a charset other than UTF-8 declared;
+	// the content itself is UTF-8 in every export WordPress produces.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	decoder.Strict = false
+
+	if err := decoder.Decode(export); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// content returns the HTML body of the item
+func (i item) content() string {
+	for _, each := range i.Encoded {
+		if each.XMLName.Space == contentNamespace {
+			return each.Value
+		}
+	}
+	return ""
+}
+
+// excerpt returns the excerpt of the item
+func (i item) excerpt() string {
+	for _, each := range i.Encoded {
+		if each.XMLName.Space != contentNamespace {
+			return strings.TrimSpace(each.Value)
+		}
+	}
+	return ""
+}
+
+// meta returns the value of the post meta with the given key
+func (i item) meta(key string) string {
+	for _, each := range i.Meta {
+		if each.Key == key {
+			return each.Value
+		}
+	}
+	return ""
+}
+
+// terms returns the terms of the item in the given taxonomy
+func (i item) terms(domain string) []term {
+	terms := make([]term, 0)
+	for _, each := range i.Terms {
+		if each.Domain == domain {
+			terms = append(terms, each)
+		}
+	}
+	return terms
+}
+
+// publishedDate returns the GMT publish date of the item, if any
+func (i item) publishedDate() *time.Time {
+	if i.PostDateGMT == "" || strings.HasPrefix(i.PostDateGMT, "0000") {
+		return nil
+	}
+	date, err := time.Parse("2006-01-02 15:04:05", i.PostDateGMT)
+	if err != nil {
+		return nil
+	}
+	return &date
+}
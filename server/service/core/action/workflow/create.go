@@ -0,0 +1,128 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/factly/x/validationx"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// create - Create workflow stage
+// @Summary Create workflow stage
+// @Description Create workflow stage
+// @Tags Workflow
+// @ID add-workflow-stage
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param Stage body stage true "Stage Object"
+// @Success 201 {object} model.WorkflowStage
+// @Failure 400 {array} string
+// @Router /core/workflows/stages [post]
+func create(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	stage := &stage{}
+
+	err = json.NewDecoder(r.Body).Decode(&stage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(stage)
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	var stageSlug string
+	if stage.Slug != "" && slugx.Check(stage.Slug) {
+		stageSlug = stage.Slug
+	} else {
+		stageSlug = slugx.Make(stage.Name)
+	}
+
+	// Get table name
+	stmt := &gorm.Statement{DB: config.DB}
+	_ = stmt.Parse(&model.WorkflowStage{})
+	tableName := stmt.Schema.Table
+
+	result := &model.WorkflowStage{
+		Name:        stage.Name,
+		Slug:        slugx.Approve(&config.DB, stageSlug, sID, tableName),
+		Position:    stage.Position,
+		PostStatus:  stage.PostStatus,
+		Transitions: toJsonb(stage.Transitions),
+		SpaceID:     uint(sID),
+	}
+
+	err = config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Model(&model.WorkflowStage{}).Create(&result).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
+
+func toJsonb(slugs []string) postgres.Jsonb {
+	if slugs == nil {
+		slugs = make([]string, 0)
+	}
+	byteArr, _ := json.Marshal(slugs)
+	return postgres.Jsonb{RawMessage: byteArr}
+}
+
+// allowed checks if a post in stage from can be moved to stage to
+func allowed(from *model.WorkflowStage, to model.WorkflowStage) bool {
+	if from == nil {
+		return true
+	}
+
+	slugs := make([]string, 0)
+	if len(from.Transitions.RawMessage) > 0 {
+		_ = json.Unmarshal(from.Transitions.RawMessage, &slugs)
+	}
+
+	// stages without configured transitions can move anywhere
+	if len(slugs) == 0 {
+		return true
+	}
+
+	for _, slug := range slugs {
+		if slug == to.Slug {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+)
+
+// DataFile default json data file
+var DataFile = "./data/workflow.json"
+
+// createDefaults - Create Default Workflow Stages
+// @Summary Create Default Workflow Stages
+// @Description Create the default draft, in-review, fact-check-verified, ready and published stages
+// @Tags Workflow
+// @ID add-default-workflow-stages
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Success 201 {object} paging
+// @Failure 400 {array} string
+// @Router /core/workflows/stages/default [post]
+func createDefaults(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	jsonFile, err := os.Open(DataFile)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	defer jsonFile.Close()
+
+	stages := make([]stage, 0)
+
+	byteValue, _ := ioutil.ReadAll(jsonFile)
+	err = json.Unmarshal(byteValue, &stages)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	result := paging{}
+	result.Nodes = make([]model.WorkflowStage, 0)
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+	for _, each := range stages {
+		workflowStage := model.WorkflowStage{
+			Name:        each.Name,
+			Slug:        each.Slug,
+			Position:    each.Position,
+			PostStatus:  each.PostStatus,
+			Transitions: toJsonb(each.Transitions),
+			SpaceID:     uint(sID),
+		}
+		err = tx.Model(&model.WorkflowStage{}).Where(&model.WorkflowStage{
+			Slug:    each.Slug,
+			SpaceID: uint(sID),
+		}).FirstOrCreate(&workflowStage).Error
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+		result.Nodes = append(result.Nodes, workflowStage)
+	}
+	result.Total = int64(len(result.Nodes))
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
@@ -0,0 +1,73 @@
+package workflow
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// delete - Delete workflow stage by id
+// @Summary Delete a workflow stage
+// @Description Delete workflow stage by ID
+// @Tags Workflow
+// @ID delete-workflow-stage-by-id
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param stage_id path string true "Stage ID"
+// @Success 200
+// @Failure 400 {array} string
+// @Router /core/workflows/stages/{stage_id} [delete]
+func delete(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	stageID := chi.URLParam(r, "stage_id")
+	id, err := strconv.Atoi(stageID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.WorkflowStage{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.WorkflowStage{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	// check if the stage is associated with posts
+	var totAssociated int64
+	config.DB.Model(&model.Post{}).Where(&model.Post{
+		WorkflowStageID: &result.ID,
+	}).Count(&totAssociated)
+
+	if totAssociated != 0 {
+		loggerx.Error(errors.New("workflow stage is associated with post"))
+		errorx.Render(w, errorx.Parser(errorx.CannotDelete("workflow stage", "post")))
+		return
+	}
+
+	config.DB.Delete(&result)
+
+	renderx.JSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,58 @@
+package workflow
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// details - Get workflow stage by id
+// @Summary Show a workflow stage by id
+// @Description Get workflow stage by ID
+// @Tags Workflow
+// @ID get-workflow-stage-by-id
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param stage_id path string true "Stage ID"
+// @Success 200 {object} model.WorkflowStage
+// @Router /core/workflows/stages/{stage_id} [get]
+func details(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	stageID := chi.URLParam(r, "stage_id")
+	id, err := strconv.Atoi(stageID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := &model.WorkflowStage{}
+	result.ID = uint(id)
+
+	err = config.DB.Model(&model.WorkflowStage{}).Where(&model.WorkflowStage{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
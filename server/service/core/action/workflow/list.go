@@ -0,0 +1,53 @@
+package workflow
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+)
+
+// list response
+type paging struct {
+	Total int64                 `json:"total"`
+	Nodes []model.WorkflowStage `json:"nodes"`
+}
+
+// list - Get all workflow stages
+// @Summary Show all workflow stages
+// @Description Get all workflow stages of the space in order
+// @Tags Workflow
+// @ID get-all-workflow-stages
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Success 200 {object} paging
+// @Router /core/workflows/stages [get]
+func list(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	result := paging{}
+	result.Nodes = make([]model.WorkflowStage, 0)
+
+	err = config.DB.Model(&model.WorkflowStage{}).Where(&model.WorkflowStage{
+		SpaceID: uint(sID),
+	}).Count(&result.Total).Order("position asc").Find(&result.Nodes).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,137 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// assign - Assign reviewers to post
+// @Summary Assign reviewers to a post
+// @Description Replace the reviewers of a post
+// @Tags Workflow
+// @ID assign-post-reviewers
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param Reviewers body reviewers true "Reviewers Object"
+// @Success 200 {object} workflowData
+// @Router /core/posts/{post_id}/workflow/reviewers [put]
+func assign(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	postID := chi.URLParam(r, "post_id")
+	id, err := strconv.Atoi(postID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	reviewers := &reviewers{}
+	err = json.NewDecoder(r.Body).Decode(&reviewers)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	post := model.Post{}
+	post.ID = uint(id)
+
+	err = config.DB.Model(&model.Post{}).Where(&model.Post{
+		SpaceID: uint(sID),
+	}).Where("is_page = ?", false).Preload("WorkflowStage").First(&post).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	authors, err := author.All(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	result := workflowData{
+		PostID:    post.ID,
+		Stage:     post.WorkflowStage,
+		Reviewers: make([]model.Author, 0),
+		History:   make([]model.PostTransition, 0),
+	}
+
+	for _, reviewerID := range reviewers.ReviewerIDs {
+		if _, found := authors[fmt.Sprint(reviewerID)]; !found {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(fmt.Sprint("reviewer ", reviewerID, " is not a member of the organisation"), http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	err = tx.Where(&model.PostReviewer{
+		PostID: post.ID,
+	}).Delete(&model.PostReviewer{}).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	for _, reviewerID := range reviewers.ReviewerIDs {
+		err = tx.Model(&model.PostReviewer{}).Create(&model.PostReviewer{
+			PostID:     post.ID,
+			ReviewerID: reviewerID,
+		}).Error
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+		result.Reviewers = append(result.Reviewers, authors[fmt.Sprint(reviewerID)])
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "post.reviewers.assigned", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,69 @@
+package workflow
+
+import (
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+)
+
+// stage request body
+type stage struct {
+	Name        string   `json:"name" validate:"required,min=3,max=50"`
+	Slug        string   `json:"slug"`
+	Position    int      `json:"position"`
+	PostStatus  string   `json:"post_status" validate:"omitempty,oneof=draft ready publish"`
+	Transitions []string `json:"transitions"`
+}
+
+// transition request body
+type transition struct {
+	StageID uint   `json:"stage_id" validate:"required"`
+	Note    string `json:"note"`
+}
+
+// reviewers request body
+type reviewers struct {
+	ReviewerIDs []uint `json:"reviewer_ids"`
+}
+
+type workflowData struct {
+	PostID    uint                   `json:"post_id"`
+	Stage     *model.WorkflowStage   `json:"stage"`
+	Reviewers []model.Author         `json:"reviewers"`
+	History   []model.PostTransition `json:"history"`
+}
+
+var userContext config.ContextKey = "workflow_user"
+
+// Router - Group of workflow stage router
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "workflows"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/stages", list)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/stages", create)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/stages/default", createDefaults)
+
+	r.Route("/stages/{stage_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
+		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
+		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+	})
+
+	return r
+}
+
+// PostRouter - Group of workflow router for a single post
+func PostRouter() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "posts"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", status)
+	r.With(util.CheckKetoPolicy(entity, "update")).Post("/transition", move)
+	r.With(util.CheckKetoPolicy(entity, "update")).Put("/reviewers", assign)
+
+	return r
+}
@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// status - Get workflow status of post
+// @Summary Show workflow status of a post
+// @Description Get current stage, reviewers and transition history of a post
+// @Tags Workflow
+// @ID get-post-workflow
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Success 200 {object} workflowData
+// @Router /core/posts/{post_id}/workflow [get]
+func status(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	postID := chi.URLParam(r, "post_id")
+	id, err := strconv.Atoi(postID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	post := model.Post{}
+	post.ID = uint(id)
+
+	err = config.DB.Model(&model.Post{}).Where(&model.Post{
+		SpaceID: uint(sID),
+	}).Where("is_page = ?", false).Preload("WorkflowStage").First(&post).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result := workflowData{
+		PostID:    post.ID,
+		Stage:     post.WorkflowStage,
+		Reviewers: make([]model.Author, 0),
+		History:   make([]model.PostTransition, 0),
+	}
+
+	postReviewers := make([]model.PostReviewer, 0)
+	config.DB.Model(&model.PostReviewer{}).Where(&model.PostReviewer{
+		PostID: post.ID,
+	}).Find(&postReviewers)
+
+	if len(postReviewers) > 0 {
+		authors, err := author.All(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		for _, each := range postReviewers {
+			if reviewer, found := authors[fmt.Sprint(each.ReviewerID)]; found {
+				result.Reviewers = append(result.Reviewers, reviewer)
+			}
+		}
+	}
+
+	config.DB.Model(&model.PostTransition{}).Where(&model.PostTransition{
+		PostID: post.ID,
+	}).Preload("FromStage").Preload("ToStage").Order("created_at desc").Find(&result.History)
+
+	renderx.JSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,368 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// postData is the payload of the post.published and post.unpublished events
+type postData struct {
+	model.Post
+	Authors    []model.Author         `json:"authors"`
+	Claims     []factCheckModel.Claim `json:"claims"`
+	ClaimOrder []uint                 `json:"claim_order"`
+}
+
+// transitionData is the payload of the post.transitioned event
+type transitionData struct {
+	model.PostTransition
+	Post model.Post `json:"post"`
+}
+
+// move - Move post to workflow stage
+// @Summary Move a post to another workflow stage
+// @Description Move a post to another workflow stage and record the transition
+// @Tags Workflow
+// @ID move-post-workflow-stage
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param post_id path string true "Post ID"
+// @Param Transition body transition true "Transition Object"
+// @Success 200 {object} model.PostTransition
+// @Failure 400 {array} string
+// @Router /core/posts/{post_id}/workflow/transition [post]
+func move(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	oID, err := util.GetOrganisation(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	postID := chi.URLParam(r, "post_id")
+	id, err := strconv.Atoi(postID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	transition := &transition{}
+	err = json.NewDecoder(r.Body).Decode(&transition)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(transition)
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	result := &postData{}
+	result.ID = uint(id)
+	result.Authors = make([]model.Author, 0)
+	result.Claims = make([]factCheckModel.Claim, 0)
+
+	err = config.DB.Model(&model.Post{}).Where(&model.Post{
+		SpaceID: uint(sID),
+	}).Where("is_page = ?", false).Preload("WorkflowStage").First(&result.Post).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	to := model.WorkflowStage{}
+	to.ID = transition.StageID
+
+	err = config.DB.Model(&model.WorkflowStage{}).Where(&model.WorkflowStage{
+		SpaceID: uint(sID),
+	}).First(&to).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("workflow stage not found", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	if !allowed(result.WorkflowStage, to) {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("transition not allowed", http.StatusUnprocessableEntity)))
+		return
+	}
+
+	oldStatus := result.Status
+	newStatus := oldStatus
+	if to.PostStatus != "" {
+		newStatus = to.PostStatus
+	}
+
+	postAuthors := make([]model.PostAuthor, 0)
+	config.DB.Model(&model.PostAuthor{}).Where(&model.PostAuthor{
+		PostID: result.ID,
+	}).Find(&postAuthors)
+
+	if newStatus != oldStatus && (newStatus == "publish" || oldStatus == "publish") {
+		if newStatus == "publish" && len(postAuthors) == 0 {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot publish post without author", http.StatusUnprocessableEntity)))
+			return
+		}
+
+		stat, err := getPublishPermissions(oID, sID, uID)
+		if err != nil || stat != http.StatusOK {
+			loggerx.Error(errors.New("user is not allowed to publish"))
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	updates := map[string]interface{}{
+		"workflow_stage_id": to.ID,
+		"status":            newStatus,
+		"updated_by_id":     uID,
+	}
+	if newStatus == "publish" && result.PublishedDate == nil {
+		updates["published_date"] = time.Now()
+	} else if newStatus != "publish" && oldStatus == "publish" {
+		updates["published_date"] = nil
+	}
+
+	err = tx.Model(&result.Post).Omit("WorkflowStage").Updates(updates).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	postTransition := model.PostTransition{
+		PostID:    result.ID,
+		ToStageID: to.ID,
+		Note:      transition.Note,
+		SpaceID:   uint(sID),
+	}
+	// the stage id of the post is the new one after the update
+	if result.WorkflowStage != nil {
+		postTransition.FromStageID = &result.WorkflowStage.ID
+	}
+
+	err = tx.Model(&model.PostTransition{}).Create(&postTransition).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	tx.Model(&model.Post{}).Preload("Medium").Preload("Format").Preload("Tags").Preload("Categories").Preload("Space").Preload("WorkflowStage").First(&result.Post)
+	tx.Model(&model.PostTransition{}).Preload("FromStage").Preload("ToStage").First(&postTransition)
+
+	if config.SearchEnabled() {
+		var meiliPublishDate int64
+		if result.Status == "publish" && result.PublishedDate != nil {
+			meiliPublishDate = result.PublishedDate.Unix()
+		}
+		_ = search.UpdateDocument(map[string]interface{}{
+			"id":                result.ID,
+			"kind":              "post",
+			"status":            result.Status,
+			"workflow_stage_id": to.ID,
+			"published_date":    meiliPublishDate,
+		})
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "post.transitioned", transitionData{
+			PostTransition: postTransition,
+			Post:           result.Post,
+		}); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+
+		if oldStatus != result.Status && (result.Status == "publish" || oldStatus == "publish") {
+			if result.Format != nil && result.Format.Slug == "fact-check" {
+				postClaims := []factCheckModel.PostClaim{}
+				config.DB.Model(&factCheckModel.PostClaim{}).Where(&factCheckModel.PostClaim{
+					PostID: result.ID,
+				}).Preload("Claim").Preload("Claim.Rating").Preload("Claim.Rating.Medium").Preload("Claim.Claimant").Preload("Claim.Claimant.Medium").Find(&postClaims)
+
+				result.ClaimOrder = make([]uint, len(postClaims))
+				for _, postClaim := range postClaims {
+					result.Claims = append(result.Claims, postClaim.Claim)
+					result.ClaimOrder[int(postClaim.Position-1)] = postClaim.ClaimID
+				}
+			}
+
+			if len(postAuthors) > 0 {
+				authors, err := author.All(r.Context())
+				if err == nil {
+					for _, each := range postAuthors {
+						if author, found := authors[fmt.Sprint(each.AuthorID)]; found {
+							result.Authors = append(result.Authors, author)
+						}
+					}
+				}
+			}
+
+			event := "post.unpublished"
+			if result.Status == "publish" {
+				event = "post.published"
+			}
+			if err = util.Outbox(tx, event, result); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, postTransition)
+}
+
+// ErrTransitionNotAllowed - no stage of the new status of a post can be
+// reached from its stage
+var ErrTransitionNotAllowed = errors.New("transition not allowed")
+
+// Route moves a post whose status is changed to the first stage of the new
+// status which can be reached from its stage and records the transition.
+// Posts are left in their stage when no stage of the space has the status.
+func Route(tx *gorm.DB, post *model.Post, status string, uID int) error {
+	// scheduled posts wait in the stage they are published from
+	if status == "scheduled" {
+		status = "publish"
+	}
+
+	stages := make([]model.WorkflowStage, 0)
+	err := tx.Model(&model.WorkflowStage{}).Where(&model.WorkflowStage{
+		SpaceID:    post.SpaceID,
+		PostStatus: status,
+	}).Order("position asc").Find(&stages).Error
+	if err != nil {
+		return err
+	}
+
+	if len(stages) == 0 {
+		return nil
+	}
+
+	var from *model.WorkflowStage
+	if post.WorkflowStageID != nil {
+		from = &model.WorkflowStage{}
+		from.ID = *post.WorkflowStageID
+		if err = tx.Model(&model.WorkflowStage{}).First(from).Error; err != nil {
+			return err
+		}
+	}
+
+	var to *model.WorkflowStage
+	for i, stage := range stages {
+		if from != nil && from.ID == stage.ID {
+			return nil
+		}
+		if to == nil && allowed(from, stage) {
+			to = &stages[i]
+		}
+	}
+
+	if to == nil {
+		return ErrTransitionNotAllowed
+	}
+
+	err = tx.Model(&model.Post{}).Where("id = ?", post.ID).UpdateColumn("workflow_stage_id", to.ID).Error
+	if err != nil {
+		return err
+	}
+	post.WorkflowStageID = &to.ID
+
+	postTransition := model.PostTransition{
+		PostID:    post.ID,
+		ToStageID: to.ID,
+		Note:      fmt.Sprint("status changed to ", status),
+		SpaceID:   post.SpaceID,
+	}
+	if from != nil {
+		postTransition.FromStageID = &from.ID
+	}
+
+	err = tx.WithContext(context.WithValue(tx.Statement.Context, userContext, uID)).Model(&model.PostTransition{}).Create(&postTransition).Error
+	if err != nil {
+		return err
+	}
+
+	if util.CheckNats() {
+		postTransition.FromStage = from
+		postTransition.ToStage = to
+		return util.Outbox(tx, "post.transitioned", transitionData{
+			PostTransition: postTransition,
+			Post:           *post,
+		})
+	}
+
+	return nil
+}
+
+func getPublishPermissions(oID, sID, uID int) (int, error) {
+	commonString := fmt.Sprint(":org:", oID, ":app:dega:space:", sID, ":")
+
+	kresource := fmt.Sprint("resources", commonString, "posts")
+	kaction := fmt.Sprint("actions", commonString, "posts:publish")
+
+	result := util.KetoAllowed{}
+
+	result.Action = kaction
+	result.Resource = kresource
+	result.Subject = fmt.Sprint(uID)
+
+	resStatus, err := util.IsAllowed(result)
+	if err != nil {
+		return 0, err
+	}
+
+	return resStatus, nil
+}
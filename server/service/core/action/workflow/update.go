@@ -0,0 +1,116 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// update - Update workflow stage by id
+// @Summary Update a workflow stage by id
+// @Description Update workflow stage by ID
+// @Tags Workflow
+// @ID update-workflow-stage-by-id
+// @Produce json
+// @Consume json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param stage_id path string true "Stage ID"
+// @Param Stage body stage false "Stage"
+// @Success 200 {object} model.WorkflowStage
+// @Router /core/workflows/stages/{stage_id} [put]
+func update(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	stageID := chi.URLParam(r, "stage_id")
+	id, err := strconv.Atoi(stageID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	stage := &stage{}
+	err = json.NewDecoder(r.Body).Decode(&stage)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(stage)
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	result := &model.WorkflowStage{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.WorkflowStage{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	// Get table name
+	stmt := &gorm.Statement{DB: config.DB}
+	_ = stmt.Parse(&model.WorkflowStage{})
+	tableName := stmt.Schema.Table
+
+	var stageSlug string
+	if result.Slug == stage.Slug {
+		stageSlug = result.Slug
+	} else if stage.Slug != "" && slugx.Check(stage.Slug) {
+		stageSlug = slugx.Approve(&config.DB, stage.Slug, sID, tableName)
+	} else {
+		stageSlug = slugx.Approve(&config.DB, slugx.Make(stage.Name), sID, tableName)
+	}
+
+	err = config.DB.Model(&result).Select("Name", "Slug", "Position", "PostStatus", "Transitions", "UpdatedByID").Updates(model.WorkflowStage{
+		Base:        config.Base{UpdatedByID: uint(uID)},
+		Name:        stage.Name,
+		Slug:        stageSlug,
+		Position:    stage.Position,
+		PostStatus:  stage.PostStatus,
+		Transitions: toJsonb(stage.Transitions),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
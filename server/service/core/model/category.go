@@ -12,22 +12,24 @@ import (
 // Category model
 type Category struct {
 	config.Base
-	Name             string         `gorm:"column:name" json:"name"`
-	Slug             string         `gorm:"column:slug" json:"slug"`
-	BackgroundColour postgres.Jsonb `gorm:"column:background_colour" json:"background_colour" swaggertype:"primitive,string"`
-	Description      postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
-	HTMLDescription  string         `gorm:"column:html_description" json:"html_description,omitempty"`
-	ParentID         *uint          `gorm:"column:parent_id;default:NULL" json:"parent_id"`
-	MediumID         *uint          `gorm:"column:medium_id;default:NULL" json:"medium_id"`
-	Medium           *Medium        `json:"medium"`
-	IsFeatured       bool           `gorm:"column:is_featured" json:"is_featured"`
-	SpaceID          uint           `gorm:"column:space_id" json:"space_id"`
-	Posts            []*Post        `gorm:"many2many:post_categories;" json:"posts"`
-	Space            *Space         `json:"space,omitempty"`
-	MetaFields       postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
-	Meta             postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
-	HeaderCode       string         `gorm:"column:header_code" json:"header_code"`
-	FooterCode       string         `gorm:"column:footer_code" json:"footer_code"`
+	Name               string         `gorm:"column:name" json:"name"`
+	Slug               string         `gorm:"column:slug" json:"slug"`
+	BackgroundColour   postgres.Jsonb `gorm:"column:background_colour" json:"background_colour" swaggertype:"primitive,string"`
+	Description        postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
+	HTMLDescription    string         `gorm:"column:html_description" json:"html_description,omitempty"`
+	ParentID           *uint          `gorm:"column:parent_id;default:NULL" json:"parent_id"`
+	MediumID           *uint          `gorm:"column:medium_id;default:NULL" json:"medium_id"`
+	Medium             *Medium        `json:"medium"`
+	IsFeatured         bool           `gorm:"column:is_featured" json:"is_featured"`
+	SpaceID            uint           `gorm:"column:space_id" json:"space_id"`
+	Posts              []*Post        `gorm:"many2many:post_categories;" json:"posts"`
+	Space              *Space         `json:"space,omitempty"`
+	MetaFields         postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
+	Meta               postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
+	HeaderCode         string         `gorm:"column:header_code" json:"header_code"`
+	FooterCode         string         `gorm:"column:footer_code" json:"footer_code"`
+	Locale             string         `gorm:"column:locale" json:"locale"`
+	TranslationGroupID *uint          `gorm:"column:translation_group_id;default:NULL" json:"translation_group_id"`
 }
 
 // BeforeSave - validation for medium
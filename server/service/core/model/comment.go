@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Comment model - editorial comment on a post or claim, optionally anchored to an EditorJS block
+type Comment struct {
+	config.Base
+	Body         string         `gorm:"column:body" json:"body"`
+	EntityType   string         `gorm:"column:entity_type;index:idx_comment_entity" json:"entity_type"`
+	EntityID     uint           `gorm:"column:entity_id;index:idx_comment_entity" json:"entity_id"`
+	BlockID      string         `gorm:"column:block_id" json:"block_id"`
+	ParentID     *uint          `gorm:"column:parent_id" json:"parent_id"`
+	Replies      []Comment      `gorm:"foreignKey:parent_id" json:"replies,omitempty"`
+	MentionIDs   postgres.Jsonb `gorm:"column:mention_ids" json:"mention_ids" swaggertype:"primitive,string"`
+	IsResolved   bool           `gorm:"column:is_resolved" json:"is_resolved"`
+	ResolvedByID *uint          `gorm:"column:resolved_by_id" json:"resolved_by_id"`
+	ResolvedAt   *time.Time     `gorm:"column:resolved_at" json:"resolved_at"`
+	SpaceID      uint           `gorm:"column:space_id" json:"space_id"`
+}
+
+var commentUser config.ContextKey = "comment_user"
+
+// BeforeCreate hook
+func (comment *Comment) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(commentUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	comment.CreatedByID = uint(uID)
+	comment.UpdatedByID = uint(uID)
+	return nil
+}
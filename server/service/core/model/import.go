@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/factly/dega-server/config"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// ImportJob model tracks the progress of a bulk import into a space
+type ImportJob struct {
+	config.Base
+	Source     string         `gorm:"column:source" json:"source"`
+	FileName   string         `gorm:"column:file_name" json:"file_name"`
+	Status     string         `gorm:"column:status;index" json:"status"`
+	Total      int            `gorm:"column:total" json:"total"`
+	Processed  int            `gorm:"column:processed" json:"processed"`
+	Posts      int            `gorm:"column:posts" json:"posts"`
+	Pages      int            `gorm:"column:pages" json:"pages"`
+	Categories int            `gorm:"column:categories" json:"categories"`
+	Tags       int            `gorm:"column:tags" json:"tags"`
+	Media      int            `gorm:"column:media" json:"media"`
+	Skipped    int            `gorm:"column:skipped" json:"skipped"`
+	Errors     postgres.Jsonb `gorm:"column:errors" json:"errors" swaggertype:"primitive,string"`
+	SpaceID    uint           `gorm:"column:space_id" json:"space_id"`
+	Space      *Space         `json:"space,omitempty"`
+}
+
+var importJobUser config.ContextKey = "import_user"
+
+// BeforeCreate hook
+func (job *ImportJob) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(importJobUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	job.CreatedByID = uint(uID)
+	job.UpdatedByID = uint(uID)
+	return nil
+}
@@ -10,12 +10,24 @@ func Migration() {
 		&Tag{},
 		&Space{},
 		&Format{},
+		&WorkflowStage{},
 		&Post{},
 		&PostAuthor{},
+		&PostRevision{},
+		&PostReviewer{},
+		&PostTransition{},
+		&Comment{},
 		&OrganisationPermission{},
 		&SpacePermission{},
 		&OrganisationPermissionRequest{},
 		&SpacePermissionRequest{},
 		&Menu{},
+		&Event{},
+		&Webhook{},
+		&WebhookLog{},
+		&OutboxEvent{},
+		&ImportJob{},
+		&PersistedQuery{},
+		&TranslationGroup{},
 	)
 }
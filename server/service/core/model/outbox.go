@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// OutboxEvent is an event waiting to be published to NATS. It is written in
+// the same transaction as the change it describes.
+type OutboxEvent struct {
+	ID          uint           `gorm:"primary_key" json:"id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	Subject     string         `gorm:"column:subject" json:"subject"`
+	Payload     postgres.Jsonb `gorm:"column:payload" json:"payload" swaggertype:"primitive,string"`
+	Status      string         `gorm:"column:status;index" json:"status"`
+	Attempts    int            `gorm:"column:attempts" json:"attempts"`
+	LastError   string         `gorm:"column:last_error" json:"last_error"`
+	PublishedAt *time.Time     `gorm:"column:published_at" json:"published_at"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/factly/dega-server/config"
+	"gorm.io/gorm"
+)
+
+// PersistedQuery model, a graphql query an organisation allows on dega-api
+type PersistedQuery struct {
+	config.Base
+	Name           string `gorm:"column:name" json:"name"`
+	Hash           string `gorm:"column:hash;uniqueIndex:idx_persisted_queries_organisation_hash" json:"hash"`
+	Query          string `gorm:"column:query" json:"query"`
+	OrganisationID uint   `gorm:"column:organisation_id;uniqueIndex:idx_persisted_queries_organisation_hash" json:"organisation_id"`
+}
+
+var persistedQueryUser config.ContextKey = "persisted_query_user"
+
+// BeforeCreate hook
+func (query *PersistedQuery) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(persistedQueryUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	query.CreatedByID = uint(uID)
+	query.UpdatedByID = uint(uID)
+	return nil
+}
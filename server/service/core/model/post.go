@@ -13,31 +13,35 @@ import (
 // Post model
 type Post struct {
 	config.Base
-	Title            string         `gorm:"column:title" json:"title"`
-	Subtitle         string         `gorm:"column:subtitle" json:"subtitle"`
-	Slug             string         `gorm:"column:slug" json:"slug"`
-	Status           string         `gorm:"column:status" json:"status"`
-	IsPage           bool           `gorm:"column:is_page" json:"is_page"`
-	Excerpt          string         `gorm:"column:excerpt" json:"excerpt"`
-	Description      postgres.Jsonb `gorm:"column:description" json:"description" sql:"jsonb" swaggertype:"primitive,string"`
-	HTMLDescription  string         `gorm:"column:html_description" json:"html_description,omitempty"`
-	IsFeatured       bool           `gorm:"column:is_featured" json:"is_featured"`
-	IsSticky         bool           `gorm:"column:is_sticky" json:"is_sticky"`
-	IsHighlighted    bool           `gorm:"column:is_highlighted" json:"is_highlighted"`
-	FeaturedMediumID *uint          `gorm:"column:featured_medium_id;default:NULL" json:"featured_medium_id"`
-	Medium           *Medium        `gorm:"foreignKey:featured_medium_id" json:"medium"`
-	FormatID         uint           `gorm:"column:format_id" json:"format_id" sql:"DEFAULT:NULL"`
-	Format           *Format        `json:"format"`
-	PublishedDate    *time.Time     `gorm:"column:published_date" json:"published_date"`
-	SpaceID          uint           `gorm:"column:space_id" json:"space_id"`
-	Schemas          postgres.Jsonb `gorm:"column:schemas" json:"schemas" swaggertype:"primitive,string"`
-	Meta             postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
-	HeaderCode       string         `gorm:"column:header_code" json:"header_code"`
-	FooterCode       string         `gorm:"column:footer_code" json:"footer_code"`
-	MetaFields       postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
-	Tags             []Tag          `gorm:"many2many:post_tags;" json:"tags"`
-	Categories       []Category     `gorm:"many2many:post_categories;" json:"categories"`
-	Space            *Space         `json:"space,omitempty"`
+	Title              string         `gorm:"column:title" json:"title"`
+	Subtitle           string         `gorm:"column:subtitle" json:"subtitle"`
+	Slug               string         `gorm:"column:slug" json:"slug"`
+	Status             string         `gorm:"column:status" json:"status"`
+	IsPage             bool           `gorm:"column:is_page" json:"is_page"`
+	Excerpt            string         `gorm:"column:excerpt" json:"excerpt"`
+	Description        postgres.Jsonb `gorm:"column:description" json:"description" sql:"jsonb" swaggertype:"primitive,string"`
+	HTMLDescription    string         `gorm:"column:html_description" json:"html_description,omitempty"`
+	IsFeatured         bool           `gorm:"column:is_featured" json:"is_featured"`
+	IsSticky           bool           `gorm:"column:is_sticky" json:"is_sticky"`
+	IsHighlighted      bool           `gorm:"column:is_highlighted" json:"is_highlighted"`
+	FeaturedMediumID   *uint          `gorm:"column:featured_medium_id;default:NULL" json:"featured_medium_id"`
+	Medium             *Medium        `gorm:"foreignKey:featured_medium_id" json:"medium"`
+	FormatID           uint           `gorm:"column:format_id" json:"format_id" sql:"DEFAULT:NULL"`
+	Format             *Format        `json:"format"`
+	PublishedDate      *time.Time     `gorm:"column:published_date" json:"published_date"`
+	WorkflowStageID    *uint          `gorm:"column:workflow_stage_id;default:NULL" json:"workflow_stage_id"`
+	WorkflowStage      *WorkflowStage `json:"workflow_stage,omitempty"`
+	SpaceID            uint           `gorm:"column:space_id" json:"space_id"`
+	Schemas            postgres.Jsonb `gorm:"column:schemas" json:"schemas" swaggertype:"primitive,string"`
+	Meta               postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
+	HeaderCode         string         `gorm:"column:header_code" json:"header_code"`
+	FooterCode         string         `gorm:"column:footer_code" json:"footer_code"`
+	MetaFields         postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
+	Locale             string         `gorm:"column:locale" json:"locale"`
+	TranslationGroupID *uint          `gorm:"column:translation_group_id;default:NULL" json:"translation_group_id"`
+	Tags               []Tag          `gorm:"many2many:post_tags;" json:"tags"`
+	Categories         []Category     `gorm:"many2many:post_categories;" json:"categories"`
+	Space              *Space         `json:"space,omitempty"`
 }
 
 // PostAuthor model
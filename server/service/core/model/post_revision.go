@@ -0,0 +1,45 @@
+package model
+
+import (
+	"errors"
+
+	"github.com/factly/dega-server/config"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// PostRevision model - immutable snapshot of a post or page taken on every save
+type PostRevision struct {
+	config.Base
+	PostID      uint           `gorm:"column:post_id;index" json:"post_id"`
+	Post        *Post          `json:"post,omitempty"`
+	SpaceID     uint           `gorm:"column:space_id" json:"space_id"`
+	IsPage      bool           `gorm:"column:is_page" json:"is_page"`
+	Title       string         `gorm:"column:title" json:"title"`
+	Excerpt     string         `gorm:"column:excerpt" json:"excerpt"`
+	Description postgres.Jsonb `gorm:"column:description" json:"description" sql:"jsonb" swaggertype:"primitive,string"`
+	TagIDs      postgres.Jsonb `gorm:"column:tag_ids" json:"tag_ids" swaggertype:"primitive,string"`
+	CategoryIDs postgres.Jsonb `gorm:"column:category_ids" json:"category_ids" swaggertype:"primitive,string"`
+	AuthorIDs   postgres.Jsonb `gorm:"column:author_ids" json:"author_ids" swaggertype:"primitive,string"`
+	ClaimOrder  postgres.Jsonb `gorm:"column:claim_order" json:"claim_order" swaggertype:"primitive,string"`
+}
+
+// BeforeCreate hook
+func (revision *PostRevision) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(postUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	revision.CreatedByID = uint(uID)
+	revision.UpdatedByID = uint(uID)
+	return nil
+}
+
+// BeforeUpdate hook - revisions are never modified once recorded
+func (revision *PostRevision) BeforeUpdate(tx *gorm.DB) error {
+	return errors.New("post revisions cannot be updated")
+}
@@ -34,6 +34,10 @@ type Space struct {
 	FooterCode        string         `gorm:"column:footer_code" json:"footer_code"`
 	MetaFields        postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
 	OrganisationID    int            `gorm:"column:organisation_id" json:"organisation_id"`
+	DefaultLocale     string         `gorm:"column:default_locale" json:"default_locale"`
+	SupportedLocales  postgres.Jsonb `gorm:"column:supported_locales" json:"supported_locales" swaggertype:"primitive,string"`
+	Permalinks        postgres.Jsonb `gorm:"column:permalinks" json:"permalinks" swaggertype:"primitive,string"`
+	Theme             string         `gorm:"column:theme" json:"theme"`
 }
 
 // SpacePermission model
@@ -9,21 +9,23 @@ import (
 // Tag model
 type Tag struct {
 	config.Base
-	Name             string         `gorm:"column:name" json:"name" validate:"required"`
-	Slug             string         `gorm:"column:slug" json:"slug" validate:"required"`
-	BackgroundColour postgres.Jsonb `json:"background_colour" validate:"required" swaggertype:"primitive,string"`
-	Description      postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
-	HTMLDescription  string         `gorm:"column:html_description" json:"html_description,omitempty"`
-	IsFeatured       bool           `gorm:"column:is_featured" json:"is_featured"`
-	MetaFields       postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
-	MediumID         *uint          `gorm:"column:medium_id;default:NULL" json:"medium_id"`
-	Medium           *Medium        `json:"medium"`
-	SpaceID          uint           `gorm:"column:space_id" json:"space_id"`
-	Space            *Space         `json:"space,omitempty"`
-	Posts            []*Post        `gorm:"many2many:post_tags;" json:"posts"`
-	Meta             postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
-	HeaderCode       string         `gorm:"column:header_code" json:"header_code"`
-	FooterCode       string         `gorm:"column:footer_code" json:"footer_code"`
+	Name               string         `gorm:"column:name" json:"name" validate:"required"`
+	Slug               string         `gorm:"column:slug" json:"slug" validate:"required"`
+	BackgroundColour   postgres.Jsonb `json:"background_colour" validate:"required" swaggertype:"primitive,string"`
+	Description        postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
+	HTMLDescription    string         `gorm:"column:html_description" json:"html_description,omitempty"`
+	IsFeatured         bool           `gorm:"column:is_featured" json:"is_featured"`
+	MetaFields         postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
+	MediumID           *uint          `gorm:"column:medium_id;default:NULL" json:"medium_id"`
+	Medium             *Medium        `json:"medium"`
+	SpaceID            uint           `gorm:"column:space_id" json:"space_id"`
+	Space              *Space         `json:"space,omitempty"`
+	Posts              []*Post        `gorm:"many2many:post_tags;" json:"posts"`
+	Meta               postgres.Jsonb `gorm:"column:meta" json:"meta" swaggertype:"primitive,string"`
+	HeaderCode         string         `gorm:"column:header_code" json:"header_code"`
+	FooterCode         string         `gorm:"column:footer_code" json:"footer_code"`
+	Locale             string         `gorm:"column:locale" json:"locale"`
+	TranslationGroupID *uint          `gorm:"column:translation_group_id;default:NULL" json:"translation_group_id"`
 }
 
 var tagUser config.ContextKey = "tag_user"
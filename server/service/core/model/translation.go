@@ -0,0 +1,10 @@
+package model
+
+import "github.com/factly/dega-server/config"
+
+// TranslationGroup model, ties the variants of a post, claim, category or
+// tag in different locales together
+type TranslationGroup struct {
+	config.Base
+	SpaceID uint `gorm:"column:space_id" json:"space_id"`
+}
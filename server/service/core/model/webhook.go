@@ -5,30 +5,35 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
 )
 
 // Webhook webhook model
 type Webhook struct {
 	config.Base
-	Name    string            `json:"name"`
-	URL     string            `json:"url"`
-	Enabled bool              `json:"enabled"`
-	Events  []Event           `json:"events"`
-	Tags    map[string]string `json:"tags"`
+	Name    string         `gorm:"column:name" json:"name"`
+	URL     string         `gorm:"column:url" json:"url"`
+	Enabled bool           `gorm:"column:enabled" json:"enabled"`
+	Secret  string         `gorm:"column:secret" json:"-"`
+	Events  []Event        `gorm:"many2many:webhook_events;" json:"events"`
+	Tags    postgres.Jsonb `gorm:"column:tags" json:"tags" swaggertype:"primitive,string"`
+	SpaceID uint           `gorm:"column:space_id" json:"space_id"`
+	Space   *Space         `json:"space,omitempty"`
 }
 
 // Event event model
 type Event struct {
 	config.Base
-	Name  string            `json:"name"`
-	Event string            `json:"event"`
-	Tags  map[string]string `json:"tags"`
+	Name  string         `gorm:"column:name" json:"name"`
+	Event string         `gorm:"column:event;uniqueIndex" json:"event"`
+	Tags  postgres.Jsonb `gorm:"column:tags" json:"tags" swaggertype:"primitive,string"`
 }
 
 // WebhookLog model
 type WebhookLog struct {
 	ID                 uint           `gorm:"primary_key" json:"id"`
 	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
 	CreatedByID        uint           `gorm:"column:created_by_id" json:"created_by_id"`
 	Event              string         `gorm:"column:event" json:"event"`
 	URL                string         `gorm:"column:url" json:"url"`
@@ -36,4 +41,44 @@ type WebhookLog struct {
 	Data               postgres.Jsonb `gorm:"column:data" json:"data" swaggertype:"primitive,string"`
 	ResponseBody       postgres.Jsonb `gorm:"column:response_body" json:"response_body" swaggertype:"primitive,string"`
 	Tags               postgres.Jsonb `gorm:"column:tags" json:"tags" swaggertype:"primitive,string"`
+	WebhookID          uint           `gorm:"column:webhook_id;index" json:"webhook_id"`
+	Status             string         `gorm:"column:status;index" json:"status"`
+	Attempts           int            `gorm:"column:attempts" json:"attempts"`
+	NextAttemptAt      *time.Time     `gorm:"column:next_attempt_at" json:"next_attempt_at"`
+	LastError          string         `gorm:"column:last_error" json:"last_error"`
+	SpaceID            uint           `gorm:"column:space_id" json:"space_id"`
+}
+
+var webhookUser config.ContextKey = "webhook_user"
+
+// BeforeCreate hook
+func (webhook *Webhook) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(webhookUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	webhook.CreatedByID = uint(uID)
+	webhook.UpdatedByID = uint(uID)
+	return nil
+}
+
+var eventUser config.ContextKey = "event_user"
+
+// BeforeCreate hook
+func (event *Event) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(eventUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	event.CreatedByID = uint(uID)
+	event.UpdatedByID = uint(uID)
+	return nil
 }
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/factly/dega-server/config"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// WorkflowStage model - a review stage in the editorial workflow of a space
+type WorkflowStage struct {
+	config.Base
+	Name        string         `gorm:"column:name" json:"name"`
+	Slug        string         `gorm:"column:slug" json:"slug"`
+	Position    int            `gorm:"column:position" json:"position"`
+	PostStatus  string         `gorm:"column:post_status" json:"post_status"`
+	Transitions postgres.Jsonb `gorm:"column:transitions" json:"transitions" swaggertype:"primitive,string"`
+	SpaceID     uint           `gorm:"column:space_id" json:"space_id"`
+	Space       *Space         `json:"space,omitempty"`
+}
+
+// PostReviewer model
+type PostReviewer struct {
+	config.Base
+	PostID     uint `gorm:"column:post_id" json:"post_id"`
+	ReviewerID uint `gorm:"column:reviewer_id" json:"reviewer_id"`
+}
+
+// PostTransition model - audit trail of the workflow stages a post moved through
+type PostTransition struct {
+	config.Base
+	PostID      uint           `gorm:"column:post_id;index" json:"post_id"`
+	FromStageID *uint          `gorm:"column:from_stage_id;default:NULL" json:"from_stage_id"`
+	FromStage   *WorkflowStage `gorm:"foreignKey:from_stage_id" json:"from_stage"`
+	ToStageID   uint           `gorm:"column:to_stage_id" json:"to_stage_id"`
+	ToStage     *WorkflowStage `gorm:"foreignKey:to_stage_id" json:"to_stage"`
+	Note        string         `gorm:"column:note" json:"note"`
+	SpaceID     uint           `gorm:"column:space_id" json:"space_id"`
+}
+
+var workflowUser config.ContextKey = "workflow_user"
+
+// BeforeCreate hook
+func (stage *WorkflowStage) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(workflowUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	stage.CreatedByID = uint(uID)
+	stage.UpdatedByID = uint(uID)
+	return nil
+}
+
+// BeforeCreate hook
+func (reviewer *PostReviewer) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(workflowUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	reviewer.CreatedByID = uint(uID)
+	reviewer.UpdatedByID = uint(uID)
+	return nil
+}
+
+// BeforeCreate hook
+func (transition *PostTransition) BeforeCreate(tx *gorm.DB) error {
+	ctx := tx.Statement.Context
+	userID := ctx.Value(workflowUser)
+
+	if userID == nil {
+		return nil
+	}
+	uID := userID.(int)
+
+	transition.CreatedByID = uint(uID)
+	transition.UpdatedByID = uint(uID)
+	return nil
+}
@@ -7,10 +7,15 @@ import (
 	"github.com/factly/dega-server/service/core/action/event"
 	"github.com/factly/dega-server/service/core/action/info"
 	"github.com/factly/dega-server/service/core/action/menu"
+	"github.com/factly/dega-server/service/core/action/outbox"
 	"github.com/factly/dega-server/service/core/action/page"
 	"github.com/factly/dega-server/service/core/action/permissions"
+	"github.com/factly/dega-server/service/core/action/persistedquery"
 	"github.com/factly/dega-server/service/core/action/request"
+	"github.com/factly/dega-server/service/core/action/trash"
 	"github.com/factly/dega-server/service/core/action/webhook"
+	"github.com/factly/dega-server/service/core/action/wordpress"
+	"github.com/factly/dega-server/service/core/action/workflow"
 	"github.com/factly/dega-server/util"
 
 	"github.com/go-chi/chi"
@@ -45,12 +50,22 @@ func Router() http.Handler {
 	r.Mount("/permissions", permissions.Router())
 	r.Mount("/requests", request.Router())
 	r.Mount("/info", info.Router())
+	r.Mount("/workflows", workflow.Router())
+	r.Mount("/import/wordpress", wordpress.Router())
+	r.Mount("/persisted-queries", persistedquery.Router())
+	r.Mount("/trash", trash.Router())
 	if config.SearchEnabled() {
 		r.Mount("/search", search.Router())
 	}
-	if util.CheckNats() {
+	if util.CheckWebhooks() {
 		r.Mount("/webhooks", webhook.Router())
 		r.Mount("/events", event.Router())
+	} else if util.CheckHukz() {
+		r.Mount("/webhooks", webhook.HukzRouter())
+		r.Mount("/events", event.HukzRouter())
+	}
+	if util.CheckNats() {
+		r.Mount("/outbox", outbox.Router())
 	}
 
 	return r
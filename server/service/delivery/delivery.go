@@ -0,0 +1,340 @@
+package delivery
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/loggerx"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Delivery statuses of a webhook log
+const (
+	StatusPending   = "pending"
+	StatusRetrying  = "retrying"
+	StatusDelivered = "delivered"
+	StatusDead      = "dead"
+)
+
+// SignatureHeader carries the HMAC-SHA256 signature of the request body
+const SignatureHeader = "X-Dega-Signature"
+
+// maximum number of response bytes stored in a webhook log
+const maxResponseBody = 64 * 1024
+
+var client = &http.Client{Timeout: 10 * time.Second}
+
+var running sync.Mutex
+
+// enqueued wakes the worker up when deliveries are enqueued, a signal already
+// waiting covers the ones enqueued after it
+var enqueued = make(chan struct{}, 1)
+
+// Start subscribes to all events published on NATS and enqueues them for the
+// webhooks registered for them. The deliveries are made by the worker loop of
+// Start, which runs when events are enqueued and at a fixed interval for the
+// retries. It blocks, so it is meant to be run in its own goroutine.
+func Start() {
+	sub, err := util.NC.Conn.QueueSubscribe(">", "dega-webhooks", func(m *nats.Msg) {
+		if err := Enqueue(m.Subject, m.Data); err != nil {
+			loggerx.Error(err)
+			return
+		}
+
+		wake()
+	})
+	if err != nil {
+		loggerx.Error(err)
+		return
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+
+	ticker := time.NewTicker(config.Interval("webhook_poll_interval", 10*time.Second))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-enqueued:
+		}
+		Run()
+	}
+}
+
+// Enqueue creates a pending webhook log for every enabled webhook in the
+// event's space which is subscribed to the event
+func Enqueue(event string, data []byte) error {
+	sID := spaceID(event, data)
+	if sID == 0 {
+		return nil
+	}
+
+	webhooks := make([]model.Webhook, 0)
+	err := config.DB.Model(&model.Webhook{}).
+		Joins("INNER JOIN webhook_events ON webhook_events.webhook_id = webhooks.id").
+		Joins("INNER JOIN events ON events.id = webhook_events.event_id AND events.deleted_at IS NULL").
+		Where("webhooks.enabled = ? AND webhooks.space_id = ? AND events.event = ?", true, sID, event).
+		Find(&webhooks).Error
+	if err != nil {
+		return err
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	logs := make([]model.WebhookLog, 0)
+	for _, webhook := range webhooks {
+		logs = append(logs, model.WebhookLog{
+			Event:         event,
+			URL:           webhook.URL,
+			Data:          postgres.Jsonb{RawMessage: data},
+			Tags:          webhook.Tags,
+			WebhookID:     webhook.ID,
+			Status:        StatusPending,
+			NextAttemptAt: &now,
+			SpaceID:       webhook.SpaceID,
+		})
+	}
+
+	return config.DB.Model(&model.WebhookLog{}).Create(&logs).Error
+}
+
+// Run attempts every delivery which is due. The logs are locked while they
+// are attempted, so a log is attempted by one worker only.
+func Run() {
+	running.Lock()
+	defer running.Unlock()
+
+	tx := config.DB.Begin()
+
+	logs := make([]model.WebhookLog, 0)
+	err := tx.Model(&model.WebhookLog{}).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status IN (?) AND next_attempt_at <= ?", []string{StatusPending, StatusRetrying}, time.Now()).
+		Order("id asc").Limit(100).Find(&logs).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		return
+	}
+
+	for i := range logs {
+		if err = deliver(tx, &logs[i]); err != nil {
+			loggerx.Error(err)
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+	}
+}
+
+// Deliver makes one attempt at posting the webhook log to its webhook and
+// records the outcome. Failed attempts are retried with exponential backoff
+// until the maximum number of attempts, after which the log is dead-lettered.
+func Deliver(webhookLog *model.WebhookLog) error {
+	return deliver(config.DB, webhookLog)
+}
+
+func deliver(db *gorm.DB, webhookLog *model.WebhookLog) error {
+	webhook := model.Webhook{}
+	webhook.ID = webhookLog.WebhookID
+	if err := db.Model(&model.Webhook{}).First(&webhook).Error; err != nil {
+		return db.Model(webhookLog).Updates(map[string]interface{}{
+			"status":     StatusDead,
+			"last_error": "webhook not found",
+		}).Error
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"event":       webhookLog.Event,
+		"delivery_id": webhookLog.ID,
+		"created_at":  webhookLog.CreatedAt,
+		"data":        json.RawMessage(webhookLog.Data.RawMessage),
+	})
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"attempts": webhookLog.Attempts + 1,
+	}
+
+	statusCode, respBody, err := post(webhook, webhookLog, body)
+	updates["response_status_code"] = statusCode
+	updates["response_body"] = respBody
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		updates["status"] = StatusDelivered
+		updates["last_error"] = ""
+		updates["next_attempt_at"] = nil
+	} else {
+		if err == nil {
+			err = fmt.Errorf("webhook responded with status %d", statusCode)
+		}
+		updates["last_error"] = err.Error()
+		if webhookLog.Attempts+1 >= maxAttempts() {
+			updates["status"] = StatusDead
+			updates["next_attempt_at"] = nil
+		} else {
+			next := time.Now().Add(Backoff(webhookLog.Attempts + 1))
+			updates["status"] = StatusRetrying
+			updates["next_attempt_at"] = next
+		}
+	}
+
+	return db.Model(webhookLog).Updates(updates).Error
+}
+
+// Redeliver queues a fresh copy of the webhook log, which is attempted by the
+// worker like any other delivery
+func Redeliver(webhookLog model.WebhookLog, uID int) (*model.WebhookLog, error) {
+	now := time.Now()
+	result := &model.WebhookLog{
+		CreatedByID:   uint(uID),
+		Event:         webhookLog.Event,
+		URL:           webhookLog.URL,
+		Data:          webhookLog.Data,
+		Tags:          webhookLog.Tags,
+		WebhookID:     webhookLog.WebhookID,
+		Status:        StatusPending,
+		NextAttemptAt: &now,
+		SpaceID:       webhookLog.SpaceID,
+	}
+
+	if err := config.DB.Model(&model.WebhookLog{}).Create(result).Error; err != nil {
+		return nil, err
+	}
+
+	wake()
+
+	return result, nil
+}
+
+// wake signals the worker that deliveries were enqueued
+func wake() {
+	select {
+	case enqueued <- struct{}{}:
+	default:
+	}
+}
+
+// Sign returns the signature sent in the X-Dega-Signature header
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random signing secret for a webhook
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Backoff returns the wait before the next attempt, doubling after every
+// failed attempt up to an hour
+func Backoff(attempts int) time.Duration {
+	wait := config.Interval("webhook_retry_interval", 30*time.Second)
+	for i := 1; i < attempts && wait < time.Hour; i++ {
+		wait *= 2
+	}
+	if wait > time.Hour {
+		wait = time.Hour
+	}
+	return wait
+}
+
+func maxAttempts() int {
+	if viper.IsSet("webhook_max_attempts") {
+		return viper.GetInt("webhook_max_attempts")
+	}
+	return 5
+}
+
+func post(webhook model.Webhook, webhookLog *model.WebhookLog, body []byte) (int, postgres.Jsonb, error) {
+	req, err := http.NewRequest("POST", webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, postgres.Jsonb{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Dega-Event", webhookLog.Event)
+	req.Header.Set("X-Dega-Delivery", fmt.Sprint(webhookLog.ID))
+	if webhook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, postgres.Jsonb{}, err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return resp.StatusCode, postgres.Jsonb{}, err
+	}
+
+	return resp.StatusCode, toJsonb(respBytes), nil
+}
+
+// toJsonb keeps JSON responses as they are and stores anything else as a string
+func toJsonb(b []byte) postgres.Jsonb {
+	if len(b) == 0 {
+		return postgres.Jsonb{}
+	}
+	if json.Valid(b) {
+		return postgres.Jsonb{RawMessage: b}
+	}
+	str, _ := json.Marshal(string(b))
+	return postgres.Jsonb{RawMessage: str}
+}
+
+// spaceID finds the space an event belongs to from its payload
+func spaceID(event string, data []byte) uint {
+	payload := struct {
+		ID      uint `json:"id"`
+		SpaceID uint `json:"space_id"`
+		Nodes   []struct {
+			SpaceID uint `json:"space_id"`
+		} `json:"nodes"`
+	}{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return 0
+	}
+	if payload.SpaceID != 0 {
+		return payload.SpaceID
+	}
+	// events for many entities, like media.created, publish them as nodes
+	if len(payload.Nodes) > 0 {
+		return payload.Nodes[0].SpaceID
+	}
+	if strings.HasPrefix(event, "space.") {
+		return payload.ID
+	}
+	return 0
+}
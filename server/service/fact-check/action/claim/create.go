@@ -8,12 +8,12 @@ import (
 	"reflect"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/fact-check/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -90,7 +90,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(claim.Description.RawMessage) > 0 && !reflect.DeepEqual(claim.Description, test.NilJsonb()) {
+	if len(claim.Description.RawMessage) > 0 && !reflect.DeepEqual(claim.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(claim.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -125,6 +125,18 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	result.Locale, result.TranslationGroupID, err = translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+	}.Resolve(tx, claim.Locale, claim.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	err = tx.Model(&model.Claim{}).Create(&result).Error
 
 	if err != nil {
@@ -162,18 +174,19 @@ func create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("claim.created", result); err != nil {
+		if err = util.Outbox(tx, "claim.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
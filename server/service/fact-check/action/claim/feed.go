@@ -0,0 +1,268 @@
+package claim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/permalink"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/schemax"
+	"github.com/go-chi/chi"
+)
+
+// feed page size, claims are paged by checked_date with the before and
+// before_id query params
+const (
+	defaultFeedLimit = 100
+	maxFeedLimit     = 1000
+)
+
+// DataFeed is the data-commons feed of claim reviews
+type DataFeed struct {
+	Context         string         `json:"@context"`
+	Type            string         `json:"@type"`
+	DateModified    time.Time      `json:"dateModified"`
+	DataFeedElement []DataFeedItem `json:"dataFeedElement"`
+}
+
+// DataFeedItem wraps a claim review in a data-commons feed
+type DataFeedItem struct {
+	Context     string                    `json:"@context"`
+	Type        string                    `json:"@type"`
+	DateCreated time.Time                 `json:"dateCreated"`
+	Item        []schemax.FactCheckSchema `json:"item"`
+}
+
+// ClaimReviewFeed - Get ClaimReview feed of published claims
+// @Summary Get ClaimReview feed of published claims
+// @Description Get schema.org ClaimReview JSON-LD of all published claims of the space, newest checked first
+// @Tags Claim
+// @ID get-claimreview-feed
+// @Produce json
+// @Param space_id path string true "Space ID"
+// @Param limit query string false "limit per page"
+// @Param before query string false "checked date (RFC3339) of the last claim of the previous page"
+// @Param before_id query string false "id of the last claim of the previous page"
+// @Success 200 {array} schemax.FactCheckSchema
+// @Router /spaces/{space_id}/claims/feeds/claimreview [get]
+func ClaimReviewFeed(w http.ResponseWriter, r *http.Request) {
+	reviews, ok := feedReviews(w, r)
+	if !ok {
+		return
+	}
+
+	writeFeed(w, reviews)
+}
+
+// DataCommonsFeed - Get data-commons feed of published claims
+// @Summary Get data-commons feed of published claims
+// @Description Get all published claims of the space as a data-commons DataFeed of ClaimReview, newest checked first
+// @Tags Claim
+// @ID get-datacommons-feed
+// @Produce json
+// @Param space_id path string true "Space ID"
+// @Param limit query string false "limit per page"
+// @Param before query string false "checked date (RFC3339) of the last claim of the previous page"
+// @Param before_id query string false "id of the last claim of the previous page"
+// @Success 200 {object} DataFeed
+// @Router /spaces/{space_id}/claims/feeds/datacommons [get]
+func DataCommonsFeed(w http.ResponseWriter, r *http.Request) {
+	reviews, ok := feedReviews(w, r)
+	if !ok {
+		return
+	}
+
+	result := DataFeed{
+		Context:         "http://schema.org",
+		Type:            "DataFeed",
+		DateModified:    time.Now(),
+		DataFeedElement: make([]DataFeedItem, 0),
+	}
+	for _, each := range reviews {
+		result.DataFeedElement = append(result.DataFeedElement, DataFeedItem{
+			Context:     "http://schema.org",
+			Type:        "DataFeedItem",
+			DateCreated: each.DatePublished,
+			Item:        []schemax.FactCheckSchema{each},
+		})
+	}
+
+	writeFeed(w, result)
+}
+
+// feedReviews fetches a page of published claims of the space and builds
+// their reviews. It sets the Link header to the next page and renders the
+// error itself when it returns false.
+func feedReviews(w http.ResponseWriter, r *http.Request) ([]schemax.FactCheckSchema, bool) {
+	spaceID := chi.URLParam(r, "space_id")
+	sID, err := strconv.Atoi(spaceID)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return nil, false
+	}
+
+	query := r.URL.Query()
+
+	limit := defaultFeedLimit
+	if lim, err := strconv.Atoi(query.Get("limit")); err == nil && lim > 0 {
+		limit = lim
+	}
+	if limit > maxFeedLimit {
+		limit = maxFeedLimit
+	}
+
+	var before *time.Time
+	if query.Get("before") != "" {
+		t, err := time.Parse(time.RFC3339Nano, query.Get("before"))
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("before must be a RFC3339 date", http.StatusBadRequest)))
+			return nil, false
+		}
+		before = &t
+	}
+	beforeID, _ := strconv.Atoi(query.Get("before_id"))
+
+	space := coreModel.Space{}
+	space.ID = uint(sID)
+	if err := config.DB.Preload("Logo").First(&space).Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return nil, false
+	}
+
+	tx := config.DB.Model(&model.Claim{}).Preload("Rating").Preload("Claimant").
+		Where("claims.space_id = ? AND claims.checked_date IS NOT NULL", sID).
+		Where("EXISTS (SELECT 1 FROM post_claims JOIN posts ON posts.id = post_claims.post_id WHERE post_claims.claim_id = claims.id AND post_claims.deleted_at IS NULL AND posts.status = ? AND posts.deleted_at IS NULL)", "publish")
+	tx = tx.Scopes(translation.InLocale("claims.locale", translation.GetFeedLocale(r.Context()), space))
+
+	if before != nil {
+		if beforeID > 0 {
+			tx = tx.Where("(claims.checked_date < ? OR (claims.checked_date = ? AND claims.id < ?))", before, before, beforeID)
+		} else {
+			tx = tx.Where("claims.checked_date < ?", before)
+		}
+	}
+
+	claims := make([]model.Claim, 0)
+	err = tx.Order("claims.checked_date desc").Order("claims.id desc").Limit(limit).Find(&claims).Error
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return nil, false
+	}
+
+	posts, err := publishedPosts(claims)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return nil, false
+	}
+
+	ratings := make([]model.Rating, 0)
+	config.DB.Model(&model.Rating{}).Where(model.Rating{
+		SpaceID: uint(sID),
+	}).Order("numeric_value asc").Find(&ratings)
+
+	urls := permalink.New(space)
+	reviews := make([]schemax.FactCheckSchema, 0)
+	for _, claim := range claims {
+		post, found := posts[claim.ID]
+		if !found {
+			continue
+		}
+		schemas := schemax.GetFactCheckSchema(schemax.PostData{
+			Post:   post,
+			Claims: []model.Claim{claim},
+		}, space, ratings)
+		if post.PublishedDate != nil {
+			schemas[0].DatePublished = *post.PublishedDate
+		}
+		schemas[0].URL = urls.Post(post)
+		reviews = append(reviews, schemas[0])
+	}
+
+	if len(claims) == limit {
+		last := claims[len(claims)-1]
+		next := url.Values{}
+		next.Set("limit", fmt.Sprint(limit))
+		next.Set("before", last.CheckedDate.Format(time.RFC3339Nano))
+		next.Set("before_id", fmt.Sprint(last.ID))
+		w.Header().Set("Link", fmt.Sprintf("<%s?%s>; rel=\"next\"", r.URL.Path, next.Encode()))
+	}
+
+	return reviews, true
+}
+
+// publishedPosts maps the claims to the earliest published post they appear in
+func publishedPosts(claims []model.Claim) (map[uint]coreModel.Post, error) {
+	result := make(map[uint]coreModel.Post)
+	if len(claims) == 0 {
+		return result, nil
+	}
+
+	claimIDs := make([]uint, 0)
+	for _, each := range claims {
+		claimIDs = append(claimIDs, each.ID)
+	}
+
+	postClaims := make([]model.PostClaim, 0)
+	err := config.DB.Model(&model.PostClaim{}).Where("claim_id IN (?)", claimIDs).Find(&postClaims).Error
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]uint, 0)
+	for _, each := range postClaims {
+		postIDs = append(postIDs, each.PostID)
+	}
+
+	posts := make([]coreModel.Post, 0)
+	err = config.DB.Model(&coreModel.Post{}).Where("id IN (?) AND status = ?", postIDs, "publish").Preload("Format").Order("published_date asc").Order("id asc").Find(&posts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	postMap := make(map[uint]coreModel.Post)
+	for _, each := range posts {
+		postMap[each.ID] = each
+	}
+
+	for _, pc := range postClaims {
+		post, found := postMap[pc.PostID]
+		if !found {
+			continue
+		}
+		if current, found := result[pc.ClaimID]; found && !earlier(post, current) {
+			continue
+		}
+		result[pc.ClaimID] = post
+	}
+
+	return result, nil
+}
+
+func earlier(a, b coreModel.Post) bool {
+	if a.PublishedDate == nil || b.PublishedDate == nil {
+		return a.PublishedDate != nil
+	}
+	return a.PublishedDate.Before(*b.PublishedDate)
+}
+
+func writeFeed(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/ld+json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		loggerx.Error(err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/meilisearchx"
@@ -72,18 +73,12 @@ func list(w http.ResponseWriter, r *http.Request) {
 		if config.SearchEnabled() {
 			// search claims with filter
 			var hits []interface{}
-			var res map[string]interface{}
-			if filters != "" {
-				filters = fmt.Sprint(filters, " AND space_id=", sID)
-			}
-			if searchQuery != "" {
-				hits, err = meilisearchx.SearchWithQuery("dega", searchQuery, filters, "claim")
-			} else {
-				res, err = meilisearchx.SearchWithoutQuery("dega", filters, "claim")
-				if _, found := res["hits"]; found {
-					hits = res["hits"].([]interface{})
-				}
-			}
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Filters: filters,
+				Kinds:   []string{"claim"},
+			})
 			if err != nil {
 				loggerx.Error(err)
 				errorx.Render(w, errorx.Parser(errorx.NetworkError()))
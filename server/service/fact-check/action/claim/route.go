@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/comment"
 
 	"github.com/factly/dega-server/util"
 	"github.com/go-chi/chi"
@@ -26,6 +27,8 @@ type claim struct {
 	Meta          postgres.Jsonb `json:"meta" swaggertype:"primitive,string"`
 	HeaderCode    string         `json:"header_code"`
 	FooterCode    string         `json:"footer_code"`
+	Locale        string         `json:"locale"`
+	TranslationOf uint           `json:"translation_of"`
 }
 
 var userContext config.ContextKey = "claim_user"
@@ -43,6 +46,7 @@ func Router() chi.Router {
 		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
 		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
 		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+		r.Mount("/comments", comment.Router(entity))
 	})
 
 	return r
@@ -8,12 +8,12 @@ import (
 	"strconv"
 
 	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/translation"
 	"github.com/factly/dega-server/service/fact-check/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -117,7 +117,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(claim.Description.RawMessage) > 0 && !reflect.DeepEqual(claim.Description, test.NilJsonb()) {
+	if len(claim.Description.RawMessage) > 0 && !reflect.DeepEqual(claim.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(claim.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -128,6 +128,20 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.Begin()
 
+	locale, translationGroupID, err := translation.Entity{
+		Table:   tableName,
+		SpaceID: uint(sID),
+		ID:      result.ID,
+		Locale:  result.Locale,
+		GroupID: result.TranslationGroupID,
+	}.Resolve(tx, claim.Locale, claim.TranslationOf)
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(translation.Message(err)))
+		return
+	}
+
 	mediumID := &claim.MediumID
 	result.MediumID = &claim.MediumID
 	if claim.MediumID == 0 {
@@ -146,21 +160,23 @@ func update(w http.ResponseWriter, r *http.Request) {
 		CheckedDate: claim.CheckedDate,
 	})
 	err = tx.Model(&result).Updates(model.Claim{
-		Base:            config.Base{UpdatedByID: uint(uID)},
-		Claim:           claim.Claim,
-		Slug:            claimSlug,
-		ClaimSources:    claim.ClaimSources,
-		Description:     claim.Description,
-		HTMLDescription: description,
-		ClaimantID:      claim.ClaimantID,
-		RatingID:        claim.RatingID,
-		Fact:            claim.Fact,
-		ReviewSources:   claim.ReviewSources,
-		MetaFields:      claim.MetaFields,
-		Meta:            claim.Meta,
-		HeaderCode:      claim.HeaderCode,
-		FooterCode:      claim.FooterCode,
-		MediumID:        mediumID,
+		Base:               config.Base{UpdatedByID: uint(uID)},
+		Claim:              claim.Claim,
+		Slug:               claimSlug,
+		ClaimSources:       claim.ClaimSources,
+		Description:        claim.Description,
+		HTMLDescription:    description,
+		ClaimantID:         claim.ClaimantID,
+		RatingID:           claim.RatingID,
+		Fact:               claim.Fact,
+		ReviewSources:      claim.ReviewSources,
+		MetaFields:         claim.MetaFields,
+		Meta:               claim.Meta,
+		HeaderCode:         claim.HeaderCode,
+		FooterCode:         claim.FooterCode,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
+		MediumID:           mediumID,
 	}).Preload("Rating").Preload("Rating.Medium").Preload("Claimant").Preload("Claimant.Medium").Preload("Medium").First(&result).Error
 
 	if err != nil {
@@ -196,18 +212,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("claim.updated", result); err != nil {
+		if err = util.Outbox(tx, "claim.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusOK, result)
 }
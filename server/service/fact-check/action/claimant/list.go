@@ -1,12 +1,12 @@
 package claimant
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/meilisearchx"
@@ -71,10 +71,12 @@ func list(w http.ResponseWriter, r *http.Request) {
 	} else if searchQuery != "" {
 
 		if config.SearchEnabled() {
-			filters := fmt.Sprint("space_id=", sID)
 			var hits []interface{}
-
-			hits, err = meilisearchx.SearchWithQuery("dega", searchQuery, filters, "claimant")
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Kinds:   []string{"claimant"},
+			})
 			if err != nil {
 				loggerx.Error(err)
 				errorx.Render(w, errorx.Parser(errorx.NetworkError()))
@@ -9,11 +9,10 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/fact-check/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -107,7 +106,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(rating.Description.RawMessage) > 0 && !reflect.DeepEqual(rating.Description, test.NilJsonb()) {
+	if len(rating.Description.RawMessage) > 0 && !reflect.DeepEqual(rating.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(rating.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -148,16 +147,17 @@ func create(w http.ResponseWriter, r *http.Request) {
 		_ = insertIntoMeili(*result)
 	}
 
-	tx.Commit()
-
 	if util.CheckNats() {
-		if err = util.NC.Publish("rating.created", result); err != nil {
+		if err = util.Outbox(tx, "rating.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
 
+	tx.Commit()
+
 	renderx.JSON(w, http.StatusCreated, result)
 }
 
@@ -174,5 +174,5 @@ func insertIntoMeili(rating model.Rating) error {
 		"space_id":          rating.SpaceID,
 	}
 
-	return meilisearchx.AddDocument("dega", meiliObj)
+	return search.AddDocument(meiliObj)
 }
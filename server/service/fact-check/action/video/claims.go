@@ -0,0 +1,63 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/factly/dega-server/service/fact-check/model"
+	"gorm.io/gorm"
+)
+
+// checkClaims validates that the claim time ranges lie within the video
+func checkClaims(video *video) error {
+	for _, each := range video.Claims {
+		if each.StartTime >= each.EndTime {
+			return fmt.Errorf("claim %d must start before it ends", each.ClaimID)
+		}
+
+		if video.TotalDuration > 0 && each.EndTime > video.TotalDuration {
+			return fmt.Errorf("claim %d ends after the video", each.ClaimID)
+		}
+	}
+
+	return nil
+}
+
+// attachClaims links the claims of the space to the video at their time ranges
+func attachClaims(tx *gorm.DB, videoID, spaceID uint, claims []videoClaim) error {
+	for _, each := range claims {
+		res := tx.Model(&model.Claim{}).Where(&model.Claim{
+			SpaceID: spaceID,
+		}).Where("id = ?", each.ClaimID).Updates(map[string]interface{}{
+			"video_id":   videoID,
+			"start_time": each.StartTime,
+			"end_time":   each.EndTime,
+		})
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return errors.New("claim not found")
+		}
+	}
+
+	return nil
+}
+
+// detachClaims unlinks all the claims of the video
+func detachClaims(tx *gorm.DB, videoID uint) error {
+	return tx.Model(&model.Claim{}).Where("video_id = ?", videoID).Updates(map[string]interface{}{
+		"video_id":   nil,
+		"start_time": 0,
+		"end_time":   0,
+	}).Error
+}
+
+// videoClaims fetches the claims of the video ordered by their start time
+func videoClaims(tx *gorm.DB, videoID uint) []model.Claim {
+	claims := make([]model.Claim, 0)
+	tx.Model(&model.Claim{}).Where("video_id = ?", videoID).Preload("Rating").Preload("Rating.Medium").Preload("Claimant").Preload("Claimant.Medium").Order("start_time asc").Find(&claims)
+	return claims
+}
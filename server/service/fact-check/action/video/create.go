@@ -0,0 +1,254 @@
+package video
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/factly/x/validationx"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// create - Create video
+// @Summary Create video
+// @Description Create video
+// @Tags Video
+// @ID add-video
+// @Consume json
+// @Produce json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param Video body video true "Video Object"
+// @Success 201 {object} videoData
+// @Failure 400 {array} string
+// @Router /fact-check/videos [post]
+func create(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	video := &video{}
+
+	err = json.NewDecoder(r.Body).Decode(&video)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(video)
+
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	if err = checkClaims(video); err != nil {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	if video.Status == "publish" {
+		if err = checkPublishPermission(r.Context(), sID, uID); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
+	if viper.GetBool("create_super_organisation") {
+		// Fetch space permissions
+		permission := coreModel.SpacePermission{}
+		err = config.DB.Model(&coreModel.SpacePermission{}).Where(&coreModel.SpacePermission{
+			SpaceID: uint(sID),
+		}).First(&permission).Error
+
+		if err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more videos", http.StatusUnprocessableEntity)))
+			return
+		}
+
+		// Fetch total number of videos in space
+		var totVideos int64
+		config.DB.Model(&model.Video{}).Where(&model.Video{
+			SpaceID: uint(sID),
+		}).Count(&totVideos)
+
+		if totVideos >= permission.Videos && permission.Videos > 0 {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage("cannot create more videos", http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
+	var videoSlug string
+	if video.Slug != "" && slugx.Check(video.Slug) {
+		videoSlug = video.Slug
+	} else {
+		videoSlug = slugx.Make(video.Title)
+	}
+
+	// Get table name
+	stmt := &gorm.Statement{DB: config.DB}
+	_ = stmt.Parse(&model.Video{})
+	tableName := stmt.Schema.Table
+
+	status := video.Status
+	if status == "" {
+		status = "draft"
+	}
+
+	result := &videoData{}
+	result.Authors = make([]coreModel.Author, 0)
+	result.Video = model.Video{
+		URL:           video.URL,
+		Title:         video.Title,
+		Slug:          slugx.Approve(&config.DB, videoSlug, sID, tableName),
+		Summary:       video.Summary,
+		VideoType:     video.VideoType,
+		Status:        status,
+		TotalDuration: video.TotalDuration,
+		ThumbnailURL:  video.ThumbnailURL,
+		PublishedDate: video.PublishedDate,
+		SpaceID:       uint(sID),
+	}
+
+	if status == "publish" && result.PublishedDate == nil {
+		currTime := time.Now()
+		result.PublishedDate = &currTime
+	}
+
+	if len(video.TagIDs) > 0 {
+		config.DB.Model(&coreModel.Tag{}).Where(video.TagIDs).Find(&result.Tags)
+	}
+	if len(video.CategoryIDs) > 0 {
+		config.DB.Model(&coreModel.Category{}).Where(video.CategoryIDs).Find(&result.Categories)
+	}
+
+	tx := config.DB.WithContext(context.WithValue(r.Context(), userContext, uID)).Begin()
+
+	err = tx.Model(&model.Video{}).Create(&result.Video).Error
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if len(video.AuthorIDs) > 0 {
+		authorMap, err := author.All(r.Context())
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		videoAuthors := make([]model.VideoAuthor, 0)
+		for _, each := range video.AuthorIDs {
+			if _, found := authorMap[fmt.Sprint(each)]; found {
+				videoAuthors = append(videoAuthors, model.VideoAuthor{
+					VideoID:  result.ID,
+					AuthorID: each,
+				})
+				result.Authors = append(result.Authors, authorMap[fmt.Sprint(each)])
+			}
+		}
+
+		if len(videoAuthors) > 0 {
+			if err = tx.Model(&model.VideoAuthor{}).Create(&videoAuthors).Error; err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+	}
+
+	if err = attachClaims(tx, result.ID, uint(sID), video.Claims); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	tx.Model(&model.Video{}).Preload("Tags").Preload("Categories").First(&result.Video)
+	result.Claims = videoClaims(tx, result.ID)
+
+	// Insert into meili index
+	meiliObj := meiliObject(result, video.TagIDs, video.CategoryIDs, video.AuthorIDs)
+
+	if config.SearchEnabled() {
+		_ = search.AddDocument(meiliObj)
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "video.created", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusCreated, result)
+}
+
+func meiliObject(result *videoData, tagIDs, categoryIDs, authorIDs []uint) map[string]interface{} {
+	var publishedDate int64
+	if result.PublishedDate != nil {
+		publishedDate = result.PublishedDate.Unix()
+	}
+
+	claimIDs := make([]uint, 0)
+	for _, each := range result.Claims {
+		claimIDs = append(claimIDs, each.ID)
+	}
+
+	return map[string]interface{}{
+		"id":             result.ID,
+		"kind":           "video",
+		"title":          result.Title,
+		"slug":           result.Slug,
+		"url":            result.URL,
+		"summary":        result.Summary,
+		"video_type":     result.VideoType,
+		"status":         result.Status,
+		"total_duration": result.TotalDuration,
+		"published_date": publishedDate,
+		"space_id":       result.SpaceID,
+		"tag_ids":        tagIDs,
+		"category_ids":   categoryIDs,
+		"author_ids":     authorIDs,
+		"claim_ids":      claimIDs,
+	}
+}
@@ -0,0 +1,98 @@
+package video
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// delete - Delete video by id
+// @Summary Delete a video
+// @Description Delete video by ID
+// @Tags Video
+// @ID delete-video-by-id
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param video_id path string true "Video ID"
+// @Success 200
+// @Failure 400 {array} string
+// @Router /fact-check/videos/{video_id} [delete]
+func delete(w http.ResponseWriter, r *http.Request) {
+
+	videoID := chi.URLParam(r, "video_id")
+	id, err := strconv.Atoi(videoID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	result := &model.Video{}
+	result.ID = uint(id)
+
+	// check record exists or not
+	err = config.DB.Where(&model.Video{
+		SpaceID: uint(sID),
+	}).First(&result).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	tx := config.DB.Begin()
+
+	if err = detachClaims(tx, result.ID); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	// the video keeps its tags and categories, and its authors are deleted
+	// after it, so that they are restored along with it from the trash
+	tx.Delete(&result)
+
+	tx.Model(&model.VideoAuthor{}).Where(&model.VideoAuthor{
+		VideoID: uint(id),
+	}).Delete(&model.VideoAuthor{})
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "video.deleted", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if config.SearchEnabled() {
+		_ = search.DeleteDocument(result.ID, "video")
+	}
+
+	renderx.JSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,86 @@
+package video
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/go-chi/chi"
+)
+
+// details - Get video by id
+// @Summary Show a video by id
+// @Description Get video by ID
+// @Tags Video
+// @ID get-video-by-id
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param video_id path string true "Video ID"
+// @Success 200 {object} videoData
+// @Router /fact-check/videos/{video_id} [get]
+func details(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	videoID := chi.URLParam(r, "video_id")
+	id, err := strconv.Atoi(videoID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	result := videoData{}
+	result.Video.ID = uint(id)
+	result.Authors = make([]coreModel.Author, 0)
+
+	err = config.DB.Model(&model.Video{}).Preload("Tags").Preload("Categories").Where(&model.Video{
+		SpaceID: uint(sID),
+	}).First(&result.Video).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	result.Claims = videoClaims(config.DB, result.ID)
+
+	// Adding authors in response
+	videoAuthors := make([]model.VideoAuthor, 0)
+	config.DB.Model(&model.VideoAuthor{}).Where(&model.VideoAuthor{
+		VideoID: uint(id),
+	}).Find(&videoAuthors)
+
+	if len(videoAuthors) > 0 {
+		authorMap, err := author.All(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		for _, each := range videoAuthors {
+			if author, found := authorMap[fmt.Sprint(each.AuthorID)]; found {
+				result.Authors = append(result.Authors, author)
+			}
+		}
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
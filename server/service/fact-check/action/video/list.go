@@ -0,0 +1,206 @@
+package video
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/meilisearchx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/paginationx"
+	"github.com/factly/x/renderx"
+)
+
+// list response
+type paging struct {
+	Total int64       `json:"total"`
+	Nodes []videoData `json:"nodes"`
+}
+
+// list - Get all videos
+// @Summary Show all videos
+// @Description Get all videos
+// @Tags Video
+// @ID get-all-videos
+// @Produce  json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param limit query string false "limit per page"
+// @Param page query string false "page number"
+// @Param q query string false "Query"
+// @Param status query string false "Status"
+// @Param sort query string false "Sort"
+// @Success 200 {object} paging
+// @Router /fact-check/videos [get]
+func list(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	searchQuery := r.URL.Query().Get("q")
+	sort := r.URL.Query().Get("sort")
+
+	// Filters
+	u, _ := url.Parse(r.URL.String())
+	queryMap := u.Query()
+
+	result := paging{}
+	result.Nodes = make([]videoData, 0)
+
+	if sort != "asc" {
+		sort = "desc"
+	}
+
+	offset, limit := paginationx.Parse(r.URL.Query())
+
+	tx := config.DB.Model(&model.Video{}).Preload("Tags").Preload("Categories").Where(&model.Video{
+		SpaceID: uint(sID),
+	}).Order("created_at " + sort)
+
+	videos := make([]model.Video, 0)
+	filters := generateFilters(queryMap["status"])
+	if filters != "" || searchQuery != "" {
+
+		if config.SearchEnabled() {
+			var hits []interface{}
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Filters: filters,
+				Kinds:   []string{"video"},
+			})
+			if err != nil {
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.NetworkError()))
+				return
+			}
+
+			filteredVideoIDs := meilisearchx.GetIDArray(hits)
+			if len(filteredVideoIDs) == 0 {
+				renderx.JSON(w, http.StatusOK, result)
+				return
+			} else {
+				err = tx.Where(filteredVideoIDs).Count(&result.Total).Offset(offset).Limit(limit).Find(&videos).Error
+				if err != nil {
+					loggerx.Error(err)
+					errorx.Render(w, errorx.Parser(errorx.DBError()))
+					return
+				}
+			}
+		} else {
+			filters = generateSQLFilters(searchQuery, queryMap["status"])
+			err = tx.Where(filters).Count(&result.Total).Offset(offset).Limit(limit).Find(&videos).Error
+			if err != nil {
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
+	} else {
+		err = tx.Count(&result.Total).Offset(offset).Limit(limit).Find(&videos).Error
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	if len(videos) == 0 {
+		renderx.JSON(w, http.StatusOK, result)
+		return
+	}
+
+	videoIDs := make([]uint, 0)
+	for _, each := range videos {
+		videoIDs = append(videoIDs, each.ID)
+	}
+
+	// fetch all claims related to videos
+	claims := make([]model.Claim, 0)
+	config.DB.Model(&model.Claim{}).Where("video_id IN (?)", videoIDs).Preload("Rating").Preload("Rating.Medium").Preload("Claimant").Preload("Claimant.Medium").Order("start_time asc").Find(&claims)
+
+	videoClaimMap := make(map[uint][]model.Claim)
+	for _, each := range claims {
+		videoClaimMap[*each.VideoID] = append(videoClaimMap[*each.VideoID], each)
+	}
+
+	// Adding authors in response
+	authorMap, err := author.All(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	videoAuthors := make([]model.VideoAuthor, 0)
+	config.DB.Model(&model.VideoAuthor{}).Where("video_id IN (?)", videoIDs).Find(&videoAuthors)
+
+	videoAuthorMap := make(map[uint][]coreModel.Author)
+	for _, each := range videoAuthors {
+		if author, found := authorMap[fmt.Sprint(each.AuthorID)]; found {
+			videoAuthorMap[each.VideoID] = append(videoAuthorMap[each.VideoID], author)
+		}
+	}
+
+	for _, each := range videos {
+		data := videoData{}
+		data.Video = each
+		data.Authors = make([]coreModel.Author, 0)
+		data.Claims = make([]model.Claim, 0)
+		data.Authors = append(data.Authors, videoAuthorMap[each.ID]...)
+		data.Claims = append(data.Claims, videoClaimMap[each.ID]...)
+		result.Nodes = append(result.Nodes, data)
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+func generateFilters(status []string) string {
+	filters := ""
+	if len(status) > 0 {
+		filters = fmt.Sprint(filters, meilisearchx.GenerateFieldFilter(status, "status"), " AND ")
+	}
+
+	if filters != "" && filters[len(filters)-5:] == " AND " {
+		filters = filters[:len(filters)-5]
+	}
+
+	return filters
+}
+
+func generateSQLFilters(searchQuery string, status []string) string {
+	filters := ""
+
+	if searchQuery != "" {
+		filters = fmt.Sprint(filters, "title ILIKE '%", strings.ToLower(searchQuery), "%' AND ")
+	}
+
+	if len(status) > 0 {
+		filters = filters + " status IN ("
+		for _, each := range status {
+			// only known statuses are added to the raw sql filter
+			if each == "draft" || each == "ready" || each == "publish" {
+				filters = fmt.Sprint(filters, "'", each, "', ")
+			}
+		}
+		filters = fmt.Sprint("(", strings.Trim(filters, ", "), ")) AND ")
+	}
+
+	if filters != "" && filters[len(filters)-5:] == " AND " {
+		filters = filters[:len(filters)-5]
+	}
+
+	return filters
+}
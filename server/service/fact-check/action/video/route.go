@@ -0,0 +1,62 @@
+package video
+
+import (
+	"time"
+
+	"github.com/factly/dega-server/config"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/go-chi/chi"
+)
+
+// video request body
+type video struct {
+	URL           string       `json:"url" validate:"required,url"`
+	Title         string       `json:"title" validate:"required,max=500"`
+	Slug          string       `json:"slug"`
+	Summary       string       `json:"summary"`
+	VideoType     string       `json:"video_type"`
+	Status        string       `json:"status" validate:"omitempty,oneof=draft ready publish"`
+	TotalDuration int          `json:"total_duration" validate:"gte=0"`
+	ThumbnailURL  string       `json:"thumbnail_url"`
+	PublishedDate *time.Time   `json:"published_date" sql:"DEFAULT:NULL"`
+	TagIDs        []uint       `json:"tag_ids"`
+	CategoryIDs   []uint       `json:"category_ids"`
+	AuthorIDs     []uint       `json:"author_ids"`
+	Claims        []videoClaim `json:"claims" validate:"dive"`
+}
+
+// videoClaim attaches a claim to a time range of the video
+type videoClaim struct {
+	ClaimID   uint `json:"claim_id" validate:"required"`
+	StartTime int  `json:"start_time" validate:"gte=0"`
+	EndTime   int  `json:"end_time" validate:"gte=0"`
+}
+
+type videoData struct {
+	model.Video
+	Authors []coreModel.Author `json:"authors"`
+	Claims  []model.Claim      `json:"claims"`
+}
+
+var userContext config.ContextKey = "video_user"
+
+// Router - Group of video router
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	entity := "videos"
+
+	r.With(util.CheckKetoPolicy(entity, "get")).Get("/", list)
+	r.With(util.CheckKetoPolicy(entity, "create")).Post("/", create)
+
+	r.Route("/{video_id}", func(r chi.Router) {
+		r.With(util.CheckKetoPolicy(entity, "get")).Get("/", details)
+		r.With(util.CheckKetoPolicy(entity, "update")).Put("/", update)
+		r.With(util.CheckKetoPolicy(entity, "delete")).Delete("/", delete)
+	})
+
+	return r
+
+}
@@ -0,0 +1,293 @@
+package video
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/arrays"
+	"github.com/factly/dega-server/util/search"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/slugx"
+	"github.com/factly/x/validationx"
+	"github.com/go-chi/chi"
+	"gorm.io/gorm"
+)
+
+// update - Update video by id
+// @Summary Update a video by id
+// @Description Update video by ID
+// @Tags Video
+// @ID update-video-by-id
+// @Produce json
+// @Consume json
+// @Param X-User header string true "User ID"
+// @Param X-Space header string true "Space ID"
+// @Param video_id path string true "Video ID"
+// @Param Video body video false "Video"
+// @Success 200 {object} videoData
+// @Router /fact-check/videos/{video_id} [put]
+func update(w http.ResponseWriter, r *http.Request) {
+
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	uID, err := middlewarex.GetUser(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	videoID := chi.URLParam(r, "video_id")
+	id, err := strconv.Atoi(videoID)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InvalidID()))
+		return
+	}
+
+	video := &video{}
+	err = json.NewDecoder(r.Body).Decode(&video)
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	validationError := validationx.Check(video)
+
+	if validationError != nil {
+		loggerx.Error(errors.New("validation error"))
+		errorx.Render(w, validationError)
+		return
+	}
+
+	if err = checkClaims(video); err != nil {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	result := &videoData{}
+	result.Video.ID = uint(id)
+	result.Authors = make([]coreModel.Author, 0)
+
+	// check record exists or not
+	err = config.DB.Where(&model.Video{
+		SpaceID: uint(sID),
+	}).First(&result.Video).Error
+
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	var videoSlug string
+
+	// Get table name
+	stmt := &gorm.Statement{DB: config.DB}
+	_ = stmt.Parse(&model.Video{})
+	tableName := stmt.Schema.Table
+
+	if result.Slug == video.Slug {
+		videoSlug = result.Slug
+	} else if video.Slug != "" && slugx.Check(video.Slug) {
+		videoSlug = slugx.Approve(&config.DB, video.Slug, sID, tableName)
+	} else {
+		videoSlug = slugx.Approve(&config.DB, slugx.Make(video.Title), sID, tableName)
+	}
+
+	status := video.Status
+	if status == "" {
+		status = result.Status
+	}
+
+	// publishing and unpublishing a video need the permission to publish
+	if status != result.Status && (status == "publish" || result.Status == "publish") {
+		if err = checkPublishPermission(r.Context(), sID, uID); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
+	publishedDate := video.PublishedDate
+	if status == "publish" && publishedDate == nil {
+		if result.PublishedDate != nil {
+			publishedDate = result.PublishedDate
+		} else {
+			currTime := time.Now()
+			publishedDate = &currTime
+		}
+	}
+
+	tx := config.DB.Begin()
+
+	newTags := make([]coreModel.Tag, 0)
+	if len(video.TagIDs) > 0 {
+		config.DB.Model(&coreModel.Tag{}).Where(video.TagIDs).Find(&newTags)
+	}
+	if err = tx.Model(&result.Video).Association("Tags").Replace(&newTags); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	newCategories := make([]coreModel.Category, 0)
+	if len(video.CategoryIDs) > 0 {
+		config.DB.Model(&coreModel.Category{}).Where(video.CategoryIDs).Find(&newCategories)
+	}
+	if err = tx.Model(&result.Video).Association("Categories").Replace(&newCategories); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	err = tx.Model(&result.Video).Select("URL", "Title", "Slug", "Summary", "VideoType", "Status", "TotalDuration", "ThumbnailURL", "PublishedDate", "UpdatedByID").Updates(model.Video{
+		Base:          config.Base{UpdatedByID: uint(uID)},
+		URL:           video.URL,
+		Title:         video.Title,
+		Slug:          videoSlug,
+		Summary:       video.Summary,
+		VideoType:     video.VideoType,
+		Status:        status,
+		TotalDuration: video.TotalDuration,
+		ThumbnailURL:  video.ThumbnailURL,
+		PublishedDate: publishedDate,
+	}).Error
+
+	if err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	// fetch old authors
+	prevVideoAuthors := make([]model.VideoAuthor, 0)
+	tx.Model(&model.VideoAuthor{}).Where(&model.VideoAuthor{
+		VideoID: result.ID,
+	}).Find(&prevVideoAuthors)
+
+	prevAuthorIDs := make([]uint, 0)
+	for _, each := range prevVideoAuthors {
+		prevAuthorIDs = append(prevAuthorIDs, each.AuthorID)
+	}
+
+	toCreateIDs, toDeleteIDs := arrays.Difference(prevAuthorIDs, video.AuthorIDs)
+
+	if len(toDeleteIDs) > 0 {
+		tx.Model(&model.VideoAuthor{}).Where("video_id = ? AND author_id IN (?)", result.ID, toDeleteIDs).Delete(&model.VideoAuthor{})
+	}
+
+	if len(toCreateIDs) > 0 {
+		createVideoAuthors := make([]model.VideoAuthor, 0)
+		for _, each := range toCreateIDs {
+			createVideoAuthors = append(createVideoAuthors, model.VideoAuthor{
+				VideoID:  result.ID,
+				AuthorID: each,
+			})
+		}
+
+		if err = tx.Model(&model.VideoAuthor{}).Create(&createVideoAuthors).Error; err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	// replace the claims attached to the video
+	if err = detachClaims(tx, result.ID); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if err = attachClaims(tx, result.ID, uint(sID), video.Claims); err != nil {
+		tx.Rollback()
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	tx.Model(&model.Video{}).Preload("Tags").Preload("Categories").First(&result.Video)
+	result.Claims = videoClaims(tx, result.ID)
+
+	// Fetch current authors
+	if len(video.AuthorIDs) > 0 {
+		authorMap, err := author.All(r.Context())
+		if err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		for _, each := range video.AuthorIDs {
+			if author, found := authorMap[fmt.Sprint(each)]; found {
+				result.Authors = append(result.Authors, author)
+			}
+		}
+	}
+
+	// Update into meili index
+	meiliObj := meiliObject(result, video.TagIDs, video.CategoryIDs, video.AuthorIDs)
+
+	if config.SearchEnabled() {
+		_ = search.UpdateDocument(meiliObj)
+	}
+
+	if util.CheckNats() {
+		if err = util.Outbox(tx, "video.updated", result); err != nil {
+			tx.Rollback()
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
+			return
+		}
+	}
+
+	tx.Commit()
+
+	renderx.JSON(w, http.StatusOK, result)
+}
+
+// checkPublishPermission checks that the user can publish the videos of the space
+func checkPublishPermission(ctx context.Context, sID, uID int) error {
+	oID, err := util.GetOrganisation(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := util.CheckPublishPermission("videos", oID, sID, uID)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.New("user does not have permission to publish videos")
+	}
+
+	return nil
+}
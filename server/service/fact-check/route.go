@@ -9,6 +9,7 @@ import (
 	"github.com/factly/dega-server/service/fact-check/action/claimant"
 	"github.com/factly/dega-server/service/fact-check/action/google"
 	"github.com/factly/dega-server/service/fact-check/action/rating"
+	"github.com/factly/dega-server/service/fact-check/action/video"
 )
 
 // Router - CRUD servies
@@ -18,6 +19,7 @@ func Router() http.Handler {
 	r.Mount("/claimants", claimant.Router())
 	r.Mount("/ratings", rating.Router())
 	r.Mount("/claims", claim.Router())
+	r.Mount("/videos", video.Router())
 	r.Mount("/google", google.Router())
 
 	return r
@@ -7,9 +7,9 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/podcast/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -60,24 +60,28 @@ func delete(w http.ResponseWriter, r *http.Request) {
 
 	tx := config.DB.Begin()
 
-	// delete all associations
-	if len(result.Categories) > 0 {
-		_ = tx.Model(&result).Association("Categories").Delete(result.Categories)
-	}
-
+	// the podcast keeps its categories so that it is restored with them from
+	// the trash
 	tx.Model(&model.Podcast{}).Delete(&result)
 
-	if config.SearchEnabled() {
-		_ = meilisearchx.DeleteDocument("dega", result.ID, "podcast")
-	}
-
-	tx.Commit()
 	if util.CheckNats() {
-		if err = util.NC.Publish("podcast.deleted", result); err != nil {
+		if err = util.Outbox(tx, "podcast.deleted", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
+
+	if err = tx.Commit().Error; err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DBError()))
+		return
+	}
+
+	if config.SearchEnabled() {
+		_ = search.DeleteDocument(result.ID, "podcast")
+	}
+
 	renderx.JSON(w, http.StatusOK, nil)
 }
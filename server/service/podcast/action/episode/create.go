@@ -11,12 +11,11 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
 	"github.com/factly/dega-server/service/podcast/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
 
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -71,6 +70,25 @@ func create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if episode.Status == "" {
+		episode.Status = "draft"
+	}
+
+	if episode.Status == "scheduled" {
+		if err = checkSchedule(episode); err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
+	if episode.Status == "publish" || episode.Status == "scheduled" {
+		if err = checkPublishPermission(r.Context(), sID, uID); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
 	var episodeSlug string
 	if episode.Slug != "" && slugx.Check(episode.Slug) {
 		episodeSlug = episode.Slug
@@ -94,7 +112,7 @@ func create(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(episode.Description.RawMessage) > 0 && !reflect.DeepEqual(episode.Description, test.NilJsonb()) {
+	if len(episode.Description.RawMessage) > 0 && !reflect.DeepEqual(episode.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(episode.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -102,6 +120,11 @@ func create(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	episodeType := episode.EpisodeType
+	if episodeType == "" {
+		episodeType = "full"
+	}
+
 	result := &episodeData{}
 	result.Episode = model.Episode{
 		Title:           episode.Title,
@@ -111,7 +134,15 @@ func create(w http.ResponseWriter, r *http.Request) {
 		Season:          episode.Season,
 		Episode:         episode.Episode,
 		AudioURL:        episode.AudioURL,
+		Duration:        episode.Duration,
+		FileSize:        episode.FileSize,
+		MIMEType:        episode.MIMEType,
+		Explicit:        episode.Explicit,
+		EpisodeType:     episodeType,
+		TranscriptURL:   episode.TranscriptURL,
+		ChaptersURL:     episode.ChaptersURL,
 		PodcastID:       podcastID,
+		Status:          episode.Status,
 		PublishedDate:   episode.PublishedDate,
 		MediumID:        mediumID,
 		MetaFields:      episode.MetaFields,
@@ -175,22 +206,33 @@ func create(w http.ResponseWriter, r *http.Request) {
 		"audio_url":      result.AudioURL,
 		"podcast_id":     result.PodcastID,
 		"description":    result.Description,
+		"status":         result.Status,
 		"published_date": publishedDate,
 		"space_id":       result.SpaceID,
 		"medium_id":      result.MediumID,
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.AddDocument("dega", meiliObj)
+		_ = search.AddDocument(meiliObj)
 	}
 
-	tx.Commit()
 	if util.CheckNats() {
-		if err = util.NC.Publish("episode.created", result); err != nil {
+		if err = util.Outbox(tx, "episode.created", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
+		if result.Status == "scheduled" {
+			if err = util.Outbox(tx, "episode.scheduled", result); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
 	}
+
+	tx.Commit()
 	renderx.JSON(w, http.StatusCreated, result)
 }
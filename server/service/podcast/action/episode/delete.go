@@ -7,9 +7,9 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/podcast/model"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/go-chi/chi"
@@ -67,16 +67,18 @@ func delete(w http.ResponseWriter, r *http.Request) {
 	}).Delete(&model.EpisodeAuthor{})
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.DeleteDocument("dega", result.ID, "episode")
+		_ = search.DeleteDocument(result.ID, "episode")
 	}
 
-	tx.Commit()
 	if util.CheckNats() {
-		if err = util.NC.Publish("episode.deleted", result); err != nil {
+		if err = util.Outbox(tx, "episode.deleted", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
 	}
+
+	tx.Commit()
 	renderx.JSON(w, http.StatusOK, nil)
 }
@@ -18,8 +18,16 @@ type episode struct {
 	Season        int            `json:"season"  validate:"required"`
 	Episode       int            `json:"episode"  validate:"required"`
 	AudioURL      string         `json:"audio_url" validate:"required"`
+	Duration      int            `json:"duration" validate:"min=0"`
+	FileSize      int64          `json:"file_size" validate:"min=0"`
+	MIMEType      string         `json:"mime_type"`
+	Explicit      bool           `json:"explicit"`
+	EpisodeType   string         `json:"episode_type" validate:"omitempty,oneof=full trailer bonus"`
+	TranscriptURL string         `json:"transcript_url" validate:"omitempty,url"`
+	ChaptersURL   string         `json:"chapters_url" validate:"omitempty,url"`
 	PodcastID     uint           `json:"podcast_id"`
 	Description   postgres.Jsonb `json:"description" swaggertype:"primitive,string"`
+	Status        string         `json:"status" validate:"omitempty,oneof=draft publish scheduled"`
 	PublishedDate *time.Time     `json:"published_date" sql:"DEFAULT:NULL"`
 	MediumID      uint           `json:"medium_id"`
 	SpaceID       uint           `json:"space_id"`
@@ -0,0 +1,127 @@
+package episode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/author"
+	coreModel "github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
+)
+
+// checkSchedule validates that an episode can be scheduled for publishing
+func checkSchedule(episode *episode) error {
+	if episode.PublishedDate == nil || !episode.PublishedDate.After(time.Now()) {
+		return errors.New("cannot schedule episode without a future published date")
+	}
+
+	return nil
+}
+
+// checkPublishPermission checks that the user can publish the episodes of the space
+func checkPublishPermission(ctx context.Context, sID, uID int) error {
+	oID, err := util.GetOrganisation(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := util.CheckPublishPermission("episodes", oID, sID, uID)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.New("user does not have permission to publish episodes")
+	}
+
+	return nil
+}
+
+// isPublished checks if the status is publish or scheduled
+func isPublished(status string) bool {
+	return status == "publish" || status == "scheduled"
+}
+
+// PublishScheduled publishes all the scheduled episodes whose published date has arrived
+func PublishScheduled() error {
+	episodes := make([]model.Episode, 0)
+
+	err := config.DB.Model(&model.Episode{}).Where("status = ? AND published_date <= ?", "scheduled", time.Now()).Preload("Podcast").Preload("Medium").Preload("Space").Find(&episodes).Error
+	if err != nil {
+		return err
+	}
+
+	for _, episode := range episodes {
+		if err = publishScheduled(episode); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
+}
+
+func publishScheduled(episode model.Episode) error {
+	tx := config.DB.Begin()
+
+	// only publish the episode if it was not rescheduled in the meantime
+	res := tx.Model(&model.Episode{}).Where("id = ? AND status = ?", episode.ID, "scheduled").Update("status", "publish")
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil
+	}
+
+	episode.Status = "publish"
+
+	result := &episodeData{}
+	result.Episode = episode
+	result.Authors = make([]coreModel.Author, 0)
+
+	episodeAuthors := make([]model.EpisodeAuthor, 0)
+	tx.Model(&model.EpisodeAuthor{}).Where(&model.EpisodeAuthor{
+		EpisodeID: episode.ID,
+	}).Find(&episodeAuthors)
+
+	if len(episodeAuthors) > 0 && episode.Space != nil {
+		authors := author.Mapper(episode.Space.OrganisationID, int(episode.UpdatedByID))
+		for _, episodeAuthor := range episodeAuthors {
+			if author, found := authors[fmt.Sprint(episodeAuthor.AuthorID)]; found {
+				result.Authors = append(result.Authors, author)
+			}
+		}
+	}
+
+	if config.SearchEnabled() {
+		_ = search.UpdateDocument(map[string]interface{}{
+			"id":             episode.ID,
+			"kind":           "episode",
+			"status":         episode.Status,
+			"published_date": episode.PublishedDate.Unix(),
+		})
+	}
+
+	if util.CheckNats() {
+		if err := util.Outbox(tx, "episode.updated", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := util.Outbox(tx, "episode.published", result); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	tx.Commit()
+
+	return nil
+}
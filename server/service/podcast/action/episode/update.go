@@ -11,12 +11,11 @@ import (
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
 	"github.com/factly/dega-server/service/podcast/model"
-	"github.com/factly/dega-server/test"
 	"github.com/factly/dega-server/util"
 	"github.com/factly/dega-server/util/arrays"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 	"github.com/factly/x/slugx"
@@ -83,6 +82,13 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if episode.Status == "scheduled" {
+		if err = checkSchedule(episode); err != nil {
+			errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+			return
+		}
+	}
+
 	// check record exists or not
 	err = config.DB.Where(&model.Episode{
 		SpaceID: uint(sID),
@@ -94,6 +100,19 @@ func update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if episode.Status == "" {
+		episode.Status = result.Status
+	}
+
+	// publishing, scheduling and unpublishing an episode need the permission to publish
+	if episode.Status != result.Status && (isPublished(episode.Status) || isPublished(result.Status)) {
+		if err = checkPublishPermission(r.Context(), sID, uID); err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+	}
+
 	var episodeSlug string
 
 	// Get table title
@@ -111,7 +130,7 @@ func update(w http.ResponseWriter, r *http.Request) {
 
 	// Store HTML description
 	var description string
-	if len(episode.Description.RawMessage) > 0 && !reflect.DeepEqual(episode.Description, test.NilJsonb()) {
+	if len(episode.Description.RawMessage) > 0 && !reflect.DeepEqual(episode.Description, util.NilJsonb()) {
 		description, err = util.HTMLDescription(episode.Description)
 		if err != nil {
 			loggerx.Error(err)
@@ -147,7 +166,22 @@ func update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	tx.Model(&result.Episode).Select("PublishedDate").Updates(model.Episode{PublishedDate: episode.PublishedDate})
+	oldStatus := result.Status
+	tx.Model(&result.Episode).Select("PublishedDate", "Status").Updates(model.Episode{PublishedDate: episode.PublishedDate, Status: episode.Status})
+	// the audio details can be cleared, so they are updated along with their zero values
+	episodeType := episode.EpisodeType
+	if episodeType == "" {
+		episodeType = "full"
+	}
+	tx.Model(&result.Episode).Select("Duration", "FileSize", "MIMEType", "Explicit", "EpisodeType", "TranscriptURL", "ChaptersURL").Updates(model.Episode{
+		Duration:      episode.Duration,
+		FileSize:      episode.FileSize,
+		MIMEType:      episode.MIMEType,
+		Explicit:      episode.Explicit,
+		EpisodeType:   episodeType,
+		TranscriptURL: episode.TranscriptURL,
+		ChaptersURL:   episode.ChaptersURL,
+	})
 	tx.Model(&result.Episode).Updates(model.Episode{
 		Base:            config.Base{UpdatedByID: uint(uID)},
 		Title:           episode.Title,
@@ -231,22 +265,33 @@ func update(w http.ResponseWriter, r *http.Request) {
 		"audio_url":      result.AudioURL,
 		"podcast_id":     result.PodcastID,
 		"description":    result.Description,
+		"status":         result.Status,
 		"published_date": publishedDate,
 		"space_id":       result.SpaceID,
 		"medium_id":      result.MediumID,
 	}
 
 	if config.SearchEnabled() {
-		_ = meilisearchx.UpdateDocument("dega", meiliObj)
+		_ = search.UpdateDocument(meiliObj)
 	}
 
-	tx.Commit()
 	if util.CheckNats() {
-		if err = util.NC.Publish("episode.updated", result); err != nil {
+		if err = util.Outbox(tx, "episode.updated", result); err != nil {
+			tx.Rollback()
 			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			errorx.Render(w, errorx.Parser(errorx.DBError()))
 			return
 		}
+		if oldStatus != "scheduled" && result.Status == "scheduled" {
+			if err = util.Outbox(tx, "episode.scheduled", result); err != nil {
+				tx.Rollback()
+				loggerx.Error(err)
+				errorx.Render(w, errorx.Parser(errorx.DBError()))
+				return
+			}
+		}
 	}
+
+	tx.Commit()
 	renderx.JSON(w, http.StatusOK, result)
 }
@@ -2,16 +2,18 @@ package podcast
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
-	pcast "github.com/eduncan911/podcast"
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/core/action/author"
 	coreModel "github.com/factly/dega-server/service/core/model"
 	"github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util/permalink"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/go-chi/chi"
@@ -76,45 +78,56 @@ func Feeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	now := time.Now()
+	urls := permalink.New(*space)
 
-	p := pcast.New(
-		result.Title,
-		fmt.Sprint("http://factly.org/podcasts/", result.Slug),
-		result.HTMLDescription,
-		&now, &now,
-	)
+	feed := rss{
+		Version:   "2.0",
+		ITunesNS:  "http://www.itunes.com/dtds/podcast-1.0.dtd",
+		PodcastNS: "https://podcastindex.org/namespace/1.0",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		Channel: channel{
+			Title:        result.Title,
+			Link:         urls.Podcast(*result),
+			Description:  cdata{Text: result.HTMLDescription},
+			Language:     result.Language,
+			ITunesAuthor: space.Name,
+			ITunesType:   "episodic",
+		},
+	}
 
 	if result.Medium != nil {
-		podcastMediumURL := map[string]interface{}{}
-		_ = json.Unmarshal(result.Medium.URL.RawMessage, &podcastMediumURL)
-		if rawURL, found := podcastMediumURL["raw"]; found {
-			p.IImage = &pcast.IImage{
-				HREF: rawURL.(string),
-			}
+		if rawURL := rawURL(*result.Medium); rawURL != "" {
+			feed.Channel.Image = &image{URL: rawURL, Title: result.Title, Link: feed.Channel.Link}
+			feed.Channel.ITunesImage = &itunesImage{HREF: rawURL}
 		}
 	}
 
 	if result.PrimaryCategory != nil {
-		p.Category = result.PrimaryCategory.Name
+		feed.Channel.Category = result.PrimaryCategory.Name
+		feed.Channel.ITunesCategories = append(feed.Channel.ITunesCategories, itunesCategory{Text: result.PrimaryCategory.Name})
 	}
-	p.Description = result.HTMLDescription
-
 	for _, cat := range result.Categories {
-		icat := pcast.ICategory{
-			Text: cat.Name,
+		if result.PrimaryCategoryID == nil || cat.ID != *result.PrimaryCategoryID {
+			feed.Channel.ITunesCategories = append(feed.Channel.ITunesCategories, itunesCategory{Text: cat.Name})
 		}
-		p.ICategories = append(p.ICategories, &icat)
 	}
 
-	p.Language = result.Language
+	// the podcast is explicit when any of its published episodes is
+	var explicitCount int64
+	config.DB.Model(&model.Episode{}).Where(&model.Episode{
+		PodcastID: &result.ID,
+		Status:    "publish",
+		Explicit:  true,
+	}).Count(&explicitCount)
+	feed.Channel.ITunesExplicit = explicit(explicitCount > 0)
 
-	// fetch all episodes related to this podcast
+	// fetch the published episodes of this podcast
 	episodeList := make([]model.Episode, 0)
 
 	config.DB.Model(&model.Episode{}).Where(&model.Episode{
 		PodcastID: &result.ID,
-	}).Preload("Medium").Order("created_at " + sort).Limit(limit).Offset((pageNo - 1) * limit).Find(&episodeList)
+		Status:    "publish",
+	}).Where("published_date IS NOT NULL").Preload("Medium").Order("published_date " + sort).Limit(limit).Offset((pageNo - 1) * limit).Find(&episodeList)
 
 	episodeIDs := make([]uint, 0)
 	for _, each := range episodeList {
@@ -127,64 +140,98 @@ func Feeds(w http.ResponseWriter, r *http.Request) {
 	var authorMap map[string]coreModel.Author
 	if len(episodeAuthors) > 0 {
 		authorMap = author.Mapper(space.OrganisationID, int(episodeAuthors[0].AuthorID))
-		if err != nil {
-			loggerx.Error(err)
-			errorx.Render(w, errorx.Parser(errorx.DBError()))
-			return
-		}
 	}
 
-	episodeAuthorMap := make(map[uint]uint)
+	episodeAuthorMap := make(map[uint][]string)
 	for _, ea := range episodeAuthors {
-		if _, found := episodeAuthorMap[ea.EpisodeID]; !found {
-			episodeAuthorMap[ea.EpisodeID] = ea.AuthorID
+		if author, found := authorMap[fmt.Sprint(ea.AuthorID)]; found {
+			name := strings.TrimSpace(fmt.Sprint(author.FirstName, " ", author.LastName))
+			if name != "" {
+				episodeAuthorMap[ea.EpisodeID] = append(episodeAuthorMap[ea.EpisodeID], name)
+			}
 		}
 	}
 
+	// the feed is published along with its latest episode and built when the
+	// podcast or any of its episodes last changed
+	var pubDate time.Time
+	lastBuildDate := result.UpdatedAt
 	for _, episode := range episodeList {
-		description := episode.HTMLDescription
-		if description == "" {
-			description = "----"
+		if episode.PublishedDate.After(pubDate) {
+			pubDate = *episode.PublishedDate
 		}
-		item := pcast.Item{
-			Title:       episode.Title,
-			GUID:        fmt.Sprint(episode.ID),
-			Description: description,
-			Link:        fmt.Sprint("https://factly.org/podcasts/", result.ID, "/episodes/", episode.ID),
-			Source:      episode.AudioURL,
+		if episode.UpdatedAt.After(lastBuildDate) {
+			lastBuildDate = episode.UpdatedAt
+		}
+
+		episodeType := episode.EpisodeType
+		if episodeType == "" {
+			episodeType = "full"
 		}
 
-		if episode.PublishedDate != nil {
-			item.PubDateFormatted = episode.PublishedDate.String()
+		item := item{
+			Title:       episode.Title,
+			Link:        urls.Episode(episode, *result),
+			Description: cdata{Text: episode.HTMLDescription},
+			GUID:        guid{ID: fmt.Sprint(episode.ID)},
+			PubDate:     episode.PublishedDate.Format(time.RFC1123Z),
+			Enclosure: enclosure{
+				URL:    episode.AudioURL,
+				Length: episode.FileSize,
+				Type:   episode.MIMEType,
+			},
+			ITunesTitle:       episode.Title,
+			ITunesAuthor:      strings.Join(episodeAuthorMap[episode.ID], ", "),
+			ITunesDuration:    episode.Duration,
+			ITunesExplicit:    explicit(episode.Explicit),
+			ITunesEpisodeType: episodeType,
+			ITunesSeason:      episode.Season,
+			ITunesEpisode:     episode.Episode,
+		}
+		if item.Enclosure.Type == "" {
+			item.Enclosure.Type = mimeType(episode.AudioURL, "audio/mpeg")
+		}
+		if episode.HTMLDescription != "" {
+			item.Content = &cdata{Text: episode.HTMLDescription}
 		}
 		if episode.Medium != nil {
-			episodeMediumURL := map[string]interface{}{}
-			_ = json.Unmarshal(episode.Medium.URL.RawMessage, &episodeMediumURL)
-			if rawURL, found := episodeMediumURL["raw"]; found {
-				item.IImage = &pcast.IImage{
-					HREF: rawURL.(string),
-				}
+			if rawURL := rawURL(*episode.Medium); rawURL != "" {
+				item.ITunesImage = &itunesImage{HREF: rawURL}
 			}
 		}
-
-		if authID, found := episodeAuthorMap[episode.ID]; found {
-			if author, found := authorMap[fmt.Sprint(authID)]; found {
-				item.Author = &pcast.Author{
-					Name:  fmt.Sprint(author.FirstName, " ", author.LastName),
-					Email: author.Email,
-				}
-			}
+		if episode.TranscriptURL != "" {
+			item.Transcript = &podcastLink{URL: episode.TranscriptURL, Type: mimeType(episode.TranscriptURL, "text/plain")}
 		}
-
-		if _, err := p.AddItem(item); err != nil {
-			fmt.Println(item.Title, ": error", err.Error())
-			return
+		if episode.ChaptersURL != "" {
+			item.Chapters = &podcastLink{URL: episode.ChaptersURL, Type: "application/json+chapters"}
 		}
+
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	if pubDate.After(lastBuildDate) {
+		lastBuildDate = pubDate
+	}
+	if !pubDate.IsZero() {
+		feed.Channel.PubDate = pubDate.Format(time.RFC1123Z)
 	}
+	feed.Channel.LastBuildDate = lastBuildDate.Format(time.RFC1123Z)
 
-	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
 
-	if err := p.Encode(w); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if _, err := fmt.Fprint(w, xml.Header); err != nil {
+		loggerx.Error(err)
+		return
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(feed); err != nil {
+		loggerx.Error(err)
 	}
 }
+
+func rawURL(medium coreModel.Medium) string {
+	urlMap := map[string]interface{}{}
+	_ = json.Unmarshal(medium.URL.RawMessage, &urlMap)
+	rawURL, _ := urlMap["raw"].(string)
+	return rawURL
+}
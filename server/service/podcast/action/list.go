@@ -8,6 +8,7 @@ import (
 
 	"github.com/factly/dega-server/config"
 	"github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/meilisearchx"
@@ -73,19 +74,13 @@ func list(w http.ResponseWriter, r *http.Request) {
 	if filters != "" || searchQuery != "" {
 
 		if config.SearchEnabled() {
-			if filters != "" {
-				filters = fmt.Sprint(filters, " AND space_id=", sID)
-			}
 			var hits []interface{}
-			var res map[string]interface{}
-			if searchQuery != "" {
-				hits, err = meilisearchx.SearchWithQuery("dega", searchQuery, filters, "podcast")
-			} else {
-				res, err = meilisearchx.SearchWithoutQuery("dega", filters, "podcast")
-				if _, found := res["hits"]; found {
-					hits = res["hits"].([]interface{})
-				}
-			}
+			hits, err = search.Search(search.Query{
+				Text:    searchQuery,
+				SpaceID: uint(sID),
+				Filters: filters,
+				Kinds:   []string{"podcast"},
+			})
 			if err != nil {
 				loggerx.Error(err)
 				errorx.Render(w, errorx.Parser(errorx.NetworkError()))
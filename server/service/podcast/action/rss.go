@@ -0,0 +1,119 @@
+package podcast
+
+import (
+	"encoding/xml"
+	"path"
+	"strings"
+)
+
+// rss - a podcast feed with the iTunes and Podcasting 2.0 tags
+type rss struct {
+	XMLName   xml.Name `xml:"rss"`
+	Version   string   `xml:"version,attr"`
+	ITunesNS  string   `xml:"xmlns:itunes,attr"`
+	PodcastNS string   `xml:"xmlns:podcast,attr"`
+	ContentNS string   `xml:"xmlns:content,attr"`
+	Channel   channel  `xml:"channel"`
+}
+
+type channel struct {
+	Title            string           `xml:"title"`
+	Link             string           `xml:"link"`
+	Description      cdata            `xml:"description"`
+	Language         string           `xml:"language,omitempty"`
+	Category         string           `xml:"category,omitempty"`
+	PubDate          string           `xml:"pubDate,omitempty"`
+	LastBuildDate    string           `xml:"lastBuildDate"`
+	Image            *image           `xml:"image"`
+	ITunesAuthor     string           `xml:"itunes:author,omitempty"`
+	ITunesType       string           `xml:"itunes:type"`
+	ITunesImage      *itunesImage     `xml:"itunes:image"`
+	ITunesCategories []itunesCategory `xml:"itunes:category"`
+	ITunesExplicit   string           `xml:"itunes:explicit"`
+	Items            []item           `xml:"item"`
+}
+
+type item struct {
+	Title             string       `xml:"title"`
+	Link              string       `xml:"link"`
+	Description       cdata        `xml:"description"`
+	Content           *cdata       `xml:"content:encoded"`
+	GUID              guid         `xml:"guid"`
+	PubDate           string       `xml:"pubDate,omitempty"`
+	Enclosure         enclosure    `xml:"enclosure"`
+	ITunesTitle       string       `xml:"itunes:title"`
+	ITunesAuthor      string       `xml:"itunes:author,omitempty"`
+	ITunesImage       *itunesImage `xml:"itunes:image"`
+	ITunesDuration    int          `xml:"itunes:duration,omitempty"`
+	ITunesExplicit    string       `xml:"itunes:explicit"`
+	ITunesEpisodeType string       `xml:"itunes:episodeType"`
+	ITunesSeason      int          `xml:"itunes:season,omitempty"`
+	ITunesEpisode     int          `xml:"itunes:episode,omitempty"`
+	Transcript        *podcastLink `xml:"podcast:transcript"`
+	Chapters          *podcastLink `xml:"podcast:chapters"`
+}
+
+type cdata struct {
+	Text string `xml:",cdata"`
+}
+
+type image struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type itunesImage struct {
+	HREF string `xml:"href,attr"`
+}
+
+type itunesCategory struct {
+	Text string `xml:"text,attr"`
+}
+
+type guid struct {
+	ID          string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type enclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// podcastLink - a file linked with the Podcasting 2.0 tags
+type podcastLink struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// types of the audio and the transcripts by their extension, for the
+// episodes without a MIME type
+var mimeTypes = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/x-m4a",
+	".aac":  "audio/aac",
+	".ogg":  "audio/ogg",
+	".opus": "audio/opus",
+	".wav":  "audio/wav",
+	".vtt":  "text/vtt",
+	".srt":  "application/x-subrip",
+	".json": "application/json",
+	".html": "text/html",
+}
+
+func mimeType(url string, fallback string) string {
+	extension := strings.ToLower(path.Ext(strings.Split(url, "?")[0]))
+	if mimeType, found := mimeTypes[extension]; found {
+		return mimeType
+	}
+	return fallback
+}
+
+func explicit(value bool) string {
+	if value {
+		return "true"
+	}
+	return "false"
+}
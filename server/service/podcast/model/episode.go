@@ -18,10 +18,18 @@ type Episode struct {
 	Season          int            `gorm:"column:season" json:"season"`
 	Episode         int            `gorm:"column:episode" json:"episode"`
 	AudioURL        string         `gorm:"column:audio_url" json:"audio_url"`
+	Duration        int            `gorm:"column:duration" json:"duration"`
+	FileSize        int64          `gorm:"column:file_size" json:"file_size"`
+	MIMEType        string         `gorm:"column:mime_type" json:"mime_type"`
+	Explicit        bool           `gorm:"column:explicit" json:"explicit"`
+	EpisodeType     string         `gorm:"column:episode_type" json:"episode_type"`
+	TranscriptURL   string         `gorm:"column:transcript_url" json:"transcript_url"`
+	ChaptersURL     string         `gorm:"column:chapters_url" json:"chapters_url"`
 	PodcastID       *uint          `gorm:"column:podcast_id" json:"podcast_id"`
 	Podcast         *Podcast       `json:"podcast"`
 	Description     postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
 	HTMLDescription string         `gorm:"column:html_description" json:"html_description,omitempty"`
+	Status          string         `gorm:"column:status" json:"status"`
 	PublishedDate   *time.Time     `gorm:"column:published_date" json:"published_date" sql:"DEFAULT:NULL"`
 	MediumID        *uint          `gorm:"column:medium_id;default:NULL" json:"medium_id"`
 	Medium          *model.Medium  `json:"medium"`
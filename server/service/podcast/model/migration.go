@@ -9,4 +9,13 @@ func Migration() {
 		&Podcast{},
 		&EpisodeAuthor{},
 	)
+
+	BackfillEpisodeStatus()
+}
+
+// BackfillEpisodeStatus sets the status of the episodes created before they
+// had one, those with a published date are published and the others drafts
+func BackfillEpisodeStatus() {
+	config.DB.Model(&Episode{}).Where("status IS NULL OR status = ''").Where("published_date IS NOT NULL").UpdateColumn("status", "publish")
+	config.DB.Model(&Episode{}).Where("status IS NULL OR status = ''").UpdateColumn("status", "draft")
 }
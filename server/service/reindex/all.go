@@ -4,9 +4,9 @@ import (
 	"net/http"
 
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/search"
 	"github.com/factly/x/errorx"
 	"github.com/factly/x/loggerx"
-	"github.com/factly/x/meilisearchx"
 	"github.com/factly/x/middlewarex"
 	"github.com/factly/x/renderx"
 )
@@ -34,7 +34,7 @@ func all(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = meilisearchx.Client.Documents("dega").DeleteAllDocuments()
+	err = search.DeleteAll()
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
@@ -0,0 +1,90 @@
+package relay
+
+import (
+	"sync"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/util"
+	"github.com/factly/x/loggerx"
+	"gorm.io/gorm/clause"
+)
+
+// number of outbox events published per transaction
+const batchSize = 100
+
+var running sync.Mutex
+
+// Start publishes the events in the outbox to NATS at a fixed interval.
+// It blocks, so it is meant to be run in its own goroutine.
+func Start() {
+	ticker := time.NewTicker(config.Interval("outbox_poll_interval", time.Second))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		Run()
+	}
+}
+
+// Run drains the outbox. Events are marked published only after NATS has
+// acknowledged them, so an event may be published more than once but is
+// never lost. Draining stops at the first failure and is retried on the next run.
+func Run() {
+	running.Lock()
+	defer running.Unlock()
+
+	for {
+		published, err := drain()
+		if err != nil {
+			loggerx.Error(err)
+			return
+		}
+		if published < batchSize {
+			return
+		}
+	}
+}
+
+func drain() (int, error) {
+	tx := config.DB.Begin()
+
+	events := make([]model.OutboxEvent, 0)
+	err := tx.Model(&model.OutboxEvent{}).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ?", util.OutboxPending).
+		Order("id asc").Limit(batchSize).Find(&events).Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	for i, event := range events {
+		if err = publish(event); err != nil {
+			tx.Model(&events[i]).Updates(map[string]interface{}{
+				"attempts":   event.Attempts + 1,
+				"last_error": err.Error(),
+			})
+			tx.Commit()
+			return i, err
+		}
+
+		err = tx.Model(&events[i]).Updates(map[string]interface{}{
+			"status":       util.OutboxPublished,
+			"last_error":   "",
+			"published_at": time.Now(),
+		}).Error
+		if err != nil {
+			tx.Commit()
+			return i, err
+		}
+	}
+
+	return len(events), tx.Commit().Error
+}
+
+func publish(event model.OutboxEvent) error {
+	if err := util.NC.Conn.Publish(event.Subject, event.Payload.RawMessage); err != nil {
+		return err
+	}
+	return util.NC.Conn.FlushTimeout(5 * time.Second)
+}
@@ -0,0 +1,33 @@
+package retention
+
+import (
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/trash"
+	"github.com/factly/x/loggerx"
+)
+
+// Start purges the entities kept in the trash for longer than
+// trash_retention_days at a fixed interval. It blocks, so it is meant to be
+// run in its own goroutine.
+func Start() {
+	ticker := time.NewTicker(config.Interval("retention_interval", time.Hour))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		Run()
+	}
+}
+
+// Run purges everything deleted more than trash_retention_days ago
+func Run() {
+	days := config.TrashRetentionDays()
+	if days <= 0 {
+		return
+	}
+
+	if err := trash.PurgeExpired(time.Now().AddDate(0, 0, -days)); err != nil {
+		loggerx.Error(err)
+	}
+}
@@ -17,12 +17,16 @@ import (
 	"github.com/factly/dega-server/service/core/action/request/organisation"
 	"github.com/factly/dega-server/service/core/action/request/space"
 	"github.com/factly/dega-server/service/core/action/tag"
+	"github.com/factly/dega-server/service/core/action/translation"
 	factCheck "github.com/factly/dega-server/service/fact-check"
+	"github.com/factly/dega-server/service/fact-check/action/claim"
 	"github.com/factly/dega-server/service/podcast"
 	podcastAction "github.com/factly/dega-server/service/podcast/action"
 	"github.com/factly/dega-server/service/reindex"
 	"github.com/factly/dega-server/service/user"
 	"github.com/factly/dega-server/util"
+	"github.com/factly/dega-server/util/feed"
+	"github.com/factly/dega-server/util/storage"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
 	"github.com/go-chi/chi"
@@ -50,6 +54,11 @@ func RegisterRoutes() http.Handler {
 		r.Mount("/meta", meta.Router())
 	}
 
+	// media uploaded to the local storage backend
+	if local, ok := storage.Current().(storage.Local); ok {
+		r.Handle("/uploads/*", http.StripPrefix("/uploads", local.Handler()))
+	}
+
 	sqlDB, _ := config.DB.DB()
 
 	healthx.RegisterRoutes(r, healthx.ReadyCheckers{
@@ -89,16 +98,12 @@ func RegisterFeedsRoutes() http.Handler {
 	r.Use(middleware.Heartbeat("/ping"))
 
 	r.Route("/spaces/{space_id}", func(r chi.Router) {
-		r.Get("/posts/feed", post.Feeds)
-		r.Get("/posts/feeds/rss2", post.Feeds)
-		r.Get("/tags/{slugs}/feed", tag.Feeds)
-		r.Get("/tags/{slugs}/feeds/rss2", tag.Feeds)
-		r.Get("/categories/{slugs}/feed", category.Feeds)
-		r.Get("/categories/{slugs}/feeds/rss2", category.Feeds)
-		r.Get("/formats/{slugs}/feed", format.Feeds)
-		r.Get("/formats/{slugs}/feeds/rss2", format.Feeds)
-		r.Get("/authors/{slugs}/feed", author.Feeds)
-		r.Get("/authors/{slugs}/feeds/rss2", author.Feeds)
+		localeFeedsRoutes(r)
+
+		r.Route("/locales/{locale}", func(r chi.Router) {
+			r.Use(translation.FeedLocale)
+			localeFeedsRoutes(r)
+		})
 
 		r.Get("/podcasts/{podcast_slug}/feed", podcastAction.Feeds)
 		r.Get("/podcasts/{podcast_slug}/feeds/rss2", podcastAction.Feeds)
@@ -106,3 +111,24 @@ func RegisterFeedsRoutes() http.Handler {
 
 	return r
 }
+
+// localeFeedsRoutes - feeds which are also split per language
+func localeFeedsRoutes(r chi.Router) {
+	feedRoutes(r, "/posts", post.Feeds)
+	feedRoutes(r, "/tags/{slugs}", tag.Feeds)
+	feedRoutes(r, "/categories/{slugs}", category.Feeds)
+	feedRoutes(r, "/formats/{slugs}", format.Feeds)
+	feedRoutes(r, "/authors/{slugs}", author.Feeds)
+
+	r.Get("/claims/feeds/claimreview", claim.ClaimReviewFeed)
+	r.Get("/claims/feeds/datacommons", claim.DataCommonsFeed)
+}
+
+// feedRoutes - the feed of the path in the format negotiated with the Accept
+// header, and in each of the formats under /feeds/{format}
+func feedRoutes(r chi.Router, path string, handler http.HandlerFunc) {
+	r.Get(path+"/feed", handler)
+	for _, format := range feed.Formats {
+		r.With(feed.As(format)).Get(path+"/feeds/"+string(format), handler)
+	}
+}
@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/page"
+	"github.com/factly/dega-server/service/core/action/post"
+	"github.com/factly/dega-server/service/podcast/action/episode"
+	"github.com/factly/x/loggerx"
+)
+
+// Start publishes scheduled posts, pages and episodes at a fixed interval.
+// It blocks, so it is meant to be run in its own goroutine.
+func Start() {
+	ticker := time.NewTicker(config.Interval("scheduler_interval", time.Minute))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		Run()
+	}
+}
+
+// Run publishes everything whose scheduled time has arrived
+func Run() {
+	if err := post.PublishScheduled(); err != nil {
+		loggerx.Error(err)
+	}
+
+	if err := page.PublishScheduled(); err != nil {
+		loggerx.Error(err)
+	}
+
+	if err := episode.PublishScheduled(); err != nil {
+		loggerx.Error(err)
+	}
+}
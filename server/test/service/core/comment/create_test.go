@@ -0,0 +1,109 @@
+package comment
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCommentCreate(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("Unprocessable comment", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithPath("post_id", "1").
+			WithJSON(invalidData).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("post not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(postQuery).
+			WithArgs(1, false, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		e.POST(basePath).
+			WithPath("post_id", "1").
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("block not found in description", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		postSelectMock(mock)
+
+		e.POST(basePath).
+			WithPath("post_id", "1").
+			WithJSON(map[string]interface{}{
+				"body":     Data["body"],
+				"block_id": "unknown",
+			}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("create comment on block", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		postSelectMock(mock)
+		insertMock(mock)
+
+		e.POST(basePath).
+			WithPath("post_id", "1").
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"body": Data["body"], "block_id": Data["block_id"], "entity_type": "post"})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("cannot reply to a reply", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		postSelectMock(mock)
+		SelectQuery(mock, 2, "post", 1, 1, 1)
+
+		e.POST(basePath).
+			WithPath("post_id", "1").
+			WithJSON(map[string]interface{}{
+				"body":      Data["body"],
+				"parent_id": 1,
+			}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,60 @@
+package comment
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCommentList(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid post id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(basePath).
+			WithPath("post_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get empty list of comments", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs("post", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(basePath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 0})
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,67 @@
+package comment
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCommentResolve(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid comment id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(resolvePath).
+			WithPathObject(map[string]interface{}{"post_id": "1", "comment_id": "invalid_id"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("comment record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs("post", 1, 1, 1).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.POST(resolvePath).
+			WithPathObject(map[string]interface{}{"post_id": "1", "comment_id": "1"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("cannot resolve a reply", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 2, "post", 1, 1, 1)
+
+		e.POST(resolvePath).
+			WithPathObject(map[string]interface{}{"post_id": "1", "comment_id": "1"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,61 @@
+package comment
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"body":     "Can we get a second source for this?",
+	"block_id": "xK2-3a1",
+}
+
+var invalidData = map[string]interface{}{
+	"block_id": "xK2-3a1",
+}
+
+var description = postgres.Jsonb{
+	RawMessage: []byte(`{"time":1617039625490,"blocks":[{"id":"xK2-3a1","type":"paragraph","data":{"text":"Test Description"}}],"version":"2.22.0"}`),
+}
+
+var Columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "body", "entity_type", "entity_id", "block_id", "parent_id", "mention_ids", "is_resolved", "resolved_by_id", "resolved_at", "space_id"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "comments"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "comments"`)
+var postQuery = regexp.QuoteMeta(`SELECT * FROM "posts"`)
+
+var basePath = "/core/posts/{post_id}/comments"
+var path = "/core/posts/{post_id}/comments/{comment_id}"
+var resolvePath = "/core/posts/{post_id}/comments/{comment_id}/resolve"
+
+func postSelectMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(postQuery).
+		WithArgs(1, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "space_id", "is_page", "description"}).
+			AddRow(1, 1, false, description))
+}
+
+func SelectQuery(mock sqlmock.Sqlmock, parentID interface{}, args ...driver.Value) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["body"], "post", 1, Data["block_id"], parentID, postgres.Jsonb{RawMessage: []byte(`[]`)}, false, nil, nil, 1))
+}
+
+func insertMock(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "comments"`).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id"}).
+			AddRow(1))
+	mock.ExpectCommit()
+}
@@ -0,0 +1,329 @@
+package medium
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/test/service/core/permissions/space"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var uploadPath = "/core/media/upload"
+
+// testJPEG returns a jpeg with an exif segment setting its Make and
+// Orientation tags
+func testJPEG(width, height int, orientation uint16) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	_ = jpeg.Encode(&buf, img, nil)
+
+	tiff := new(bytes.Buffer)
+	tiff.WriteString("MM")
+	_ = binary.Write(tiff, binary.BigEndian, []uint16{42})
+	_ = binary.Write(tiff, binary.BigEndian, []uint32{8})
+	_ = binary.Write(tiff, binary.BigEndian, []uint16{2})
+	// Make, ascii stored after the directory
+	_ = binary.Write(tiff, binary.BigEndian, []uint16{0x010f, 2})
+	_ = binary.Write(tiff, binary.BigEndian, []uint32{5, 38})
+	// Orientation, short stored in the entry
+	_ = binary.Write(tiff, binary.BigEndian, []uint16{0x0112, 3})
+	_ = binary.Write(tiff, binary.BigEndian, []uint32{1})
+	_ = binary.Write(tiff, binary.BigEndian, []uint16{orientation, 0})
+	_ = binary.Write(tiff, binary.BigEndian, []uint32{0})
+	tiff.WriteString("Dega\x00")
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	app1 := []byte{0xff, 0xe1, byte((len(segment) + 2) >> 8), byte(len(segment) + 2)}
+
+	data := buf.Bytes()
+	result := append([]byte{}, data[:2]...)
+	result = append(result, app1...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func uploadCountQuery(mock sqlmock.Sqlmock, count int) {
+	space.SelectQuery(mock)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "media"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func uploadInsertMock(mock sqlmock.Sqlmock, name, mimeType, dimensions string) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "media"`)).
+		WithArgs(name+"%", 1).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "media"`).
+		WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, name, name, mimeType, "", "", "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), dimensions, nil, 1).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id"}).
+			AddRow(1))
+	mock.ExpectCommit()
+}
+
+func TestMediumUpload(t *testing.T) {
+
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	dir, err := ioutil.TempDir("", "dega-uploads")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	viper.Set("storage_backend", "local")
+	viper.Set("storage_local_path", dir)
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	viper.Set("storage_public_url", testServer.URL+"/uploads")
+	defer viper.Set("storage_public_url", nil)
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("upload without a file", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFormField("name", "photo").
+			Expect().
+			Status(http.StatusBadRequest)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("upload image", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		uploadCountQuery(mock, 0)
+
+		uploadInsertMock(mock, "photo", "image/jpeg", "350x700")
+
+		url := e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFileBytes("file", "photo.jpg", testJPEG(700, 350, 6)).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"name":       "photo",
+				"type":       "image/jpeg",
+				"dimensions": "350x700",
+			}).
+			Value("url").
+			Object()
+
+		url.ContainsMap(map[string]interface{}{
+			"mime_type": "image/jpeg",
+			"width":     350,
+			"height":    700,
+			"exif": map[string]interface{}{
+				"Make":        "Dega",
+				"Orientation": 6,
+			},
+		})
+		url.Value("blurhash").String().Length().Equal(28)
+
+		raw := url.Value("raw").String().Raw()
+		if !strings.HasPrefix(raw, testServer.URL+"/uploads/1/") || !strings.HasSuffix(raw, "_photo.jpg") {
+			t.Errorf("unexpected raw url %s", raw)
+		}
+		served := e.GET(strings.TrimPrefix(raw, testServer.URL)).
+			Expect().
+			Status(http.StatusOK).
+			ContentType("image/jpeg")
+		served.Header("X-Content-Type-Options").Equal("nosniff")
+		served.Header("Content-Disposition").Empty()
+
+		webp := url.Value("webp").String().Raw()
+		e.GET(strings.TrimPrefix(webp, testServer.URL)).
+			Expect().
+			Status(http.StatusOK).
+			Body().
+			Contains("WEBPVP8L")
+
+		renditions := url.Value("renditions").Array()
+		renditions.Length().Equal(1)
+		rendition := renditions.Element(0).Object()
+		rendition.ContainsMap(map[string]interface{}{
+			"width":  320,
+			"height": 640,
+		})
+
+		file := strings.TrimPrefix(rendition.Value("url").String().Raw(), testServer.URL+"/uploads/")
+		stored, err := os.Open(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer stored.Close()
+		cfg, err := jpeg.DecodeConfig(stored)
+		if err != nil || cfg.Width != 320 || cfg.Height != 640 {
+			t.Errorf("unexpected rendition %v %v", cfg, err)
+		}
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("upload file which is not an image", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		uploadCountQuery(mock, 0)
+
+		uploadInsertMock(mock, "notes", "text/plain; charset=utf-8", "")
+
+		url := e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFileBytes("file", "notes.html", []byte("notes about the fact check")).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			Value("url").
+			Object()
+
+		url.Value("mime_type").Equal("text/plain; charset=utf-8")
+		url.NotContainsKey("renditions")
+		// the extension is the one of the detected type, not of the file name
+		raw := url.Value("raw").String().Raw()
+		if !strings.HasSuffix(raw, "_notes.txt") {
+			t.Errorf("unexpected raw url %s", raw)
+		}
+
+		served := e.GET(strings.TrimPrefix(raw, testServer.URL)).
+			Expect().
+			Status(http.StatusOK)
+		served.Header("X-Content-Type-Options").Equal("nosniff")
+		served.Header("Content-Disposition").Equal("attachment")
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("upload file of a type which is not allowed", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		uploadCountQuery(mock, 0)
+
+		e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFileBytes("file", "photo.jpg", []byte("<html><script>alert(document.cookie)</script></html>")).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("space cannot create more media", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		uploadCountQuery(mock, 100)
+
+		e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFileBytes("file", "photo.jpg", testJPEG(10, 10, 1)).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("directories of uploads are not listed", func(t *testing.T) {
+		e.GET("/uploads/1/").
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("upload image to s3 compatible storage", func(t *testing.T) {
+		var lock sync.Mutex
+		objects := map[string]string{}
+		minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=miniokey/") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			lock.Lock()
+			objects[r.URL.Path] = r.Header.Get("Content-Type")
+			lock.Unlock()
+		}))
+		defer minio.Close()
+		gock.New(minio.URL).EnableNetworking().Persist()
+
+		viper.Set("storage_backend", "s3")
+		viper.Set("s3_endpoint", minio.URL)
+		viper.Set("s3_bucket", "dega")
+		viper.Set("s3_access_key", "miniokey")
+		viper.Set("s3_secret_key", "miniosecret")
+		viper.Set("storage_public_url", nil)
+		defer viper.Set("storage_backend", "local")
+
+		test.CheckSpaceMock(mock)
+
+		uploadCountQuery(mock, 0)
+
+		uploadInsertMock(mock, "photo", "image/jpeg", "700x350")
+
+		url := e.POST(uploadPath).
+			WithHeaders(headers).
+			WithMultipart().
+			WithFileBytes("file", "photo.jpg", testJPEG(700, 350, 1)).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			Value("url").
+			Object()
+
+		url.Value("raw").String().Contains(minio.URL + "/dega/1/")
+		url.Value("renditions").Array().Length().Equal(2)
+
+		// the original, two renditions and their webp variants
+		if len(objects) != 6 {
+			t.Errorf("expected 6 objects, got %v", objects)
+		}
+		for path, contentType := range objects {
+			if strings.HasSuffix(path, ".webp") && contentType != "image/webp" ||
+				strings.HasSuffix(path, ".jpg") && contentType != "image/jpeg" {
+				t.Errorf("unexpected content type %s of %s", contentType, path)
+			}
+		}
+
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,277 @@
+package medium
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var usagePath = "/core/media/{medium_id}/usages"
+var unusedPath = "/core/media/unused"
+
+var usageColumns = []string{"id", "title", "medium_id", "description", "is_page"}
+
+// tables scanned for the media they use, in the order they are scanned
+var usageTables = []string{"posts", "categories", "tags", "formats", "ratings", "claimants", "claims", "podcasts", "episodes"}
+
+var mediumURL = `{"raw":"http://localhost:7789/uploads/1/photo.jpg","webp":"http://localhost:7789/uploads/1/photo.webp"}`
+
+func mediumSelectMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "url", "space_id"}).
+			AddRow(1, "photo", "photo", []byte(mediumURL), 1))
+}
+
+// usageScanMock mocks the scan of the space using the space logo and the
+// rows of the tables
+func usageScanMock(mock sqlmock.Sqlmock, logoID interface{}, rows map[string]*sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, url FROM "media"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url"}).
+			AddRow(1, []byte(mediumURL)).
+			AddRow(2, []byte(`{"raw":"http://localhost:7789/uploads/1/unused.jpg"}`)))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces" WHERE id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "logo_id"}).
+			AddRow(1, "Factly", logoID))
+
+	for _, table := range usageTables {
+		result, found := rows[table]
+		if !found {
+			result = sqlmock.NewRows(usageColumns)
+		}
+		mock.ExpectQuery(`SELECT id, (.+) FROM "` + table + `" WHERE space_id = \$1`).
+			WithArgs(1).
+			WillReturnRows(result)
+	}
+}
+
+// usageLookupMock mocks the lookup of the usages of the medium with the
+// space using it as its logo and the rows of the tables
+func usageLookupMock(mock sqlmock.Sqlmock, space *sqlmock.Rows, rows map[string]*sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces" WHERE id = $1 AND (logo_id = $2 OR logo_mobile_id = $3 OR fav_icon_id = $4 OR mobile_icon_id = $5)`)).
+		WithArgs(1, 1, 1, 1, 1).
+		WillReturnRows(space)
+
+	for _, table := range usageTables {
+		result, found := rows[table]
+		if !found {
+			result = sqlmock.NewRows(usageColumns)
+		}
+		condition := `\(medium_id = \$2 OR description @> \$3`
+		if table == "posts" {
+			condition = `\(featured_medium_id = \$2 OR description @> \$3`
+		} else if table == "formats" {
+			condition = `medium_id = \$2 AND`
+		}
+		mock.ExpectQuery(`SELECT id, (.+) FROM "` + table + `" WHERE space_id = \$1 AND ` + condition).
+			WillReturnRows(result)
+	}
+}
+
+func usedMediumLookupMock(mock sqlmock.Sqlmock) {
+	usageLookupMock(mock, sqlmock.NewRows([]string{"id", "name", "logo_id"}).AddRow(1, "Factly", 1), map[string]*sqlmock.Rows{
+		"posts": sqlmock.NewRows(usageColumns).
+			AddRow(1, "Featured", 1, nil, false).
+			AddRow(2, "About", nil, []byte(`{"blocks":[{"type":"uppy","data":{"nodes":[{"id":1},{"id":3}]}}]}`), true),
+		"tags": sqlmock.NewRows(usageColumns).
+			AddRow(4, "Elections", nil, []byte(`{"blocks":[{"type":"paragraph","data":{"text":"photo"}},{"type":"image","data":{"file":{"url":"http://localhost:7789/uploads/1/photo.webp"}}}]}`), false).
+			AddRow(5, "Results", nil, []byte(`{"blocks":[{"type":"uppy","data":{"id":3,"url":{"raw":"http://localhost:7789/uploads/1/photo.jpg"}}}]}`), false),
+	})
+}
+
+func usedMediumMock(mock sqlmock.Sqlmock) {
+	usageScanMock(mock, 1, map[string]*sqlmock.Rows{
+		"posts": sqlmock.NewRows(usageColumns).
+			AddRow(1, "Featured", 1, nil, false).
+			AddRow(2, "About", nil, []byte(`{"blocks":[{"type":"uppy","data":{"nodes":[{"id":1},{"id":3}]}}]}`), true),
+		"tags": sqlmock.NewRows(usageColumns).
+			AddRow(4, "Elections", nil, []byte(`{"blocks":[{"type":"paragraph","data":{"text":"photo"}},{"type":"image","data":{"file":{"url":"http://localhost:7789/uploads/1/photo.webp"}}}]}`), false),
+	})
+}
+
+var mediumUsages = []map[string]interface{}{
+	{"kind": "space", "id": 1, "title": "Factly", "field": "logo_id"},
+	{"kind": "post", "id": 1, "title": "Featured", "field": "featured_medium_id"},
+	{"kind": "page", "id": 2, "title": "About", "field": "description"},
+	{"kind": "tag", "id": 4, "title": "Elections", "field": "description"},
+}
+
+func TestMediumUsages(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid medium id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(usagePath).
+			WithPath("medium_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("medium not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+			WithArgs(1, 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.GET(usagePath).
+			WithPath("medium_id", 100).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get usages of medium", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mediumSelectMock(mock)
+		usedMediumLookupMock(mock)
+
+		e.GET(usagePath).
+			WithPath("medium_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"total": 4,
+				"nodes": mediumUsages,
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("medium in use is not deleted", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mediumSelectMock(mock)
+		usedMediumLookupMock(mock)
+
+		e.DELETE(path).
+			WithPath("medium_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity).
+			JSON().
+			Object().
+			Value("errors").
+			Array().
+			Element(0).
+			Object().
+			Value("message").
+			Equal("medium is associated with some space, post, page, tag")
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("force delete medium in use", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mediumSelectMock(mock)
+		usedMediumLookupMock(mock)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "media" SET "deleted_at"=`)).
+			WithArgs(test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		e.DELETE(path).
+			WithPath("medium_id", 1).
+			WithQuery("force", true).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"total": 4,
+				"nodes": mediumUsages,
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("delete unused medium", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mediumSelectMock(mock)
+		usageLookupMock(mock, sqlmock.NewRows([]string{"id", "name", "logo_id"}), map[string]*sqlmock.Rows{})
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "media" SET "deleted_at"=`)).
+			WithArgs(test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		e.DELETE(path).
+			WithPath("medium_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get unused media", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		usedMediumMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(file_size), 0) FROM "media" WHERE "media"."space_id" = $1 AND id NOT IN ($2,$3)`)).
+			WithArgs(1, 1, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2048))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "media" WHERE "media"."space_id" = $1 AND id NOT IN ($2,$3)`)).
+			WithArgs(1, 1, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media" WHERE "media"."space_id" = $1 AND id NOT IN ($2,$3)`)).
+			WithArgs(1, 1, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "name", "slug", "file_size", "space_id"}).
+				AddRow(2, time.Now(), "unused", "unused", 2048, 1))
+
+		e.GET(unusedPath).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"total":           1,
+				"total_file_size": 2048,
+			}).
+			Value("nodes").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"id":   2,
+				"name": "unused",
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+}
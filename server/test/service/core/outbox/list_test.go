@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/util"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestOutboxList(t *testing.T) {
+	mock := test.SetupMockDB()
+	// outbox routes are only mounted when nats is enabled
+	viper.Set("enable_hukz", true)
+	defer viper.Set("enable_hukz", false)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get empty outbox", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.GET(basePath).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 0})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get stuck events", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(util.OutboxPending).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(util.OutboxPending).
+			WillReturnRows(stuckRow())
+
+		e.GET(basePath).
+			WithQuery("stuck", "true").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 1}).
+			Value("nodes").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{"subject": "tag.created", "attempts": 3})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("replay invalid event id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(replayPath).
+			WithPath("event_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("replay event not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.POST(replayPath).
+			WithPath("event_id", "100").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/util"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var columns = []string{"id", "created_at", "updated_at", "subject", "payload", "status", "attempts", "last_error", "published_at"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "outbox_events"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "outbox_events"`)
+
+var basePath = "/core/outbox"
+var replayPath = "/core/outbox/{event_id}/replay"
+
+func stuckRow() *sqlmock.Rows {
+	return sqlmock.NewRows(columns).
+		AddRow(1, time.Now(), time.Now(), "tag.created", []byte(`{"id":1}`), util.OutboxPending, 3, "nats: connection closed", nil)
+}
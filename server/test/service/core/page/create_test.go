@@ -3,7 +3,9 @@ package page
 import (
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/factly/dega-server/service"
@@ -68,6 +70,63 @@ func TestPageCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("cannot schedule page without future published date", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithJSON(scheduledPage(time.Now().Add(-time.Hour))).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("schedule page", func(t *testing.T) {
+		publishedDate := time.Now().Add(time.Hour)
+		test.CheckSpaceMock(mock)
+
+		slugCheckMock(mock, Data)
+		mock.ExpectBegin()
+		format.SelectMock(mock, 1, 1)
+		mock.ExpectQuery(`INSERT INTO "posts"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, Data["title"], "", Data["slug"], "scheduled", true, "", sqlmock.AnyArg(), "", false, false, false, Data["format_id"], publishedAt(publishedDate), 1, sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"workflow_stage_id", "translation_group_id", "id", "featured_medium_id"}).AddRow(1, 1, 1, 1))
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "status", "is_page", "published_date", "space_id"}).
+				AddRow(1, Data["title"], Data["slug"], "scheduled", true, publishedDate, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "category_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		format.SelectMock(mock, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "tag_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		pageAuthorInsertMock(mock)
+		mock.ExpectQuery(`INSERT INTO "post_revisions"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		e.POST(basePath).
+			WithJSON(scheduledPage(publishedDate)).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"status":         "scheduled",
+				"published_date": publishedDate.UTC().Format(time.RFC3339Nano),
+			})
+		test.ExpectationsMet(t, mock)
+	})
+
 	t.Run("create page", func(t *testing.T) {
 		test.CheckSpaceMock(mock)
 
@@ -121,5 +180,17 @@ func TestPageCreate(t *testing.T) {
 			Object().ContainsMap(pageData)
 		test.ExpectationsMet(t, mock)
 	})
+}
 
+// scheduledPage returns a page without media, tags and categories scheduled
+// to be published at the date
+func scheduledPage(publishedDate time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"title":          Data["title"],
+		"slug":           Data["slug"],
+		"status":         "scheduled",
+		"published_date": publishedDate,
+		"format_id":      Data["format_id"],
+		"author_ids":     []uint{1},
+	}
 }
@@ -106,6 +106,14 @@ var selectQuery = `SELECT (.+) FROM "posts"`
 var basePath = "/core/pages"
 var path = "/core/pages/{page_id}"
 
+// publishedAt matches the published date of a page
+type publishedAt time.Time
+
+func (a publishedAt) Match(v driver.Value) bool {
+	date, ok := v.(time.Time)
+	return ok && date.Equal(time.Time(a))
+}
+
 func slugCheckMock(mock sqlmock.Sqlmock, post map[string]interface{}) {
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "posts"`)).
 		WithArgs(fmt.Sprint(post["slug"], "%"), 1).
@@ -18,6 +18,7 @@ import (
 	"github.com/factly/dega-server/test/service/core/tag"
 	"github.com/gavv/httpexpect"
 	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/spf13/viper"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -82,6 +83,29 @@ func TestPageUpdate(t *testing.T) {
 		test.ExpectationsMet(t, mock)
 	})
 
+	t.Run("user without permission to publish cannot schedule page", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+		SelectMock(mock, true, 1, 1)
+
+		e.PUT(path).
+			WithPath("page_id", "1").
+			WithHeaders(headers).
+			WithJSON(scheduledPage(time.Now().Add(time.Hour))).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		test.MockServer()
+	})
+
 	t.Run("cannot parse page description", func(t *testing.T) {
 		test.CheckSpaceMock(mock)
 		SelectMock(mock, true, 1, 1)
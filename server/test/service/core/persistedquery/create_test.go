@@ -0,0 +1,79 @@
+package persistedquery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPersistedQueryCreate(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("Unprocessable persisted query", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithJSON(invalidData).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("Unable to decode persisted query", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("create persisted query", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		sameHashCount(mock, 0)
+		insertMock(mock)
+		mock.ExpectCommit()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"name": Data["name"], "hash": hash(), "organisation_id": 1})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("persisted query with same hash exist", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		sameHashCount(mock, 1)
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+}
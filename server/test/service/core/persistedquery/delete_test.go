@@ -0,0 +1,74 @@
+package persistedquery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPersistedQueryDelete(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid persisted query id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.DELETE(path).
+			WithPath("query_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("persisted query record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1, 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.DELETE(path).
+			WithPath("query_id", "100").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("delete persisted query", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, 1)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "persisted_queries" SET "deleted_at"=`)).
+			WithArgs(test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		e.DELETE(path).
+			WithPath("query_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,59 @@
+package persistedquery
+
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/test"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"name":  "Home page",
+	"query": "{ posts { nodes { id title } } }",
+}
+
+var invalidData = map[string]interface{}{
+	"name": "Ho",
+}
+
+var columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "name", "hash", "query", "organisation_id"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "persisted_queries"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "persisted_queries"`)
+
+var basePath = "/core/persisted-queries"
+var path = "/core/persisted-queries/{query_id}"
+
+func hash() string {
+	sum := sha256.Sum256([]byte(Data["query"].(string)))
+	return hex.EncodeToString(sum[:])
+}
+
+func SelectQuery(mock sqlmock.Sqlmock, args ...driver.Value) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["name"], hash(), Data["query"], 1))
+}
+
+func sameHashCount(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectQuery(countQuery).
+		WithArgs(hash(), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func insertMock(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "persisted_queries"`).
+		WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, Data["name"], hash(), Data["query"], 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+}
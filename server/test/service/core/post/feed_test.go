@@ -0,0 +1,171 @@
+package post
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var feedPath = "/spaces/{space_id}/posts/feed"
+var feedFormatPath = "/spaces/{space_id}/posts/feeds/{format}"
+
+var feedPublishedDate = time.Date(2021, 4, 2, 0, 0, 0, 0, time.UTC)
+
+// postFeedMock mocks a fact-check in the feed of the posts of the space,
+// along with its category, tag, featured image, author and claim
+func postFeedMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "site_address", "organisation_id"}).
+			AddRow(1, "Test Space", "test-space", "https://testaddress.com", 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "title", "slug", "status", "excerpt", "html_description", "published_date", "featured_medium_id", "format_id", "space_id"}).
+			AddRow(1, time.Now(), feedPublishedDate, "Fact check", "fact-check", "publish", "post excerpt", "<p>Test Description</p>", feedPublishedDate, 1, 1, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_categories"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "category_id"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Health", "health", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Fact Check", "fact-check", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "type", "file_size", "url", "space_id"}).
+			AddRow(1, "Image", "image", "image/png", 100, []byte(`{"raw":"https://testaddress.com/image.png"}`), 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "tag_id"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Vaccines", "vaccines", 1))
+
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_authors"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "author_id"}).AddRow(1, 1, 1))
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_claims"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "claim_id", "position"}).AddRow(1, 1, 1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "claim", "slug", "claimant_id", "rating_id", "space_id"}).
+			AddRow(1, "Claim", "claim", 1, 1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claimants"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Claimant", "claimant", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ratings"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "numeric_value", "space_id"}).AddRow(1, "False", "false", 1, 1))
+}
+
+func TestPostFeeds(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	testServer := httptest.NewServer(service.RegisterFeedsRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get rss feed", func(t *testing.T) {
+		postFeedMock(mock)
+
+		res := e.GET(feedFormatPath).
+			WithPath("space_id", "1").
+			WithPath("format", "rss2").
+			Expect().
+			Status(http.StatusOK)
+
+		res.ContentType("application/rss+xml")
+		body := res.Body()
+		body.Contains(`<link>https://testaddress.com/fact-check</link>`)
+		body.Contains(`<content:encoded><![CDATA[<p>Test Description</p>]]></content:encoded>`)
+		body.Contains(`<dc:creator>abc cba</dc:creator>`)
+		body.Contains(`<category>Health</category>`)
+		body.Contains(`<category>Vaccines</category>`)
+		body.Contains(`<enclosure url="https://testaddress.com/image.png" length="100" type="image/png"></enclosure>`)
+		body.Contains(`<schema:alternateName>False</schema:alternateName>`)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get atom feed", func(t *testing.T) {
+		postFeedMock(mock)
+
+		res := e.GET(feedFormatPath).
+			WithPath("space_id", "1").
+			WithPath("format", "atom").
+			Expect().
+			Status(http.StatusOK)
+
+		res.ContentType("application/atom+xml")
+		body := res.Body()
+		body.Contains(`<feed xmlns="http://www.w3.org/2005/Atom"`)
+		body.Contains(`<id>https://testaddress.com/fact-check</id>`)
+		body.Contains(`<name>abc cba</name>`)
+		body.Contains(`<category term="Vaccines"></category>`)
+		body.Contains(`<content type="html">&lt;p&gt;Test Description&lt;/p&gt;</content>`)
+		body.Contains(`<schema:claimReviewed>Claim</schema:claimReviewed>`)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get json feed", func(t *testing.T) {
+		postFeedMock(mock)
+
+		res := e.GET(feedFormatPath).
+			WithPath("space_id", "1").
+			WithPath("format", "json").
+			Expect().
+			Status(http.StatusOK)
+
+		feed := res.JSON(httpexpect.ContentOpts{MediaType: "application/feed+json"}).Object()
+		feed.Value("version").Equal("https://jsonfeed.org/version/1.1")
+
+		item := feed.Value("items").Array().Element(0).Object()
+		item.ContainsMap(map[string]interface{}{
+			"id":           "1",
+			"url":          "https://testaddress.com/fact-check",
+			"content_html": "<p>Test Description</p>",
+			"summary":      "post excerpt",
+			"image":        "https://testaddress.com/image.png",
+			"tags":         []string{"Health", "Vaccines"},
+		})
+		item.Value("authors").Array().Element(0).Object().Value("name").Equal("abc cba")
+		item.Value("_fact_check").Object().Value("claim_reviews").Array().Element(0).Object().ContainsMap(map[string]interface{}{
+			"claim_reviewed": "Claim",
+			"claimant":       "Claimant",
+			"rating": map[string]interface{}{
+				"name":  "False",
+				"value": 1,
+			},
+		})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("negotiate the format of the feed", func(t *testing.T) {
+		postFeedMock(mock)
+
+		e.GET(feedPath).
+			WithPath("space_id", "1").
+			WithHeader("Accept", "application/atom+xml;q=0.9, */*;q=0.8").
+			Expect().
+			Status(http.StatusOK).
+			ContentType("application/atom+xml")
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("rss feed when no format is accepted", func(t *testing.T) {
+		postFeedMock(mock)
+
+		e.GET(feedPath).
+			WithPath("space_id", "1").
+			Expect().
+			Status(http.StatusOK).
+			ContentType("application/rss+xml")
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,70 @@
+package revision
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRevisionDetails(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid revision id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(path).
+			WithPathObject(map[string]interface{}{"post_id": "1", "revision_id": "invalid_id"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("revision record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1, 1, false, 1).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(path).
+			WithPathObject(map[string]interface{}{"post_id": "1", "revision_id": "1"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get revision by id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, Data)
+
+		e.GET(path).
+			WithPathObject(map[string]interface{}{"post_id": "1", "revision_id": "1"}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"title": Data["title"], "post_id": 1})
+		test.ExpectationsMet(t, mock)
+	})
+}
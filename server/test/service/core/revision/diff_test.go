@@ -0,0 +1,79 @@
+package revision
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRevisionDiff(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("missing revision ids", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(diffPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("revision to compare not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, Data)
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(diffPath).
+			WithPath("post_id", "1").
+			WithQueryObject(map[string]interface{}{"from": 1, "to": 2}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("diff between two revisions", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, Data)
+		SelectQuery(mock, 2, updatedData)
+
+		changes := e.GET(diffPath).
+			WithPath("post_id", "1").
+			WithQueryObject(map[string]interface{}{"from": 1, "to": 2}).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("changes").
+			Array()
+
+		changes.Length().Equal(3)
+		changes.Element(0).Object().ContainsMap(map[string]interface{}{"field": "title", "from": Data["title"], "to": updatedData["title"]})
+		changes.Element(1).Object().ContainsMap(map[string]interface{}{"field": "tag_ids", "added": []uint{2}})
+		changes.Element(2).Object().ContainsMap(map[string]interface{}{"field": "claim_order"})
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,107 @@
+package revision
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRevisionList(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid post id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(basePath).
+			WithPath("post_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get empty list of revisions", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(1, 1, false).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(basePath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 0})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get list of revisions", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(1, 1, false).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(Columns).
+				AddRow(2, time.Now(), time.Now(), nil, 1, 1, 1, 1, false, updatedData["title"], updatedData["excerpt"], updatedData["description"], updatedData["tag_ids"], updatedData["category_ids"], updatedData["author_ids"], updatedData["claim_order"]).
+				AddRow(1, time.Now(), time.Now(), nil, 1, 1, 1, 1, false, Data["title"], Data["excerpt"], Data["description"], Data["tag_ids"], Data["category_ids"], Data["author_ids"], Data["claim_order"]))
+
+		e.GET(basePath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 2}).
+			Value("nodes").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{"title": updatedData["title"]})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get list of page revisions", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(countQuery).
+			WithArgs(1, 1, true).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		mock.ExpectQuery(selectQuery).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(pageBasePath).
+			WithPath("page_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK)
+		test.ExpectationsMet(t, mock)
+	})
+}
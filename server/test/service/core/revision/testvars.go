@@ -0,0 +1,54 @@
+package revision
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"title":   "Post",
+	"excerpt": "post excerpt",
+	"description": postgres.Jsonb{
+		RawMessage: []byte(`{"time":1617039625490,"blocks":[{"type":"paragraph","data":{"text":"Test Description"}}],"version":"2.19.0"}`),
+	},
+	"tag_ids":      postgres.Jsonb{RawMessage: []byte(`[1]`)},
+	"category_ids": postgres.Jsonb{RawMessage: []byte(`[1]`)},
+	"author_ids":   postgres.Jsonb{RawMessage: []byte(`[1]`)},
+	"claim_order":  postgres.Jsonb{RawMessage: []byte(`[1,2]`)},
+}
+
+var updatedData = map[string]interface{}{
+	"title":   "Post updated",
+	"excerpt": "post excerpt",
+	"description": postgres.Jsonb{
+		RawMessage: []byte(`{"time":1617039625490,"blocks":[{"type":"paragraph","data":{"text":"Test Description"}}],"version":"2.19.0"}`),
+	},
+	"tag_ids":      postgres.Jsonb{RawMessage: []byte(`[1,2]`)},
+	"category_ids": postgres.Jsonb{RawMessage: []byte(`[1]`)},
+	"author_ids":   postgres.Jsonb{RawMessage: []byte(`[1]`)},
+	"claim_order":  postgres.Jsonb{RawMessage: []byte(`[2,1]`)},
+}
+
+var Columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "post_id", "space_id", "is_page", "title", "excerpt", "description", "tag_ids", "category_ids", "author_ids", "claim_order"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "post_revisions"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "post_revisions"`)
+
+var basePath = "/core/posts/{post_id}/revisions"
+var path = "/core/posts/{post_id}/revisions/{revision_id}"
+var diffPath = "/core/posts/{post_id}/revisions/diff"
+var pageBasePath = "/core/pages/{page_id}/revisions"
+
+func SelectQuery(mock sqlmock.Sqlmock, id int, data map[string]interface{}) {
+	mock.ExpectQuery(selectQuery).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(id, time.Now(), time.Now(), nil, 1, 1, 1, 1, false, data["title"], data["excerpt"], data["description"], data["tag_ids"], data["category_ids"], data["author_ids"], data["claim_order"]))
+}
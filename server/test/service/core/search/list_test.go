@@ -1,13 +1,17 @@
 package search
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/factly/dega-server/service"
 	"github.com/factly/dega-server/test"
 	"github.com/gavv/httpexpect"
+	"github.com/spf13/viper"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -82,3 +86,69 @@ func TestPostList(t *testing.T) {
 		test.ExpectationsMet(t, mock)
 	})
 }
+
+func TestPostgresSearch(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("search_backend", "postgres")
+	defer viper.Set("search_backend", "meilisearch")
+
+	test.KetoGock()
+	test.KavachGock()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("search entities ranked by postgres", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT data FROM "search_documents" WHERE space_id = $1 AND ((CASE jsonb_typeof(data->'kind') WHEN 'array'`)).
+			WillReturnRows(sqlmock.NewRows([]string{"data"}).
+				AddRow(`{"id":1,"kind":"category","name":"Test category","object_id":"category_1","space_id":1}`))
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{"object_id": "category_1", "name": "Test category"})
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{
+				"q":       "test",
+				"filters": "kind=category AND (",
+			}).
+			Expect().
+			Status(http.StatusInternalServerError)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("search query fails", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT data FROM "search_documents"`)).
+			WillReturnError(errors.New("cannot query search documents"))
+
+		e.POST(path).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusInternalServerError)
+
+		test.ExpectationsMet(t, mock)
+	})
+}
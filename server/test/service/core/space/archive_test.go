@@ -0,0 +1,90 @@
+package space
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/space"
+	"github.com/factly/dega-server/service/core/model"
+)
+
+func testArchive() *space.Archive {
+	archive := &space.Archive{
+		Version:    space.ArchiveVersion,
+		ExportedAt: time.Now().UTC(),
+		Space:      model.Space{Name: "Factly", Slug: "factly"},
+	}
+	archive.Space.ID = 1
+	groupID := uint(3)
+	archive.TranslationGroups = []model.TranslationGroup{{Base: config.Base{ID: 3}, SpaceID: 1}}
+	archive.Tags = []model.Tag{{Base: config.Base{ID: 4}, Name: "Elections", Slug: "elections", TranslationGroupID: &groupID, SpaceID: 1}}
+	archive.Posts = []model.Post{
+		{Base: config.Base{ID: 7}, Title: "Post", Slug: "post", SpaceID: 1},
+		{Base: config.Base{ID: 8}, Title: "Page", Slug: "page", IsPage: true, SpaceID: 1},
+	}
+	archive.PostTags = []space.PostTag{{PostID: 7, TagID: 4}}
+	archive.PostAuthors = []model.PostAuthor{{Base: config.Base{ID: 2}, AuthorID: 1, PostID: 7}}
+	return archive
+}
+
+func TestArchive(t *testing.T) {
+	for _, format := range []string{space.FormatJSON, space.FormatNDJSON} {
+		t.Run("round trip "+format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := testArchive().Write(&buf, format); err != nil {
+				t.Fatal(err)
+			}
+
+			if format == space.FormatNDJSON {
+				// header, space, translation group, tag, two posts, post tag and post author
+				if lines := strings.Count(buf.String(), "\n"); lines != 8 {
+					t.Errorf("expected 8 records, got %d", lines)
+				}
+			}
+
+			archive, err := space.ReadArchive(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if archive.Space.Name != "Factly" || len(archive.Tags) != 1 || len(archive.Posts) != 2 {
+				t.Errorf("archive not read back: %+v", archive)
+			}
+			if len(archive.TranslationGroups) != 1 || archive.Tags[0].TranslationGroupID == nil || *archive.Tags[0].TranslationGroupID != 3 {
+				t.Errorf("expected tag in translation group 3, got %+v", archive.Tags[0])
+			}
+			if archive.Posts[1].ID != 8 || !archive.Posts[1].IsPage {
+				t.Errorf("expected page 8, got %+v", archive.Posts[1])
+			}
+			if len(archive.PostTags) != 1 || archive.PostTags[0] != (space.PostTag{PostID: 7, TagID: 4}) {
+				t.Errorf("unexpected post tags %+v", archive.PostTags)
+			}
+			if len(archive.PostAuthors) != 1 || archive.PostAuthors[0].AuthorID != 1 {
+				t.Errorf("unexpected post authors %+v", archive.PostAuthors)
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := testArchive().Write(&buf, "xml"); err == nil {
+			t.Error("expected unknown format to fail")
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		if _, err := space.ReadArchive(strings.NewReader(`{"version":99,"space":{"name":"Factly"}}`)); err == nil {
+			t.Error("expected unsupported version to fail")
+		}
+	})
+
+	t.Run("unknown record", func(t *testing.T) {
+		if _, err := space.ReadArchive(strings.NewReader(`{"type":"archive","data":{"version":1}}
+{"type":"widgets","data":{}}`)); err == nil {
+			t.Error("expected unknown record to fail")
+		}
+	})
+}
@@ -136,6 +136,30 @@ func TestSpaceCreate(t *testing.T) {
 			Status(http.StatusUnprocessableEntity)
 	})
 
+	t.Run("invalid permalinks", func(t *testing.T) {
+		for _, permalinks := range []map[string]interface{}{
+			{"post": "/{format}/{yyyy}/{title}"},
+			{"tag": "/tag/{locale}"},
+			{"episode": "podcasts/{podcast}/{slug}"},
+		} {
+			e.POST(basePath).
+				WithHeader("X-User", "1").
+				WithJSON(withPermalinks(permalinks)).
+				Expect().
+				Status(http.StatusUnprocessableEntity)
+		}
+	})
+
+	t.Run("invalid theme name", func(t *testing.T) {
+		for _, theme := range []string{"Default", "my theme", "../default", "-dark"} {
+			e.POST(basePath).
+				WithHeader("X-User", "1").
+				WithJSON(withTheme(theme)).
+				Expect().
+				Status(http.StatusUnprocessableEntity)
+		}
+	})
+
 	t.Run("unable to decode space body", func(t *testing.T) {
 		e.POST(basePath).
 			WithHeader("X-User", "1").
@@ -0,0 +1,118 @@
+package space
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var exportPath = "/core/spaces/{space_id}/export"
+
+// exportMock expects the queries of exporting a space which owns nothing
+func exportMock(mock sqlmock.Sqlmock) {
+	SelectQuery(mock, 1)
+	SelectQuery(mock, 1)
+
+	for _, table := range []string{"translation_groups", "media", "formats", "categories", "tags", "menus", "workflow_stages", "ratings", "claimants", "videos", "claims", "posts", "podcasts", "episodes"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "` + table + `"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	for _, table := range []string{"post_tags", "post_categories", "post_authors", "post_claims", "video_tags", "video_categories", "video_authors", "podcast_categories", "episode_authors"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "` + table + `"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+}
+
+func TestSpaceExport(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid space id", func(t *testing.T) {
+		e.GET(exportPath).
+			WithPath("space_id", "invalid_id").
+			WithHeader("X-User", "1").
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		e.GET(exportPath).
+			WithPath("space_id", "1").
+			WithQuery("format", "xml").
+			WithHeader("X-User", "1").
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("space record not found", func(t *testing.T) {
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(exportPath).
+			WithPath("space_id", "1").
+			WithHeader("X-User", "1").
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("export space as json", func(t *testing.T) {
+		exportMock(mock)
+
+		e.GET(exportPath).
+			WithPath("space_id", "1").
+			WithHeader("X-User", "1").
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"version": 1}).
+			Value("space").
+			Object().
+			ContainsMap(map[string]interface{}{"name": Data["name"], "slug": Data["slug"]})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("export space as ndjson", func(t *testing.T) {
+		exportMock(mock)
+
+		body := e.GET(exportPath).
+			WithPath("space_id", "1").
+			WithQuery("format", "ndjson").
+			WithHeader("X-User", "1").
+			Expect().
+			Status(http.StatusOK).
+			ContentType("application/x-ndjson").
+			Body().
+			Raw()
+
+		lines := strings.Split(strings.TrimSpace(body), "\n")
+		if len(lines) != 2 || !strings.Contains(lines[0], `"type":"archive"`) || !strings.Contains(lines[1], `"type":"space"`) {
+			t.Errorf("unexpected archive %s", body)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,67 @@
+package space
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/action/space"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/test"
+	"github.com/spf13/viper"
+)
+
+func TestSpaceImport(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("create_super_organisation", false)
+	viper.Set("enable_search_indexing", false)
+	defer viper.Set("create_super_organisation", true)
+	defer viper.Set("enable_search_indexing", true)
+
+	t.Run("remap translation groups", func(t *testing.T) {
+		archive := testArchive()
+		archive.Posts, archive.PostTags, archive.PostAuthors = nil, nil, nil
+		// the group of a category left out of the archive is dropped
+		missingID := uint(9)
+		archive.Categories = []model.Category{{Base: config.Base{ID: 5}, Name: "Politics", Slug: "politics", TranslationGroupID: &missingID, SpaceID: 1}}
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+			WithArgs("factly%").
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		mock.ExpectBegin()
+		// the columns are returned in any order, the logos of the space are
+		// checked against its media when they are set
+		mock.ExpectQuery(`INSERT INTO "spaces"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "logo_id", "logo_mobile_id", "fav_icon_id", "mobile_icon_id"}).AddRow(2, 2, 2, 2, 2))
+		mock.ExpectQuery(`INSERT INTO "translation_groups"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+		for i := 0; i < 4; i++ {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+				WithArgs(2, 2).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "space_id"}).AddRow(2, 2))
+		}
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "spaces"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		// without a group the column is left out of the insert
+		mock.ExpectQuery(`INSERT INTO "categories"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, "Politics", "politics", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), false, 2, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id", "medium_id", "translation_group_id"}).AddRow(12, 12, 12, 12))
+		// the tag joins the group created for the space
+		mock.ExpectQuery(`INSERT INTO "tags"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, "Elections", "elections", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), false, sqlmock.AnyArg(), 2, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "medium_id", "translation_group_id"}).AddRow(13, 13, 13))
+		mock.ExpectCommit()
+
+		result, err := space.Import(archive, 1, 1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.ID != 2 {
+			t.Errorf("expected space 2, got %d", result.ID)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -52,6 +52,25 @@ var resData map[string]interface{} = map[string]interface{}{
 	"organisation_id":    1,
 }
 
+// withPermalinks returns the space data with the permalink patterns
+func withPermalinks(permalinks map[string]interface{}) map[string]interface{} {
+	space := map[string]interface{}{}
+	for key, value := range Data {
+		space[key] = value
+	}
+	space["permalinks"] = permalinks
+	return space
+}
+
+func withTheme(theme string) map[string]interface{} {
+	space := map[string]interface{}{}
+	for key, value := range Data {
+		space[key] = value
+	}
+	space["theme"] = theme
+	return space
+}
+
 var invalidData map[string]interface{} = map[string]interface{}{
 	"nam":             "Te",
 	"slug":            "test-space",
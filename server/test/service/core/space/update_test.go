@@ -63,6 +63,15 @@ func TestSpaceUpdate(t *testing.T) {
 			Status(http.StatusUnprocessableEntity)
 	})
 
+	t.Run("invalid theme name", func(t *testing.T) {
+		e.PUT(path).
+			WithPath("space_id", "1").
+			WithHeader("X-User", "1").
+			WithJSON(withTheme("../default")).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+	})
+
 	t.Run("undecodable space body", func(t *testing.T) {
 		e.PUT(path).
 			WithPath("space_id", "1").
@@ -0,0 +1,60 @@
+package translation
+
+import (
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"name": "चुनाव",
+	"slug": "chunav",
+	"background_colour": postgres.Jsonb{
+		RawMessage: []byte(`"#ff0000"`),
+	},
+	"locale":         "hi",
+	"translation_of": 2,
+}
+
+var basePath = "/core/tags"
+
+// tagCreateMock mocks the checks before a tag is created
+func tagCreateMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}))
+	mock.ExpectBegin()
+}
+
+// spaceLocalesMock mocks the locales of the space, en by default and hi
+func spaceLocalesMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, default_locale, supported_locales FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "default_locale", "supported_locales"}).
+			AddRow(1, "en", []byte(`["en","hi"]`)))
+}
+
+// sourceMock mocks the lookup of the tag 2 being translated
+func sourceMock(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, locale, translation_group_id FROM "tags"`)).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+}
+
+func sourceRows(locale string, groupID interface{}) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "locale", "translation_group_id"}).AddRow(2, locale, groupID)
+}
+
+// groupLocaleCount mocks the count of the translations in the locale
+func groupLocaleCount(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "tags" WHERE translation_group_id = $1 AND locale = $2 AND id <> $3`)).
+		WithArgs(1, "hi", 0).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
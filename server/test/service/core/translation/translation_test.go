@@ -0,0 +1,161 @@
+package translation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestTranslation(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("create translation of a tag without translation group", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		sourceMock(mock, sourceRows("", nil))
+
+		mock.ExpectQuery(`INSERT INTO "translation_groups"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET "locale"=$1,"translation_group_id"=$2 WHERE id = $3`)).
+			WithArgs("en", 1, 2).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		groupLocaleCount(mock, 0)
+
+		mock.ExpectQuery(`INSERT INTO "tags"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "medium_id", "translation_group_id"}).AddRow(3, 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "locale", "translation_group_id", "space_id"}).
+				AddRow(3, Data["name"], Data["slug"], "hi", 1, 1))
+		mock.ExpectCommit()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"locale":               "hi",
+				"translation_group_id": 1,
+			})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("create translation of a tag in a translation group", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		sourceMock(mock, sourceRows("en", 1))
+		groupLocaleCount(mock, 0)
+
+		mock.ExpectQuery(`INSERT INTO "tags"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "medium_id", "translation_group_id"}).AddRow(3, 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "locale", "translation_group_id", "space_id"}).
+				AddRow(3, Data["name"], Data["slug"], "hi", 1, 1))
+		mock.ExpectCommit()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"locale":               "hi",
+				"translation_group_id": 1,
+			})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("translation in the locale exists", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		sourceMock(mock, sourceRows("en", 1))
+		groupLocaleCount(mock, 1)
+		mock.ExpectRollback()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("translated tag not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		sourceMock(mock, sqlmock.NewRows([]string{"id", "locale", "translation_group_id"}))
+		mock.ExpectRollback()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("locale not supported by the space", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		mock.ExpectRollback()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{
+				"name":              Data["name"],
+				"slug":              Data["slug"],
+				"background_colour": Data["background_colour"],
+				"locale":            "ta",
+			}).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		tagCreateMock(mock)
+		spaceLocalesMock(mock)
+		mock.ExpectRollback()
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{
+				"name":              Data["name"],
+				"slug":              Data["slug"],
+				"background_colour": Data["background_colour"],
+				"locale":            "not a locale",
+			}).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+}
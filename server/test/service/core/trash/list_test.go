@@ -0,0 +1,110 @@
+package trash
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestTrashList(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("entity without trash", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(basePath).
+			WithPath("entity", "spaces").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get deleted posts", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts" WHERE deleted_at IS NOT NULL AND space_id = $1 AND is_page = $2`)).
+			WithArgs(1, false).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title AS title, slug, space_id, deleted_at FROM "posts" WHERE deleted_at IS NOT NULL AND space_id = $1 AND is_page = $2 ORDER BY deleted_at desc`)).
+			WithArgs(1, false).
+			WillReturnRows(deletedPostRows())
+
+		e.GET(basePath).
+			WithPath("entity", "posts").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 1}).
+			Value("nodes").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"id":         1,
+				"title":      "Post",
+				"slug":       "post",
+				"deleted_at": "2021-04-01T10:00:00Z",
+			}).
+			NotContainsKey("purge_at")
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get deleted tags with their purge date", func(t *testing.T) {
+		viper.Set("trash_retention_days", 30)
+		defer viper.Set("trash_retention_days", nil)
+
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "tags" WHERE deleted_at IS NOT NULL AND space_id = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name AS title, slug, space_id, deleted_at FROM "tags" WHERE deleted_at IS NOT NULL AND space_id = $1 ORDER BY deleted_at desc`)).
+			WithArgs(1).
+			WillReturnRows(deletedTagRows())
+
+		e.GET(basePath).
+			WithPath("entity", "tags").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("nodes").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"id":       1,
+				"title":    "Elections",
+				"purge_at": "2021-05-01T10:00:00Z",
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,136 @@
+package trash
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestTrashPurge(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("entity not in trash", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "tags", "name", sqlmock.NewRows(columns))
+
+		e.DELETE(path).
+			WithPath("entity", "tags").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("purge tag", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "tags", "name", deletedTagRows())
+		purgeTagMock(mock)
+
+		e.DELETE(path).
+			WithPath("entity", "tags").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("purge claimant which claims refer to", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "claimants", "name", sqlmock.NewRows(columns).AddRow(1, "Claimant", "claimant", 1, deletedAt))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claimants" WHERE "claimants"."id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Claimant", "claimant", 1))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claims" WHERE claimant_id = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectRollback()
+
+		e.DELETE(path).
+			WithPath("entity", "claimants").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("purge medium with its files", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "dega-uploads")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		viper.Set("storage_local_path", dir)
+		defer viper.Set("storage_local_path", nil)
+
+		_ = os.MkdirAll(filepath.Join(dir, "1"), 0755)
+		for _, name := range []string{"photo.jpg", "photo.webp", "other.jpg"} {
+			_ = ioutil.WriteFile(filepath.Join(dir, "1", name), []byte("image"), 0644)
+		}
+
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "media", "name", sqlmock.NewRows(columns).AddRow(1, "photo", "photo", 1, deletedAt))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media" WHERE "media"."id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "url", "space_id"}).
+				AddRow(1, "photo", "photo", []byte(`{"raw":"http://localhost:7789/uploads/1/photo.jpg","webp":"http://localhost:7789/uploads/1/photo.webp"}`), 1))
+
+		mock.ExpectBegin()
+		for _, table := range []string{"posts", "categories", "tags", "formats", "ratings", "claimants", "claims", "podcasts", "episodes", "spaces", "spaces", "spaces", "spaces"} {
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE ` + table + ` SET`)).
+				WithArgs(1).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "media" WHERE "media"."id" = $1`)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		e.DELETE(path).
+			WithPath("entity", "media").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK)
+
+		test.ExpectationsMet(t, mock)
+
+		for name, kept := range map[string]bool{"photo.jpg": false, "photo.webp": false, "other.jpg": true} {
+			if _, err := os.Stat(filepath.Join(dir, "1", name)); (err == nil) != kept {
+				t.Errorf("expected %s to be kept %v", name, kept)
+			}
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package trash
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestTrashRestore(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(restorePath).
+			WithPath("entity", "tags").
+			WithPath("item_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("entity not in trash", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "tags", "name", sqlmock.NewRows(columns))
+
+		e.POST(restorePath).
+			WithPath("entity", "tags").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("restore tag whose slug was taken", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "tags", "name", deletedTagRows())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "tags"`)).
+			WithArgs("elections%", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}).AddRow("elections", 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET "deleted_at"=$1,"slug"=$2,"updated_at"=$3,"updated_by_id"=$4 WHERE id = $5`)).
+			WithArgs(nil, "elections-1", test.AnyTime{}, 1, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE "tags"."id" = $1 AND "tags"."deleted_at" IS NULL`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Elections", "elections-1", 1))
+		mock.ExpectCommit()
+
+		// reindex the tags of the space
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE space_id IN ($1)`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Elections", "elections-1", 1))
+
+		e.POST(restorePath).
+			WithPath("entity", "tags").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"id":   1,
+				"name": "Elections",
+				"slug": "elections-1",
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("restore post with its authors and claims", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		deletedSelectMock(mock, "posts", "title", deletedPostRows())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "posts"`)).
+			WithArgs("post%", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}))
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "deleted_at"=$1,"slug"=$2,"updated_at"=$3,"updated_by_id"=$4 WHERE id = $5`)).
+			WithArgs(nil, "post", test.AnyTime{}, 1, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "post_authors" SET "deleted_at"=$1 WHERE post_id = $2 AND deleted_at >= $3`)).
+			WithArgs(nil, 1, deletedAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "post_claims" SET "deleted_at"=$1 WHERE post_id = $2 AND deleted_at >= $3`)).
+			WithArgs(nil, 1, deletedAt).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts" WHERE "posts"."id" = $1 AND "posts"."deleted_at" IS NULL`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "space_id"}).AddRow(1, "Post", "post", 1))
+		mock.ExpectCommit()
+
+		// reindex the posts of the space
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "status", "format_id", "space_id"}).AddRow(1, "Post", "post", "draft", 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_categories"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "category_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Article", "article"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_tags"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "tag_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_authors"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "author_id"}).AddRow(1, 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_claims"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "claim_id"}).AddRow(1, 1, 1))
+		mock.ExpectCommit()
+
+		e.POST(restorePath).
+			WithPath("entity", "posts").
+			WithPath("item_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{
+				"id":    1,
+				"title": "Post",
+			})
+
+		test.ExpectationsMet(t, mock)
+	})
+}
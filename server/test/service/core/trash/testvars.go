@@ -0,0 +1,54 @@
+package trash
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var columns = []string{"id", "title", "slug", "space_id", "deleted_at"}
+
+var deletedAt = time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC)
+
+var basePath = "/core/trash/{entity}"
+var restorePath = "/core/trash/{entity}/{item_id}/restore"
+var path = "/core/trash/{entity}/{item_id}"
+
+// deletedSelectMock mocks the lookup of a deleted entity in the trash
+func deletedSelectMock(mock sqlmock.Sqlmock, table, title string, rows *sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, `+title+` AS title, slug, space_id, deleted_at FROM "`+table+`" WHERE deleted_at IS NOT NULL`)).
+		WillReturnRows(rows)
+}
+
+func deletedTagRows() *sqlmock.Rows {
+	return sqlmock.NewRows(columns).AddRow(1, "Elections", "elections", 1, deletedAt)
+}
+
+func deletedPostRows() *sqlmock.Rows {
+	return sqlmock.NewRows(columns).AddRow(1, "Post", "post", 1, deletedAt)
+}
+
+// purgeTagMock mocks purging the deleted tag 1
+func purgeTagMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE "tags"."id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Elections", "elections", 1))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM post_tags WHERE tag_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM video_tags WHERE tag_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "tags" WHERE "tags"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWebhookCreate(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("enable_webhooks", true)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("Unprocessable webhook", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithJSON(invalidData).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("Unable to decode webhook", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "event"}))
+
+		e.POST(basePath).
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+}
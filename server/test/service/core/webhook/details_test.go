@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWebhookDetails(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("enable_webhooks", true)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid webhook id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.GET(path).
+			WithPath("webhook_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("webhook record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1, 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.GET(path).
+			WithPath("webhook_id", "100").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get webhook by id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, 1)
+		eventsPreloadMock(mock)
+
+		e.GET(path).
+			WithPath("webhook_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"name": Data["name"], "url": Data["url"]}).
+			NotContainsKey("secret")
+		test.ExpectationsMet(t, mock)
+	})
+}
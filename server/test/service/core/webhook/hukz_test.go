@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWebhookHukz(t *testing.T) {
+	mock := test.SetupMockDB()
+	// webhooks are proxied to hukz when dega-server does not deliver them itself
+	viper.Set("enable_hukz", true)
+	viper.Set("enable_webhooks", false)
+	viper.Set("hukz_url", "http://hukz:7790")
+	defer viper.Set("enable_hukz", false)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("list webhooks of the space", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		gock.New("http://hukz:7790").
+			Get("/webhooks").
+			MatchParam("tag", "space:1").
+			MatchParam("limit", "5").
+			Reply(http.StatusOK).
+			JSON(map[string]interface{}{"total": 1, "nodes": []interface{}{Data}})
+
+		e.GET(basePath).
+			WithQuery("limit", 5).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 1})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("create webhook with space tags", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		gock.New("http://hukz:7790").
+			Post("/webhooks").
+			BodyString(`"tags":{"app":"dega","space":"1"}`).
+			Reply(http.StatusCreated).
+			JSON(map[string]interface{}{"id": 1, "name": Data["name"]})
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"id": 1, "name": Data["name"]})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("webhook not found in hukz", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		gock.New("http://hukz:7790").
+			Get("/webhooks/100").
+			Reply(http.StatusNotFound)
+
+		e.GET(path).
+			WithPath("webhook_id", 100).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("redelivery is not proxied", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(redeliverPath).
+			WithPath("log_id", 1).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+}
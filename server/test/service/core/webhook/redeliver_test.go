@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWebhookRedeliver(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("enable_webhooks", true)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	gock.New(receiver.URL).EnableNetworking().Persist()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	logRow := func(id int, status string) *sqlmock.Rows {
+		return sqlmock.NewRows(logColumns).
+			AddRow(id, time.Now(), time.Now(), 0, "post.created", receiver.URL, 500, []byte(`{"id":1,"space_id":1}`), nil, nil, 1, status, 5, nil, "", 1)
+	}
+
+	t.Run("invalid log id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(redeliverPath).
+			WithPath("log_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("log record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(logSelectQuery).
+			WithArgs(1, 100).
+			WillReturnRows(sqlmock.NewRows(logColumns))
+
+		e.POST(redeliverPath).
+			WithPath("log_id", "100").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("redeliver dead log", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(logSelectQuery).
+			WithArgs(1, 1).
+			WillReturnRows(logRow(1, delivery.StatusDead))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_logs"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		e.POST(redeliverPath).
+			WithPath("log_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusAccepted).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"id": 2, "status": delivery.StatusPending})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get webhook logs", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(logCountQuery).
+			WithArgs(1, delivery.StatusDead).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		mock.ExpectQuery(logSelectQuery).
+			WithArgs(1, delivery.StatusDead).
+			WillReturnRows(logRow(1, delivery.StatusDead))
+
+		e.GET(logsPath).
+			WithQuery("status", delivery.StatusDead).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"total": 1})
+		test.ExpectationsMet(t, mock)
+	})
+}
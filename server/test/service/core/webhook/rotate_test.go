@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWebhookRotateSecret(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid webhook id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(secretPath).
+			WithPath("webhook_id", "invalid_id").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("webhook record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1, 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		e.POST(secretPath).
+			WithPath("webhook_id", "100").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("rotate secret", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		SelectQuery(mock, 1, 1)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "updated_at"=$1,"updated_by_id"=$2,"secret"=$3`)).
+			WithArgs(test.AnyTime{}, 1, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		secret := e.POST(secretPath).
+			WithPath("webhook_id", "1").
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"name": Data["name"], "url": Data["url"]}).
+			Value("secret").
+			String()
+		secret.Length().Equal(64)
+		secret.NotEqual("secret")
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"name":      "Slack",
+	"url":       "http://example.com/hook",
+	"enabled":   true,
+	"event_ids": []uint{1},
+}
+
+var invalidData = map[string]interface{}{
+	"name": "Slack",
+	"url":  "not a url",
+}
+
+var columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "name", "url", "enabled", "secret", "tags", "space_id"}
+
+var logColumns = []string{"id", "created_at", "updated_at", "created_by_id", "event", "url", "response_status_code", "data", "response_body", "tags", "webhook_id", "status", "attempts", "next_attempt_at", "last_error", "space_id"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "webhooks"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "webhooks"`)
+var logSelectQuery = regexp.QuoteMeta(`SELECT * FROM "webhook_logs"`)
+var logCountQuery = regexp.QuoteMeta(`SELECT count(*) FROM "webhook_logs"`)
+
+var basePath = "/core/webhooks"
+var path = "/core/webhooks/{webhook_id}"
+var logsPath = "/core/webhooks/logs"
+var redeliverPath = "/core/webhooks/logs/{log_id}/redeliver"
+var secretPath = "/core/webhooks/{webhook_id}/secret"
+
+func SelectQuery(mock sqlmock.Sqlmock, args ...driver.Value) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["name"], Data["url"], Data["enabled"], "secret", nil, 1))
+}
+
+func eventsPreloadMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_events"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"webhook_id", "event_id"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "event"}).AddRow(1, "Create Post", "post.created"))
+}
@@ -0,0 +1,138 @@
+package wordpress
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service/core/action/wordpress"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/test"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWordpressImport(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("create_super_organisation", false)
+	viper.Set("enable_search_indexing", false)
+	defer viper.Set("create_super_organisation", true)
+	defer viper.Set("enable_search_indexing", true)
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	t.Run("invalid export", func(t *testing.T) {
+		job := &model.ImportJob{SpaceID: 1}
+		job.ID = 1
+
+		updateMock(mock)
+		updateMock(mock)
+
+		err := wordpress.Import(job, strings.NewReader("not an export"), 1, 1, true, nil)
+		if err == nil {
+			t.Error("expected invalid export to fail")
+		}
+		if job.Status != wordpress.JobFailed {
+			t.Errorf("expected status %s, got %s", wordpress.JobFailed, job.Status)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("import export", func(t *testing.T) {
+		job := &model.ImportJob{SpaceID: 1}
+		job.ID = 1
+
+		importMock(mock, "publish")
+
+		err := wordpress.Import(job, strings.NewReader(export), 1, 1, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if job.Status != wordpress.JobCompleted {
+			t.Errorf("expected status %s, got %s", wordpress.JobCompleted, job.Status)
+		}
+		if job.Total != 3 || job.Processed != 3 {
+			t.Errorf("expected 3 of 3 items processed, got %d of %d", job.Processed, job.Total)
+		}
+		if job.Posts != 1 || job.Categories != 1 || job.Media != 1 || job.Skipped != 1 {
+			t.Errorf("unexpected counts %d posts, %d categories, %d media, %d skipped", job.Posts, job.Categories, job.Media, job.Skipped)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("import published posts as drafts without permission to publish", func(t *testing.T) {
+		job := &model.ImportJob{SpaceID: 1}
+		job.ID = 1
+
+		importMock(mock, "draft")
+
+		err := wordpress.Import(job, strings.NewReader(export), 1, 1, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if job.Posts != 1 {
+			t.Errorf("expected 1 post imported, got %d", job.Posts)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("space over its quota", func(t *testing.T) {
+		viper.Set("create_super_organisation", true)
+		defer viper.Set("create_super_organisation", false)
+
+		job := &model.ImportJob{SpaceID: 1}
+		job.ID = 1
+
+		// running
+		updateMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Factly", "factly"))
+
+		// the space has more posts and media than its quota allows
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "space_permissions"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "space_id", "posts", "media"}).AddRow(1, 1, 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "media"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+			WithArgs("article", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Article", "article", 1))
+
+		// total
+		updateMock(mock)
+
+		categoryMock(mock)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+			WithArgs(1, "https://example.com/wp-content/uploads/logo.png").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts"`)).
+			WithArgs(1, "11").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		// completed
+		updateMock(mock)
+
+		err := wordpress.Import(job, strings.NewReader(export), 1, 1, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if job.Posts != 0 || job.Media != 0 || job.Skipped != 3 {
+			t.Errorf("unexpected counts %d posts, %d media, %d skipped", job.Posts, job.Media, job.Skipped)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,198 @@
+package wordpress
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/test"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "source", "file_name", "status", "total", "processed", "posts", "pages", "categories", "tags", "media", "skipped", "errors", "space_id"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "import_jobs"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "import_jobs"`)
+var updateQuery = regexp.QuoteMeta(`UPDATE "import_jobs"`)
+
+var basePath = "/core/import/wordpress"
+var path = "/core/import/wordpress/{job_id}"
+
+// export is a WXR export with a category, a tag, an attachment and a published post
+var export = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Factly</title>
+	<wp:author>
+		<wp:author_id>2</wp:author_id>
+		<wp:author_login><![CDATA[abc]]></wp:author_login>
+		<wp:author_email><![CDATA[abc@abc.com]]></wp:author_email>
+	</wp:author>
+	<wp:category>
+		<wp:term_id>3</wp:term_id>
+		<wp:category_nicename><![CDATA[politics]]></wp:category_nicename>
+		<wp:category_parent><![CDATA[]]></wp:category_parent>
+		<wp:cat_name><![CDATA[Politics]]></wp:cat_name>
+	</wp:category>
+	<item>
+		<title>Factly logo</title>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_name><![CDATA[factly-logo]]></wp:post_name>
+		<wp:status><![CDATA[inherit]]></wp:status>
+		<wp:post_type><![CDATA[attachment]]></wp:post_type>
+		<wp:attachment_url><![CDATA[https://example.com/wp-content/uploads/logo.png]]></wp:attachment_url>
+	</item>
+	<item>
+		<title>Fact check of the week</title>
+		<link>https://example.com/fact-check-of-the-week/</link>
+		<dc:creator><![CDATA[abc]]></dc:creator>
+		<content:encoded><![CDATA[<h2>Claim</h2>
+First paragraph with <strong>bold</strong> text.
+
+Second paragraph.]]></content:encoded>
+		<excerpt:encoded><![CDATA[Weekly fact check]]></excerpt:encoded>
+		<wp:post_id>11</wp:post_id>
+		<wp:post_date_gmt><![CDATA[2021-03-10 10:00:00]]></wp:post_date_gmt>
+		<wp:post_name><![CDATA[fact-check-of-the-week]]></wp:post_name>
+		<wp:status><![CDATA[publish]]></wp:status>
+		<wp:post_type><![CDATA[post]]></wp:post_type>
+		<wp:is_sticky>0</wp:is_sticky>
+		<category domain="category" nicename="politics"><![CDATA[Politics]]></category>
+		<wp:postmeta>
+			<wp:meta_key><![CDATA[_thumbnail_id]]></wp:meta_key>
+			<wp:meta_value><![CDATA[10]]></wp:meta_value>
+		</wp:postmeta>
+	</item>
+	<item>
+		<title>Menu</title>
+		<wp:post_id>12</wp:post_id>
+		<wp:status><![CDATA[publish]]></wp:status>
+		<wp:post_type><![CDATA[nav_menu_item]]></wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+func jobRow(status string) *sqlmock.Rows {
+	return sqlmock.NewRows(columns).
+		AddRow(1, time.Now(), time.Now(), nil, 1, 1, "wordpress", "export.xml", status, 3, 3, 1, 0, 1, 0, 1, 1, []byte(`[]`), 1)
+}
+
+func selectMock(mock sqlmock.Sqlmock, status string) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs("wordpress", 1, 1).
+		WillReturnRows(jobRow(status))
+}
+
+func updateMock(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectExec(updateQuery).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+}
+
+// importMock mocks importing the export, with its post imported with the status
+func importMock(mock sqlmock.Sqlmock, status string) {
+	// running
+	updateMock(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Factly", "factly"))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+		WithArgs("article", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Article", "article", 1))
+
+	// total
+	updateMock(mock)
+
+	categoryMock(mock)
+
+	// attachment
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+		WithArgs(1, "https://example.com/wp-content/uploads/logo.png").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "media"`)).
+		WithArgs("factly-logo%", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "media"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	// post
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts"`)).
+		WithArgs(1, "11").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "posts"`)).
+		WithArgs("fact-check-of-the-week%", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "space_id"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "space_id"}).AddRow(1, 1))
+	mock.ExpectQuery(`INSERT INTO "posts"`).
+		WithArgs(postArgs(status)...).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "featured_medium_id", "workflow_stage_id", "translation_group_id"}).AddRow(1, 1, 1, 1))
+	mock.ExpectQuery(`INSERT INTO "categories"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id", "medium_id", "translation_group_id"}).AddRow(1, 1, 1, 1))
+	mock.ExpectExec(`INSERT INTO "post_categories"`).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "post_authors"`).
+		WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "media"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "space_id"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "space_id"}).AddRow(1, 1))
+	mock.ExpectExec(`UPDATE "posts"`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`INSERT INTO "post_revisions"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	// completed
+	updateMock(mock)
+}
+
+func categoryMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+		WithArgs("politics", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "categories"`)).
+		WithArgs("politics%", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"slug", "space_id"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "categories"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id", "medium_id", "translation_group_id"}).AddRow(1, 1, 1, 1))
+	mock.ExpectCommit()
+}
+
+// postArgs are the arguments inserting the post of the export with the status,
+// the published posts only have a published date
+func postArgs(status string) []driver.Value {
+	args := make([]driver.Value, 26)
+	for i := range args {
+		args[i] = sqlmock.AnyArg()
+	}
+	args[8] = status
+	if status != "publish" {
+		args[17] = nil
+	}
+	return args
+}
@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWorkflowReviewers(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("post record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		mock.ExpectQuery(postSelectQuery).
+			WithArgs(1, false, 1).
+			WillReturnRows(sqlmock.NewRows(postColumns))
+
+		e.PUT(reviewersPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"reviewer_ids": []uint{1}}).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("reviewer is not a member of the organisation", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		PostSelectMock(mock, "draft", 1, 1, false, 1)
+		StageMock(mock, 1, "in-review", "", `[]`, 1)
+
+		e.PUT(reviewersPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"reviewer_ids": []uint{100}}).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("assign reviewers", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		PostSelectMock(mock, "draft", 1, 1, false, 1)
+		StageMock(mock, 1, "in-review", "", `[]`, 1)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "post_reviewers" SET "deleted_at"=`)).
+			WithArgs(test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`INSERT INTO "post_reviewers"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		e.PUT(reviewersPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"reviewer_ids": []uint{1}}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("reviewers").
+			Array().
+			Length().
+			Equal(1)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,78 @@
+package workflow
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"name":        "In Review",
+	"slug":        "in-review",
+	"position":    2,
+	"post_status": "",
+	"transitions": postgres.Jsonb{RawMessage: []byte(`["draft","fact-check-verified"]`)},
+}
+
+var invalidData = map[string]interface{}{
+	"name":        "In",
+	"post_status": "archived",
+}
+
+var Columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "name", "slug", "position", "post_status", "transitions", "space_id"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "workflow_stages"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "workflow_stages"`)
+var postCountQuery = regexp.QuoteMeta(`SELECT count(*) FROM "posts"`)
+
+var basePath = "/core/workflows/stages"
+var path = "/core/workflows/stages/{stage_id}"
+
+func SelectQuery(mock sqlmock.Sqlmock, args ...driver.Value) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["name"], Data["slug"], Data["position"], Data["post_status"], Data["transitions"], 1))
+}
+
+var postColumns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "title", "slug", "status", "is_page", "workflow_stage_id", "space_id"}
+
+var postSelectQuery = regexp.QuoteMeta(`SELECT * FROM "posts"`)
+
+var transitionPath = "/core/posts/{post_id}/workflow/transition"
+var reviewersPath = "/core/posts/{post_id}/workflow/reviewers"
+
+// PostSelectMock mocks the post in the stage with the id, in no stage when it is nil
+func PostSelectMock(mock sqlmock.Sqlmock, status string, stageID interface{}, args ...driver.Value) {
+	mock.ExpectQuery(postSelectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(postColumns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, "Post", "post", status, false, stageID, 1))
+}
+
+// StageMock mocks a stage of the space
+func StageMock(mock sqlmock.Sqlmock, id int, slug, postStatus, transitions string, args ...driver.Value) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(id, time.Now(), time.Now(), nil, 1, 1, slug, slug, id, postStatus, postgres.Jsonb{RawMessage: []byte(transitions)}, 1))
+}
+
+// PostAuthorsMock mocks the authors of the post
+func PostAuthorsMock(mock sqlmock.Sqlmock, count int) {
+	rows := sqlmock.NewRows([]string{"id", "post_id", "author_id"})
+	for i := 1; i <= count; i++ {
+		rows.AddRow(i, 1, i)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_authors"`)).
+		WithArgs(1).
+		WillReturnRows(rows)
+}
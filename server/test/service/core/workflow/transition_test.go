@@ -0,0 +1,148 @@
+package workflow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWorkflowTransition(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid post id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(transitionPath).
+			WithPath("post_id", "invalid_id").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"stage_id": 2}).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("unprocessable transition", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		e.POST(transitionPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{}).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("transition not allowed", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		PostSelectMock(mock, "draft", 1, 1, false, 1)
+		StageMock(mock, 1, "in-review", "", `["draft","fact-check-verified"]`, 1)
+		StageMock(mock, 2, "published", "publish", `[]`, 1, 2)
+
+		e.POST(transitionPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"stage_id": 2}).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("user does not have permission to publish", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+
+		PostSelectMock(mock, "draft", 1, 1, false, 1)
+		StageMock(mock, 1, "in-review", "", `["published"]`, 1)
+		StageMock(mock, 2, "published", "publish", `[]`, 1, 2)
+		PostAuthorsMock(mock, 1)
+
+		e.POST(transitionPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"stage_id": 2}).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		test.MockServer()
+	})
+
+	t.Run("move post to the next stage", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+
+		PostSelectMock(mock, "draft", 1, 1, false, 1)
+		StageMock(mock, 1, "in-review", "", `["draft","fact-check-verified"]`, 1)
+		StageMock(mock, 3, "fact-check-verified", "ready", `[]`, 1, 3)
+		PostAuthorsMock(mock, 1)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "status"=$1,"updated_by_id"=$2,"workflow_stage_id"=$3,"updated_at"=$4`)).
+			WithArgs("ready", 1, 3, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`INSERT INTO "post_transitions"`).
+			WithArgs(test.AnyTime{}, test.AnyTime{}, nil, 1, 1, 1, 3, "", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "from_stage_id"}).AddRow(1, 1))
+
+		PostSelectMock(mock, "ready", 3, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_categories"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "category_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}).AddRow(1, "Space", "space"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_tags"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"post_id", "tag_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		StageMock(mock, 3, "fact-check-verified", "ready", `[]`, 3)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_transitions"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "from_stage_id", "to_stage_id", "note", "space_id"}).
+				AddRow(1, 1, 1, 3, "", 1))
+		StageMock(mock, 1, "in-review", "", `["draft","fact-check-verified"]`, 1)
+		StageMock(mock, 3, "fact-check-verified", "ready", `[]`, 3)
+		mock.ExpectCommit()
+
+		e.POST(transitionPath).
+			WithPath("post_id", "1").
+			WithHeaders(headers).
+			WithJSON(map[string]interface{}{"stage_id": 3}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"post_id": 1, "from_stage_id": 1, "to_stage_id": 3})
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,211 @@
+package delivery
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/dega-server/service/delivery"
+	"github.com/factly/dega-server/test"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/spf13/viper"
+)
+
+var webhookColumns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "name", "url", "enabled", "secret", "tags", "space_id"}
+
+var selectWebhook = regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE "webhooks"."deleted_at" IS NULL AND "webhooks"."id" = $1`)
+
+var updateLog = regexp.QuoteMeta(`UPDATE "webhook_logs" SET`)
+
+func TestSign(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`{"event":"post.created"}`))
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := delivery.Sign("secret", []byte(`{"event":"post.created"}`)); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	viper.Set("webhook_retry_interval", "30s")
+
+	tests := map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		20: time.Hour,
+	}
+
+	for attempts, expected := range tests {
+		if got := delivery.Backoff(attempts); got != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempts, expected, got)
+		}
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	mock := test.SetupMockDB()
+	viper.Set("webhook_max_attempts", 2)
+
+	var signature, event string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(delivery.SignatureHeader)
+		if signature != delivery.Sign("secret", body) {
+			t.Error("signature does not match body")
+		}
+		event = r.Header.Get("X-Dega-Event")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	webhookRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(webhookColumns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, "hook", server.URL, true, "secret", nil, 1)
+	}
+
+	newLog := func(attempts int) *model.WebhookLog {
+		webhookLog := &model.WebhookLog{
+			Event:     "post.created",
+			URL:       server.URL,
+			Data:      postgres.Jsonb{RawMessage: []byte(`{"id":1,"space_id":1}`)},
+			WebhookID: 1,
+			Status:    delivery.StatusPending,
+			Attempts:  attempts,
+			SpaceID:   1,
+		}
+		webhookLog.ID = 1
+		return webhookLog
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		status = http.StatusOK
+		mock.ExpectQuery(selectWebhook).WithArgs(1).WillReturnRows(webhookRow())
+
+		mock.ExpectBegin()
+		mock.ExpectExec(updateLog).
+			WithArgs(1, "", nil, []byte(`{"ok":true}`), 200, delivery.StatusDelivered, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := delivery.Deliver(newLog(0)); err != nil {
+			t.Fatal(err)
+		}
+		if event != "post.created" {
+			t.Errorf("expected post.created event header, got %s", event)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("failed attempt is retried", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		mock.ExpectQuery(selectWebhook).WithArgs(1).WillReturnRows(webhookRow())
+
+		mock.ExpectBegin()
+		mock.ExpectExec(updateLog).
+			WithArgs(1, "webhook responded with status 500", test.AnyTime{}, []byte(`{"ok":true}`), 500, delivery.StatusRetrying, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := delivery.Deliver(newLog(0)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("last failed attempt is dead-lettered", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		mock.ExpectQuery(selectWebhook).WithArgs(1).WillReturnRows(webhookRow())
+
+		mock.ExpectBegin()
+		mock.ExpectExec(updateLog).
+			WithArgs(2, "webhook responded with status 500", nil, []byte(`{"ok":true}`), 500, delivery.StatusDead, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := delivery.Deliver(newLog(1)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("webhook deleted", func(t *testing.T) {
+		mock.ExpectQuery(selectWebhook).WithArgs(1).WillReturnRows(sqlmock.NewRows(webhookColumns))
+
+		mock.ExpectBegin()
+		mock.ExpectExec(updateLog).
+			WithArgs("webhook not found", delivery.StatusDead, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := delivery.Deliver(newLog(0)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+}
+
+func TestEnqueue(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	t.Run("event without space is skipped", func(t *testing.T) {
+		if err := delivery.Enqueue("policy.created", []byte(`{"name":"policy"}`)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("no webhook registered for event", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "webhooks"."id"`)).
+			WithArgs(true, 1, "post.created").
+			WillReturnRows(sqlmock.NewRows(webhookColumns))
+
+		if err := delivery.Enqueue("post.created", []byte(`{"id":3,"space_id":1}`)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("media event is enqueued for the space of its nodes", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "webhooks"."id"`)).
+			WithArgs(true, 1, "media.created").
+			WillReturnRows(sqlmock.NewRows(webhookColumns).
+				AddRow(1, time.Now(), time.Now(), nil, 1, 1, "hook", "http://hook.test", true, "secret", nil, 1))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_logs"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		if err := delivery.Enqueue("media.created", []byte(`{"total":1,"nodes":[{"id":4,"space_id":1}]}`)); err != nil {
+			t.Fatal(err)
+		}
+		test.ExpectationsMet(t, mock)
+	})
+}
+
+func TestRun(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	t.Run("due logs are locked while they are attempted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_logs" WHERE status IN ($1,$2) AND next_attempt_at <= $3 ORDER BY id asc LIMIT 100 FOR UPDATE SKIP LOCKED`)).
+			WithArgs(delivery.StatusPending, delivery.StatusRetrying, test.AnyTime{}).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		delivery.Run()
+		test.ExpectationsMet(t, mock)
+	})
+}
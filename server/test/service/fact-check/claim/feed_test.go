@@ -0,0 +1,244 @@
+package claim
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var claimReviewPath = "/spaces/{space_id}/claims/feeds/claimreview"
+var dataCommonsPath = "/spaces/{space_id}/claims/feeds/datacommons"
+var localeClaimReviewPath = "/spaces/{space_id}/locales/{locale}/claims/feeds/claimreview"
+
+var checkedDate = time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+var publishedDate = time.Date(2021, 4, 2, 0, 0, 0, 0, time.UTC)
+
+func feedSpaceMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "site_address", "organisation_id"}).
+			AddRow(1, "Test Space", "test-space", "https://testaddress.com", 1))
+}
+
+// feedClaimsMock mocks the claims of the feed, the post they appear in is of
+// the format when formatID is set
+func feedClaimsMock(mock sqlmock.Sqlmock, formatID uint) {
+	mock.ExpectQuery(selectQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "claim", "slug", "checked_date", "claim_sources", "claimant_id", "rating_id", "fact", "space_id"}).
+			AddRow(1, Data["claim"], Data["slug"], checkedDate, Data["claim_sources"], 1, 1, Data["fact"], 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claimants"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).
+			AddRow(1, "Claimant", "claimant", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ratings"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "numeric_value", "space_id"}).
+			AddRow(1, "True", "true", 5, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_claims"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "claim_id", "post_id"}).
+			AddRow(1, 1, 1).
+			AddRow(2, 1, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts"`)).
+		WithArgs(1, 2, "publish").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "title", "slug", "status", "published_date", "format_id", "space_id"}).
+			AddRow(2, time.Now(), "Fact check", "fact-check", "publish", publishedDate, formatID, 1))
+	if formatID > 0 {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "formats"`)).
+			WithArgs(formatID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).
+				AddRow(formatID, "Fact Check", "fact-check", 1))
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ratings"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "numeric_value", "space_id"}).
+			AddRow(1, "True", "true", 5, 1))
+}
+
+func feedLocaleSpaceMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "site_address", "default_locale", "organisation_id"}).
+			AddRow(1, "Test Space", "test-space", "https://testaddress.com", "en", 1))
+}
+
+func feedPermalinksSpaceMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "site_address", "permalinks", "organisation_id"}).
+			AddRow(1, "Test Space", "test-space", "https://testaddress.com", []byte(`{"post": "/{format}/{yyyy}/{mm}/{slug}"}`), 1))
+}
+
+func TestClaimFeeds(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	testServer := httptest.NewServer(service.RegisterFeedsRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid space id", func(t *testing.T) {
+		e.GET(claimReviewPath).
+			WithPath("space_id", "invalid").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("invalid before date", func(t *testing.T) {
+		e.GET(claimReviewPath).
+			WithPath("space_id", "1").
+			WithQuery("before", "yesterday").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("space not found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		e.GET(claimReviewPath).
+			WithPath("space_id", "1").
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get claimreview feed", func(t *testing.T) {
+		feedSpaceMock(mock)
+		feedClaimsMock(mock, 0)
+
+		res := e.GET(claimReviewPath).
+			WithPath("space_id", "1").
+			Expect().
+			Status(http.StatusOK)
+
+		res.Header("Link").Empty()
+		review := res.JSON(httpexpect.ContentOpts{MediaType: "application/ld+json"}).
+			Array().
+			Element(0).
+			Object()
+
+		review.ContainsMap(map[string]interface{}{
+			"@type":         "ClaimReview",
+			"claimReviewed": Data["claim"],
+			"url":           "https://testaddress.com/fact-check",
+			"datePublished": publishedDate.Format(time.RFC3339),
+		})
+		review.Value("reviewRating").Object().ContainsMap(map[string]interface{}{
+			"alternateName": "True",
+			"ratingValue":   5,
+		})
+		review.Value("itemReviewed").Object().Value("author").Object().ContainsMap(map[string]interface{}{
+			"name": "Claimant",
+		})
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get claimreview feed with the permalinks of the space", func(t *testing.T) {
+		feedPermalinksSpaceMock(mock)
+		feedClaimsMock(mock, 1)
+
+		e.GET(claimReviewPath).
+			WithPath("space_id", "1").
+			Expect().
+			Status(http.StatusOK).
+			JSON(httpexpect.ContentOpts{MediaType: "application/ld+json"}).
+			Array().
+			Element(0).
+			Object().
+			ValueEqual("url", "https://testaddress.com/fact-check/2021/04/fact-check")
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get next page of datacommons feed", func(t *testing.T) {
+		feedSpaceMock(mock)
+		feedClaimsMock(mock, 0)
+
+		res := e.GET(dataCommonsPath).
+			WithPath("space_id", "1").
+			WithQuery("limit", 1).
+			WithQuery("before", checkedDate.Add(time.Hour).Format(time.RFC3339)).
+			WithQuery("before_id", 5).
+			Expect().
+			Status(http.StatusOK)
+
+		res.Header("Link").Equal(`</spaces/1/claims/feeds/datacommons?before=2021-04-01T00%3A00%3A00Z&before_id=1&limit=1>; rel="next"`)
+		feed := res.JSON(httpexpect.ContentOpts{MediaType: "application/ld+json"}).Object()
+		feed.ContainsMap(map[string]interface{}{
+			"@type": "DataFeed",
+		})
+		feed.Value("dataFeedElement").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"@type":       "DataFeedItem",
+				"dateCreated": publishedDate.Format(time.RFC3339),
+			}).
+			Value("item").
+			Array().
+			Element(0).
+			Object().
+			ContainsMap(map[string]interface{}{
+				"@type":         "ClaimReview",
+				"claimReviewed": Data["claim"],
+			})
+		test.ExpectationsMet(t, mock)
+	})
+	t.Run("invalid locale", func(t *testing.T) {
+		e.GET(localeClaimReviewPath).
+			WithPath("space_id", "1").
+			WithPath("locale", "not a locale").
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+	})
+
+	t.Run("get claimreview feed in a locale", func(t *testing.T) {
+		feedLocaleSpaceMock(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`claims.locale = $3`)).
+			WithArgs(1, "publish", "hi").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		e.GET(localeClaimReviewPath).
+			WithPath("space_id", "1").
+			WithPath("locale", "hi").
+			Expect().
+			Status(http.StatusOK).
+			JSON(httpexpect.ContentOpts{MediaType: "application/ld+json"}).
+			Array().
+			Empty()
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("get claimreview feed in the default locale of the space", func(t *testing.T) {
+		feedLocaleSpaceMock(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`claims.locale IN ($3,$4)`)).
+			WithArgs(1, "publish", "en", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		e.GET(localeClaimReviewPath).
+			WithPath("space_id", "1").
+			WithPath("locale", "en").
+			Expect().
+			Status(http.StatusOK).
+			JSON(httpexpect.ContentOpts{MediaType: "application/ld+json"}).
+			Array().
+			Empty()
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,180 @@
+package video
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/test/service/core/permissions/space"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestVideoCreate(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("Unprocessable video", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		e.POST(basePath).
+			WithJSON(invalidData).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("Undecodable video", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("claim ends after the video", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		video := map[string]interface{}{}
+		for k, v := range Data {
+			video[k] = v
+		}
+		video["claims"] = []map[string]interface{}{
+			{"claim_id": 1, "start_time": 120, "end_time": 400},
+		}
+
+		e.POST(basePath).
+			WithJSON(video).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("claim starts after it ends", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		video := map[string]interface{}{}
+		for k, v := range Data {
+			video[k] = v
+		}
+		video["claims"] = []map[string]interface{}{
+			{"claim_id": 1, "start_time": 120, "end_time": 60},
+		}
+
+		e.POST(basePath).
+			WithJSON(video).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("user does not have permission to publish video", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		video := withClaims(videoClaims)
+		video["status"] = "publish"
+
+		e.POST(basePath).
+			WithJSON(video).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		test.MockServer()
+	})
+
+	t.Run("cannot create more videos than the quota", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		permissionMock(mock, 2)
+		videoCountMock(mock, 2)
+
+		e.POST(basePath).
+			WithJSON(withClaims(videoClaims)).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("claim of another space", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		permissionMock(mock, 2)
+		videoCountMock(mock, 1)
+		slugCheckMock(mock)
+
+		mock.ExpectBegin()
+		videoInsertMock(mock)
+		claimAttachMock(mock, 0)
+		mock.ExpectRollback()
+
+		e.POST(basePath).
+			WithJSON(withClaims(videoClaims)).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("create video with claims", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		permissionMock(mock, 2)
+		videoCountMock(mock, 1)
+		slugCheckMock(mock)
+
+		mock.ExpectBegin()
+		videoInsertMock(mock)
+		claimAttachMock(mock, 1)
+		videoPreloadMock(mock)
+		videoClaimsMock(mock)
+		mock.ExpectCommit()
+
+		e.POST(basePath).
+			WithJSON(withClaims(videoClaims)).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"title": Data["title"], "slug": Data["slug"], "status": "draft"}).
+			Value("claims").
+			Array().
+			Length().
+			Equal(1)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,21 @@
+package video
+
+import (
+	"os"
+	"testing"
+
+	"github.com/factly/dega-server/test"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestMain(m *testing.M) {
+
+	// Mock kavach server and allowing persisted external traffic
+	defer gock.Disable()
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	exitValue := m.Run()
+
+	os.Exit(exitValue)
+}
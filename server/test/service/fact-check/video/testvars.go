@@ -0,0 +1,154 @@
+package video
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/test"
+)
+
+var headers = map[string]string{
+	"X-Space": "1",
+	"X-User":  "1",
+}
+
+var Data = map[string]interface{}{
+	"url":            "https://www.youtube.com/watch?v=ed_2UDqN5J4",
+	"title":          "Video Fact Check",
+	"slug":           "video-fact-check",
+	"summary":        "summary of the video",
+	"video_type":     "youtube",
+	"status":         "draft",
+	"total_duration": 300,
+	"thumbnail_url":  "https://i.ytimg.com/vi/ed_2UDqN5J4/hqdefault.jpg",
+}
+
+var invalidData = map[string]interface{}{
+	"url":   "not a url",
+	"title": "",
+}
+
+var Columns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "url", "title", "slug", "summary", "video_type", "space_id", "status", "total_duration", "thumbnail_url", "published_date", "schemas"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "videos"`)
+var countQuery = regexp.QuoteMeta(`SELECT count(*) FROM "videos"`)
+
+var basePath = "/fact-check/videos"
+var path = "/fact-check/videos/{video_id}"
+
+func SelectQuery(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["url"], Data["title"], Data["slug"], Data["summary"], Data["video_type"], 1, Data["status"], Data["total_duration"], Data["thumbnail_url"], nil, nil))
+}
+
+func recordNotFoundMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(1, 100).
+		WillReturnRows(sqlmock.NewRows(Columns))
+}
+
+var permissionColumns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "space_id", "fact_check", "videos"}
+
+// videoClaims attaches the first claim of the space to the first two minutes
+var videoClaims = []map[string]interface{}{
+	{"claim_id": 1, "start_time": 0, "end_time": 120},
+}
+
+// withClaims returns the video with the claims attached
+func withClaims(claims []map[string]interface{}) map[string]interface{} {
+	video := map[string]interface{}{}
+	for k, v := range Data {
+		video[k] = v
+	}
+	video["claims"] = claims
+	return video
+}
+
+// permissionMock mocks the space permission allowing the number of videos
+func permissionMock(mock sqlmock.Sqlmock, videos int) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "space_permissions"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(permissionColumns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, 1, true, videos))
+}
+
+func videoCountMock(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectQuery(countQuery).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func slugCheckMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT slug, space_id FROM "videos"`)).
+		WithArgs(fmt.Sprint(Data["slug"], "%"), 1).
+		WillReturnRows(sqlmock.NewRows(Columns))
+}
+
+func videoInsertMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`INSERT INTO "videos"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+}
+
+// claimAttachMock mocks attaching the claim, which is not found when no row is affected
+func claimAttachMock(mock sqlmock.Sqlmock, affected int64) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "claims" SET "end_time"=$1,"start_time"=$2,"video_id"=$3,"updated_at"=$4`)).
+		WithArgs(120, 0, 1, test.AnyTime{}, 1, 1).
+		WillReturnResult(sqlmock.NewResult(1, affected))
+}
+
+func claimDetachMock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "claims" SET "end_time"=$1,"start_time"=$2,"video_id"=$3,"updated_at"=$4`)).
+		WithArgs(0, 0, nil, test.AnyTime{}, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+// videoPreloadMock mocks fetching the saved video with its tags and categories
+func videoPreloadMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(selectQuery).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(Columns).
+			AddRow(1, time.Now(), time.Now(), nil, 1, 1, Data["url"], Data["title"], Data["slug"], Data["summary"], Data["video_type"], 1, Data["status"], Data["total_duration"], Data["thumbnail_url"], nil, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "video_categories"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"video_id", "category_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "video_tags"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"video_id", "tag_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+// videoClaimsMock mocks the claims attached to the video
+func videoClaimsMock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "claim", "slug", "video_id", "start_time", "end_time", "space_id"}).
+			AddRow(1, "Claim", "claim", 1, 0, 120, 1))
+}
+
+// videoUpdateMock mocks replacing the tags, categories and fields of the video
+func videoUpdateMock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "videos" SET "updated_at"=$1 WHERE "id" = $2`)).
+		WithArgs(test.AnyTime{}, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "video_tags"`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "videos" SET "updated_at"=$1 WHERE "id" = $2`)).
+		WithArgs(test.AnyTime{}, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "video_categories"`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "videos" SET`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "video_authors"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "video_id", "author_id"}))
+}
@@ -0,0 +1,144 @@
+package video
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/test/service/core/permissions/space"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestVideoUpdate(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("invalid video id", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		e.PUT(path).
+			WithPath("video_id", "invalid_id").
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusBadRequest)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("video record not found", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		mock.ExpectQuery(selectQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.PUT(path).
+			WithPath("video_id", "1").
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("user does not have permission to publish video", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		SelectQuery(mock)
+
+		video := withClaims(videoClaims)
+		video["status"] = "publish"
+
+		e.PUT(path).
+			WithPath("video_id", "1").
+			WithJSON(video).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		test.MockServer()
+	})
+
+	t.Run("update video and attach claims", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		SelectQuery(mock)
+
+		mock.ExpectBegin()
+		videoUpdateMock(mock)
+		claimDetachMock(mock)
+		claimAttachMock(mock, 1)
+		videoPreloadMock(mock)
+		videoClaimsMock(mock)
+		mock.ExpectCommit()
+
+		e.PUT(path).
+			WithPath("video_id", "1").
+			WithJSON(withClaims(videoClaims)).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsMap(map[string]interface{}{"title": Data["title"], "slug": Data["slug"]}).
+			Value("claims").
+			Array().
+			Length().
+			Equal(1)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("update video and detach claims", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		SelectQuery(mock)
+
+		mock.ExpectBegin()
+		videoUpdateMock(mock)
+		claimDetachMock(mock)
+		videoPreloadMock(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		e.PUT(path).
+			WithPath("video_id", "1").
+			WithJSON(withClaims([]map[string]interface{}{})).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("claims").
+			Array().
+			Empty()
+		test.ExpectationsMet(t, mock)
+	})
+}
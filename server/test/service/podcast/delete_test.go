@@ -1,7 +1,6 @@
 package podcast
 
 import (
-	"database/sql/driver"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -62,10 +61,6 @@ func TestPodcastDelete(t *testing.T) {
 		PodcastCategorySelect(mock)
 
 		mock.ExpectBegin()
-		mock.ExpectExec(`DELETE FROM "podcast_categories"`).
-			WithArgs(1, 1).
-			WillReturnResult(driver.ResultNoRows)
-
 		mock.ExpectExec(`UPDATE \"podcasts\"`).
 			WithArgs(test.AnyTime{}, 1).
 			WillReturnResult(sqlmock.NewResult(1, 1))
@@ -73,6 +73,61 @@ func TestEpisodeCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("cannot schedule episode without future published date", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		Data["status"] = "scheduled"
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+		delete(Data, "status")
+	})
+
+	t.Run("invalid episode status", func(t *testing.T) {
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		Data["status"] = "ready"
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnprocessableEntity)
+		test.ExpectationsMet(t, mock)
+		delete(Data, "status")
+	})
+
+	t.Run("user does not have permission to publish episode", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+
+		Data["status"] = "publish"
+
+		e.POST(basePath).
+			WithHeaders(headers).
+			WithJSON(Data).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		delete(Data, "status")
+		test.MockServer()
+	})
+
 	t.Run("create episode", func(t *testing.T) {
 		test.CheckSpaceMock(mock)
 		space.SelectQuery(mock, 1)
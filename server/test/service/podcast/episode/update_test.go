@@ -103,6 +103,33 @@ func TestEpisodeUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("user does not have permission to publish episode", func(t *testing.T) {
+		test.DisableKetoGock(testServer.URL)
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusOK)
+
+		gock.New(viper.GetString("keto_url")).
+			Post("/engines/acp/ory/regex/allowed").
+			Reply(http.StatusForbidden)
+
+		test.CheckSpaceMock(mock)
+		space.SelectQuery(mock, 1)
+		SelectQuery(mock, 1, 1)
+
+		Data["status"] = "publish"
+
+		e.PUT(path).
+			WithPath("episode_id", "1").
+			WithJSON(Data).
+			WithHeaders(headers).
+			Expect().
+			Status(http.StatusUnauthorized)
+		test.ExpectationsMet(t, mock)
+		delete(Data, "status")
+		test.MockServer()
+	})
+
 	t.Run("update episode", func(t *testing.T) {
 		test.CheckSpaceMock(mock)
 		space.SelectQuery(mock, 1)
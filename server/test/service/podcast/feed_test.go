@@ -0,0 +1,167 @@
+package podcast
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service"
+	"github.com/factly/dega-server/service/podcast/model"
+	"github.com/factly/dega-server/test"
+	"github.com/gavv/httpexpect/v2"
+	"gopkg.in/h2non/gock.v1"
+)
+
+var feedPath = "/spaces/{space_id}/podcasts/{podcast_slug}/feed"
+
+var episodePublishedDate = time.Date(2021, 4, 2, 0, 0, 0, 0, time.UTC)
+var episodeUpdatedAt = time.Date(2021, 4, 3, 0, 0, 0, 0, time.UTC)
+
+// podcastFeedMock mocks a podcast with a published episode, explicit when
+// the podcast has explicit episodes
+func podcastFeedMock(mock sqlmock.Sqlmock, explicitCount int) {
+	podcastMock(mock, explicitCount)
+	podcastEpisodesMock(mock, sqlmock.NewRows(episodeColumns).
+		AddRow(1, episodeUpdatedAt, "Episode", "episode", 1, 2, "https://testaddress.com/episode.mp3", 1800, 1024, "", explicitCount > 0, "full", "https://testaddress.com/episode.vtt", "https://testaddress.com/chapters.json", "publish", episodePublishedDate, 1, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episode_authors"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "episode_id", "author_id"}).AddRow(1, 1, 1))
+}
+
+// podcastMock mocks the space and the podcast of the feed, with the count of
+// its explicit episodes
+func podcastMock(mock sqlmock.Sqlmock, explicitCount int) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "site_address", "organisation_id"}).
+			AddRow(1, "Test Space", "test-space", "https://testaddress.com", 1))
+
+	mock.ExpectQuery(selectQuery).
+		WithArgs(Data["slug"], 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at", "title", "slug", "html_description", "language", "space_id"}).
+			AddRow(1, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Data["title"], Data["slug"], Data["html_description"], "en", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "podcast_categories"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"podcast_id", "category_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "episodes"`)).
+		WithArgs(true, 1, "publish").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(explicitCount))
+}
+
+var episodeColumns = []string{"id", "updated_at", "title", "slug", "season", "episode", "audio_url", "duration", "file_size", "mime_type", "explicit", "episode_type", "transcript_url", "chapters_url", "status", "published_date", "podcast_id", "space_id"}
+
+// podcastEpisodesMock mocks the published episodes of the podcast feed
+func podcastEpisodesMock(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE "episodes"."podcast_id" = $1 AND "episodes"."status" = $2 AND published_date IS NOT NULL AND "episodes"."deleted_at" IS NULL ORDER BY published_date desc`)).
+		WithArgs(1, "publish").
+		WillReturnRows(rows)
+}
+
+func TestPodcastFeed(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	test.MockServer()
+	defer gock.DisableNetworking()
+
+	testServer := httptest.NewServer(service.RegisterFeedsRoutes())
+	gock.New(testServer.URL).EnableNetworking().Persist()
+	defer gock.DisableNetworking()
+	defer testServer.Close()
+
+	// create httpexpect instance
+	e := httpexpect.New(t, testServer.URL)
+
+	t.Run("get podcast feed", func(t *testing.T) {
+		podcastFeedMock(mock, 0)
+
+		res := e.GET(feedPath).
+			WithPath("space_id", "1").
+			WithPath("podcast_slug", Data["slug"]).
+			Expect().
+			Status(http.StatusOK)
+
+		res.ContentType("application/rss+xml")
+		body := res.Body()
+		body.Contains(`xmlns:podcast="https://podcastindex.org/namespace/1.0"`)
+		body.Contains(`<pubDate>` + episodePublishedDate.Format(time.RFC1123Z) + `</pubDate>`)
+		body.Contains(`<lastBuildDate>` + episodeUpdatedAt.Format(time.RFC1123Z) + `</lastBuildDate>`)
+		body.Contains(`<enclosure url="https://testaddress.com/episode.mp3" length="1024" type="audio/mpeg"></enclosure>`)
+		body.Contains(`<itunes:author>abc cba</itunes:author>`)
+		body.Contains(`<itunes:duration>1800</itunes:duration>`)
+		body.Contains(`<itunes:explicit>false</itunes:explicit>`)
+		body.Contains(`<itunes:episodeType>full</itunes:episodeType>`)
+		body.Contains(`<itunes:season>1</itunes:season>`)
+		body.Contains(`<itunes:episode>2</itunes:episode>`)
+		body.Contains(`<podcast:transcript url="https://testaddress.com/episode.vtt" type="text/vtt"></podcast:transcript>`)
+		body.Contains(`<podcast:chapters url="https://testaddress.com/chapters.json" type="application/json+chapters"></podcast:chapters>`)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("podcast with explicit episodes", func(t *testing.T) {
+		podcastFeedMock(mock, 1)
+
+		e.GET(feedPath).
+			WithPath("space_id", "1").
+			WithPath("podcast_slug", Data["slug"]).
+			Expect().
+			Status(http.StatusOK).
+			Body().
+			NotContains(`<itunes:explicit>false</itunes:explicit>`)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("legacy episode published before statuses", func(t *testing.T) {
+		// the episode was created without a status and is published by the
+		// backfill of the migration
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "episodes" SET "status"=$1 WHERE (status IS NULL OR status = '') AND published_date IS NOT NULL`)).
+			WithArgs("publish").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "episodes" SET "status"=$1 WHERE (status IS NULL OR status = '')`)).
+			WithArgs("draft").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		model.BackfillEpisodeStatus()
+
+		podcastMock(mock, 0)
+		podcastEpisodesMock(mock, sqlmock.NewRows(episodeColumns).
+			AddRow(2, episodeUpdatedAt, "Pilot", "pilot", 0, 0, "https://testaddress.com/pilot.mp3", 0, 0, "", false, "", "", "", "publish", episodePublishedDate, 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episode_authors"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "episode_id", "author_id"}))
+
+		body := e.GET(feedPath).
+			WithPath("space_id", "1").
+			WithPath("podcast_slug", Data["slug"]).
+			Expect().
+			Status(http.StatusOK).
+			Body()
+		body.Contains(`<title>Pilot</title>`)
+		body.Contains(`<enclosure url="https://testaddress.com/pilot.mp3"`)
+		body.Contains(`<pubDate>` + episodePublishedDate.Format(time.RFC1123Z) + `</pubDate>`)
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("podcast not found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "spaces"`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organisation_id"}).AddRow(1, "Test Space", 1))
+		mock.ExpectQuery(selectQuery).
+			WithArgs(Data["slug"], 1).
+			WillReturnRows(sqlmock.NewRows(Columns))
+
+		e.GET(feedPath).
+			WithPath("space_id", "1").
+			WithPath("podcast_slug", Data["slug"]).
+			Expect().
+			Status(http.StatusNotFound)
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,71 @@
+package relay
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service/relay"
+	"github.com/factly/dega-server/test"
+	"github.com/factly/dega-server/util"
+	"github.com/nats-io/nats.go"
+)
+
+var columns = []string{"id", "created_at", "updated_at", "subject", "payload", "status", "attempts", "last_error", "published_at"}
+
+var selectQuery = regexp.QuoteMeta(`SELECT * FROM "outbox_events" WHERE status = $1 ORDER BY id asc LIMIT 100 FOR UPDATE SKIP LOCKED`)
+
+func TestRelayRun(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	server := test.RunDefaultNATSServer()
+	defer server.Shutdown()
+
+	util.ConnectNats()
+	defer util.NC.Close()
+
+	received := make(chan *nats.Msg, 1)
+	sub, err := util.NC.Conn.ChanSubscribe("tag.created", received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+
+	t.Run("nothing to publish", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectQuery).
+			WithArgs(util.OutboxPending).
+			WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectCommit()
+
+		relay.Run()
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("publish pending event", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectQuery).
+			WithArgs(util.OutboxPending).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, time.Now(), time.Now(), "tag.created", []byte(`{"id":1,"name":"Elections"}`), util.OutboxPending, 0, "", nil))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_events" SET "last_error"=$1,"published_at"=$2,"status"=$3,"updated_at"=$4 WHERE "id" = $5`)).
+			WithArgs("", test.AnyTime{}, util.OutboxPublished, test.AnyTime{}, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		relay.Run()
+		test.ExpectationsMet(t, mock)
+
+		select {
+		case msg := <-received:
+			if string(msg.Data) != `{"id":1,"name":"Elections"}` {
+				t.Errorf("unexpected payload %s", msg.Data)
+			}
+		case <-time.After(2 * time.Second):
+			t.Error("event was not published")
+		}
+	})
+}
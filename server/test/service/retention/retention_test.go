@@ -0,0 +1,68 @@
+package retention
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service/retention"
+	"github.com/factly/dega-server/test"
+	"github.com/spf13/viper"
+)
+
+var columns = []string{"id", "title", "slug", "space_id", "deleted_at"}
+
+// tables of the entities kept in the trash, in the order they are purged
+var tables = []string{"posts", "posts", "episodes", "podcasts", "claims", "videos", "claimants", "ratings", "categories", "tags", "formats", "menus", "media"}
+
+func TestRetentionRun(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	t.Run("trash kept until purged by hand", func(t *testing.T) {
+		retention.Run()
+		test.ExpectationsMet(t, mock)
+	})
+
+	t.Run("purge expired entities", func(t *testing.T) {
+		viper.Set("trash_retention_days", 30)
+		defer viper.Set("trash_retention_days", nil)
+
+		for _, table := range tables {
+			rows := sqlmock.NewRows(columns)
+			if table == "tags" {
+				rows.AddRow(1, "Elections", "elections", 1, time.Now().AddDate(0, 0, -31))
+			}
+			query := mock.ExpectQuery(regexp.QuoteMeta(`FROM "` + table + `" WHERE deleted_at IS NOT NULL AND deleted_at < $1`)).
+				WillReturnRows(rows)
+			if table == "posts" {
+				query.WithArgs(test.AnyTime{}, sqlmock.AnyArg())
+			} else {
+				query.WithArgs(test.AnyTime{})
+			}
+
+			if table != "tags" {
+				continue
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE "tags"."id" = $1`)).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "space_id"}).AddRow(1, "Elections", "elections", 1))
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM post_tags WHERE tag_id = $1`)).
+				WithArgs(1).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM video_tags WHERE tag_id = $1`)).
+				WithArgs(1).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "tags" WHERE "tags"."id" = $1`)).
+				WithArgs(1).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}
+
+		retention.Run()
+		test.ExpectationsMet(t, mock)
+	})
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/factly/dega-server/service/scheduler"
+	"github.com/factly/dega-server/test"
+)
+
+var postColumns = []string{"id", "created_at", "updated_at", "deleted_at", "created_by_id", "updated_by_id", "title", "slug", "status", "is_page", "published_date", "space_id"}
+
+func TestSchedulerRun(t *testing.T) {
+	mock := test.SetupMockDB()
+
+	t.Run("nothing to publish", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts" WHERE (status = $1 AND published_date <= $2) AND is_page = $3`)).
+			WithArgs("scheduled", test.AnyTime{}, false).
+			WillReturnRows(sqlmock.NewRows(postColumns))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts" WHERE (status = $1 AND published_date <= $2) AND is_page = $3`)).
+			WithArgs("scheduled", test.AnyTime{}, true).
+			WillReturnRows(sqlmock.NewRows(postColumns))
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "episodes" WHERE (status = $1 AND published_date <= $2)`)).
+			WithArgs("scheduled", test.AnyTime{}).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "published_date", "space_id"}))
+
+		scheduler.Run()
+		test.ExpectationsMet(t, mock)
+	})
+
+}
@@ -19,3 +19,12 @@ func HTMLDescription(jsonData postgres.Jsonb) (string, error) {
 	}
 	return description, nil
 }
+
+// NilJsonb returns the JSON null, sent as the description of entities
+// without one
+func NilJsonb() postgres.Jsonb {
+	ba, _ := json.Marshal(nil)
+	return postgres.Jsonb{
+		RawMessage: ba,
+	}
+}
@@ -0,0 +1,113 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName  xml.Name     `xml:"feed"`
+	NS       string       `xml:"xmlns,attr"`
+	SchemaNS string       `xml:"xmlns:schema,attr"`
+	Lang     string       `xml:"xml:lang,attr,omitempty"`
+	ID       string       `xml:"id"`
+	Title    string       `xml:"title"`
+	Subtitle string       `xml:"subtitle,omitempty"`
+	Updated  string       `xml:"updated"`
+	Links    []atomLink   `xml:"link"`
+	Logo     string       `xml:"logo,omitempty"`
+	Author   *atomPerson  `xml:"author"`
+	Entries  []*atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID           string           `xml:"id"`
+	Title        string           `xml:"title"`
+	Updated      string           `xml:"updated"`
+	Published    string           `xml:"published,omitempty"`
+	Links        []atomLink       `xml:"link"`
+	Authors      []atomPerson     `xml:"author"`
+	Categories   []atomCategory   `xml:"category"`
+	Summary      *atomText        `xml:"summary"`
+	Content      *atomText        `xml:"content"`
+	ClaimReviews []xmlClaimReview `xml:"schema:ClaimReview"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email,omitempty"`
+	URI   string `xml:"uri,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+func writeAtom(w io.Writer, feed *Feed) error {
+	updated := feed.Updated
+	for _, item := range feed.Items {
+		if item.Updated.After(updated) {
+			updated = item.Updated
+		}
+	}
+
+	result := atomFeed{
+		NS:       "http://www.w3.org/2005/Atom",
+		SchemaNS: schemaNS,
+		Lang:     feed.Language,
+		ID:       feed.Link,
+		Title:    feed.Title,
+		Subtitle: feed.Subtitle,
+		Updated:  updated.Format(time.RFC3339),
+		Links:    []atomLink{{Href: feed.Link, Rel: "alternate"}},
+		// entries without authors fall back to the author of the feed
+		Author: &atomPerson{Name: feed.Title},
+	}
+	if feed.Image != nil {
+		result.Logo = feed.Image.URL
+	}
+
+	for _, item := range feed.Items {
+		entry := &atomEntry{
+			ID:           item.Link,
+			Title:        item.Title,
+			Updated:      item.Updated.Format(time.RFC3339),
+			Links:        []atomLink{{Href: item.Link, Rel: "alternate"}},
+			ClaimReviews: xmlClaimReviews(item.ClaimReviews),
+		}
+		if !item.Published.IsZero() {
+			entry.Published = item.Published.Format(time.RFC3339)
+		}
+		if item.Image != nil {
+			entry.Links = append(entry.Links, atomLink{Href: item.Image.URL, Rel: "enclosure", Type: item.Image.Type, Length: item.Image.Length})
+		}
+		for _, author := range item.Authors {
+			entry.Authors = append(entry.Authors, atomPerson{Name: author.Name, Email: author.Email, URI: author.URL})
+		}
+		for _, term := range append(append([]string{}, item.Categories...), item.Tags...) {
+			entry.Categories = append(entry.Categories, atomCategory{Term: term})
+		}
+		if item.Summary != "" {
+			entry.Summary = &atomText{Type: "text", Body: item.Summary}
+		}
+		if item.Content != "" {
+			entry.Content = &atomText{Type: "html", Body: item.Content}
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	return writeXML(w, result)
+}
@@ -0,0 +1,139 @@
+package feed
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-server/config"
+)
+
+// Format of a feed
+type Format string
+
+const (
+	// RSS 2.0
+	RSS Format = "rss2"
+	// Atom 1.0
+	Atom Format = "atom"
+	// JSON Feed 1.1
+	JSON Format = "json"
+)
+
+// Formats - the formats the feeds are served in, each one under
+// /feeds/{format}
+var Formats = []Format{RSS, Atom, JSON}
+
+// content types of the formats, also accepted for content negotiation
+var contentTypes = map[Format][]string{
+	RSS:  {"application/rss+xml", "application/xml", "text/xml"},
+	Atom: {"application/atom+xml"},
+	JSON: {"application/feed+json", "application/json"},
+}
+
+var feedFormat config.ContextKey = "feed_format"
+
+// Feed of the posts of a space
+type Feed struct {
+	ID          string
+	Title       string
+	Subtitle    string
+	Link        string
+	Description string
+	// Language is empty for the feeds of all the locales
+	Language string
+	Image    *Image
+	Updated  time.Time
+	Items    []*Item
+}
+
+// Image of a feed
+type Image struct {
+	Title string
+	URL   string
+}
+
+// Item of a feed
+type Item struct {
+	ID         string
+	Title      string
+	Link       string
+	Summary    string
+	Content    string
+	Published  time.Time
+	Updated    time.Time
+	Authors    []Author
+	Categories []string
+	Tags       []string
+	Image      *Enclosure
+	// ClaimReviews of fact-check posts, written as extension fields
+	ClaimReviews []ClaimReview
+}
+
+// Author of an item
+type Author struct {
+	Name  string
+	Email string
+	URL   string
+}
+
+// Enclosure - a file attached to an item
+type Enclosure struct {
+	URL    string
+	Type   string
+	Length int64
+}
+
+// ClaimReview - a claim of a fact-check and its rating
+type ClaimReview struct {
+	Claim       string
+	Claimant    string
+	Rating      string
+	RatingValue int
+}
+
+// As - middleware that serves the feed in the format, instead of the one
+// negotiated from the Accept header
+func As(format Format) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), feedFormat, format)))
+		})
+	}
+}
+
+// GetFormat returns the format to serve the feed in, RSS when the client
+// accepts none of the formats
+func GetFormat(r *http.Request) Format {
+	if format, ok := r.Context().Value(feedFormat).(Format); ok {
+		return format
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType := strings.TrimSpace(strings.Split(accepted, ";")[0])
+		for _, format := range Formats {
+			for _, contentType := range contentTypes[format] {
+				if mediaType == contentType {
+					return format
+				}
+			}
+		}
+	}
+	return RSS
+}
+
+// Write writes the feed in the format of the request
+func Write(w http.ResponseWriter, r *http.Request, feed *Feed) error {
+	format := GetFormat(r)
+	w.Header().Set("Content-Type", contentTypes[format][0]+"; charset=utf-8")
+
+	switch format {
+	case Atom:
+		return writeAtom(w, feed)
+	case JSON:
+		return writeJSON(w, feed)
+	default:
+		return writeRSS(w, feed)
+	}
+}
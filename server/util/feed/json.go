@@ -0,0 +1,107 @@
+package feed
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type jsonFeed struct {
+	Version     string      `json:"version"`
+	Title       string      `json:"title"`
+	HomePageURL string      `json:"home_page_url,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Icon        string      `json:"icon,omitempty"`
+	Language    string      `json:"language,omitempty"`
+	Items       []*jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string         `json:"id"`
+	URL           string         `json:"url,omitempty"`
+	Title         string         `json:"title,omitempty"`
+	ContentHTML   string         `json:"content_html,omitempty"`
+	Summary       string         `json:"summary,omitempty"`
+	Image         string         `json:"image,omitempty"`
+	DatePublished *time.Time     `json:"date_published,omitempty"`
+	DateModified  *time.Time     `json:"date_modified,omitempty"`
+	Authors       []jsonAuthor   `json:"authors,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	FactCheck     *jsonFactCheck `json:"_fact_check,omitempty"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// jsonFactCheck - extension of the items of fact-check posts
+type jsonFactCheck struct {
+	ClaimReviews []jsonClaimReview `json:"claim_reviews"`
+}
+
+type jsonClaimReview struct {
+	ClaimReviewed string     `json:"claim_reviewed"`
+	Claimant      string     `json:"claimant,omitempty"`
+	Rating        jsonRating `json:"rating"`
+}
+
+type jsonRating struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func writeJSON(w io.Writer, feed *Feed) error {
+	result := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: feed.Link,
+		Description: feed.Description,
+		Language:    feed.Language,
+		Items:       make([]*jsonItem, 0),
+	}
+	if feed.Image != nil {
+		result.Icon = feed.Image.URL
+	}
+
+	for _, item := range feed.Items {
+		each := &jsonItem{
+			ID:          item.ID,
+			URL:         item.Link,
+			Title:       item.Title,
+			ContentHTML: item.Content,
+			Summary:     item.Summary,
+			Tags:        append(append([]string{}, item.Categories...), item.Tags...),
+		}
+		if item.Image != nil {
+			each.Image = item.Image.URL
+		}
+		if !item.Published.IsZero() {
+			published := item.Published
+			each.DatePublished = &published
+		}
+		if !item.Updated.IsZero() {
+			updated := item.Updated
+			each.DateModified = &updated
+		}
+		for _, author := range item.Authors {
+			each.Authors = append(each.Authors, jsonAuthor{Name: author.Name, URL: author.URL})
+		}
+		if len(item.ClaimReviews) > 0 {
+			each.FactCheck = &jsonFactCheck{ClaimReviews: make([]jsonClaimReview, 0)}
+			for _, review := range item.ClaimReviews {
+				each.FactCheck.ClaimReviews = append(each.FactCheck.ClaimReviews, jsonClaimReview{
+					ClaimReviewed: review.Claim,
+					Claimant:      review.Claimant,
+					Rating:        jsonRating{Name: review.Rating, Value: review.RatingValue},
+				})
+			}
+		}
+		result.Items = append(result.Items, each)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
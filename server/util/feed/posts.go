@@ -0,0 +1,128 @@
+package feed
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-server/config"
+	"github.com/factly/dega-server/service/core/model"
+	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
+	"github.com/factly/dega-server/util/permalink"
+)
+
+// New returns the feed of the space, without items
+func New(space model.Space, locale string) *Feed {
+	feed := &Feed{
+		ID:          fmt.Sprint(space.ID),
+		Title:       space.Name,
+		Subtitle:    space.TagLine,
+		Link:        space.SiteAddress,
+		Description: space.Description,
+		Language:    locale,
+		Updated:     time.Now(),
+	}
+
+	if space.Logo != nil {
+		if rawURL := rawURL(*space.Logo); rawURL != "" {
+			feed.Image = &Image{Title: space.Logo.Name, URL: rawURL}
+		}
+	}
+	return feed
+}
+
+// Items returns the items of the posts, along with their authors from the
+// author map and the claim reviews of the fact-checks. The posts are expected
+// to be loaded along with their Format, Medium, Tags and Categories.
+func Items(postList []model.Post, space model.Space, authorMap map[string]model.Author) []*Item {
+	postIDs := make([]uint, 0)
+	factCheckIDs := make([]uint, 0)
+	for _, post := range postList {
+		postIDs = append(postIDs, post.ID)
+		if post.Format != nil && post.Format.Slug == "fact-check" {
+			factCheckIDs = append(factCheckIDs, post.ID)
+		}
+	}
+
+	postAuthors := make([]model.PostAuthor, 0)
+	if len(postIDs) > 0 {
+		config.DB.Model(&model.PostAuthor{}).Where("post_id IN (?)", postIDs).Find(&postAuthors)
+	}
+
+	postClaims := make([]factCheckModel.PostClaim, 0)
+	if len(factCheckIDs) > 0 {
+		config.DB.Model(&factCheckModel.PostClaim{}).Where("post_id IN (?)", factCheckIDs).Preload("Claim").Preload("Claim.Rating").Preload("Claim.Claimant").Order("position").Find(&postClaims)
+	}
+
+	urls := permalink.New(space)
+	itemMap := make(map[uint]*Item)
+	itemList := make([]*Item, 0)
+	for _, post := range postList {
+		item := &Item{
+			ID:      fmt.Sprint(post.ID),
+			Title:   post.Title,
+			Link:    urls.Post(post),
+			Summary: post.Excerpt,
+			Content: post.HTMLDescription,
+			Updated: post.UpdatedAt,
+		}
+		if post.PublishedDate != nil {
+			item.Published = *post.PublishedDate
+		}
+		for _, category := range post.Categories {
+			item.Categories = append(item.Categories, category.Name)
+		}
+		for _, tag := range post.Tags {
+			item.Tags = append(item.Tags, tag.Name)
+		}
+		if post.Medium != nil {
+			if rawURL := rawURL(*post.Medium); rawURL != "" {
+				item.Image = &Enclosure{URL: rawURL, Type: post.Medium.Type, Length: post.Medium.FileSize}
+			}
+		}
+
+		itemMap[post.ID] = item
+		itemList = append(itemList, item)
+	}
+
+	for _, po := range postAuthors {
+		author, found := authorMap[fmt.Sprint(po.AuthorID)]
+		item := itemMap[po.PostID]
+		if !found || item == nil {
+			continue
+		}
+		name := strings.TrimSpace(fmt.Sprint(author.FirstName, " ", author.LastName))
+		if name == "" {
+			name = author.DisplayName
+		}
+		if name == "" {
+			continue
+		}
+		each := Author{Name: name, Email: author.Email}
+		if author.Slug != "" {
+			each.URL = urls.Author(author)
+		}
+		item.Authors = append(item.Authors, each)
+	}
+
+	for _, pc := range postClaims {
+		if item := itemMap[pc.PostID]; item != nil {
+			item.ClaimReviews = append(item.ClaimReviews, ClaimReview{
+				Claim:       pc.Claim.Claim,
+				Claimant:    pc.Claim.Claimant.Name,
+				Rating:      pc.Claim.Rating.Name,
+				RatingValue: pc.Claim.Rating.NumericValue,
+			})
+		}
+	}
+
+	return itemList
+}
+
+func rawURL(medium model.Medium) string {
+	urlMap := map[string]interface{}{}
+	_ = json.Unmarshal(medium.URL.RawMessage, &urlMap)
+	rawURL, _ := urlMap["raw"].(string)
+	return rawURL
+}
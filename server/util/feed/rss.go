@@ -0,0 +1,154 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+type rssFeed struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	ContentNS    string     `xml:"xmlns:content,attr"`
+	DublinCoreNS string     `xml:"xmlns:dc,attr"`
+	SchemaNS     string     `xml:"xmlns:schema,attr"`
+	Channel      rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	Language      string     `xml:"language,omitempty"`
+	LastBuildDate string     `xml:"lastBuildDate,omitempty"`
+	Image         *rssImage  `xml:"image"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssImage struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type rssItem struct {
+	Title        string           `xml:"title"`
+	Link         string           `xml:"link"`
+	Description  string           `xml:"description,omitempty"`
+	Content      *rssContent      `xml:"content:encoded"`
+	Creators     []string         `xml:"dc:creator"`
+	Categories   []string         `xml:"category"`
+	Enclosure    *rssEnclosure    `xml:"enclosure"`
+	GUID         rssGUID          `xml:"guid"`
+	PubDate      string           `xml:"pubDate,omitempty"`
+	ClaimReviews []xmlClaimReview `xml:"schema:ClaimReview"`
+}
+
+type rssContent struct {
+	Content string `xml:",cdata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssGUID struct {
+	ID          string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+// xmlClaimReview - the claim review of an item in the schema.org vocabulary,
+// shared by the RSS and Atom feeds
+type xmlClaimReview struct {
+	ClaimReviewed string    `xml:"schema:claimReviewed"`
+	ItemReviewed  *xmlClaim `xml:"schema:itemReviewed"`
+	ReviewRating  xmlRating `xml:"schema:reviewRating"`
+}
+
+type xmlClaim struct {
+	Author string `xml:"schema:author"`
+}
+
+type xmlRating struct {
+	RatingValue   int    `xml:"schema:ratingValue"`
+	AlternateName string `xml:"schema:alternateName"`
+}
+
+const schemaNS = "https://schema.org/"
+
+func writeRSS(w io.Writer, feed *Feed) error {
+	channel := rssChannel{
+		Title:       feed.Title,
+		Link:        feed.Link,
+		Description: feed.Description,
+		Language:    feed.Language,
+	}
+	if !feed.Updated.IsZero() {
+		channel.LastBuildDate = feed.Updated.Format(time.RFC1123Z)
+	}
+	if feed.Image != nil {
+		channel.Image = &rssImage{URL: feed.Image.URL, Title: feed.Image.Title, Link: feed.Link}
+	}
+
+	for _, item := range feed.Items {
+		each := &rssItem{
+			Title:        item.Title,
+			Link:         item.Link,
+			Description:  item.Summary,
+			Categories:   append(append([]string{}, item.Categories...), item.Tags...),
+			GUID:         rssGUID{ID: item.ID},
+			ClaimReviews: xmlClaimReviews(item.ClaimReviews),
+		}
+		if item.Content != "" {
+			each.Content = &rssContent{Content: item.Content}
+		}
+		for _, author := range item.Authors {
+			each.Creators = append(each.Creators, author.Name)
+		}
+		if item.Image != nil {
+			each.Enclosure = &rssEnclosure{URL: item.Image.URL, Length: item.Image.Length, Type: item.Image.Type}
+		}
+		if !item.Published.IsZero() {
+			each.PubDate = item.Published.Format(time.RFC1123Z)
+		}
+		channel.Items = append(channel.Items, each)
+	}
+
+	return writeXML(w, rssFeed{
+		Version:      "2.0",
+		ContentNS:    "http://purl.org/rss/1.0/modules/content/",
+		DublinCoreNS: "http://purl.org/dc/elements/1.1/",
+		SchemaNS:     schemaNS,
+		Channel:      channel,
+	})
+}
+
+func xmlClaimReviews(reviews []ClaimReview) []xmlClaimReview {
+	result := make([]xmlClaimReview, 0)
+	for _, review := range reviews {
+		each := xmlClaimReview{
+			ClaimReviewed: review.Claim,
+			ReviewRating: xmlRating{
+				RatingValue:   review.RatingValue,
+				AlternateName: review.Rating,
+			},
+		}
+		if review.Claimant != "" {
+			each.ItemReviewed = &xmlClaim{Author: review.Claimant}
+		}
+		result = append(result, each)
+	}
+	return result
+}
+
+func writeXML(w io.Writer, feed interface{}) error {
+	if _, err := fmt.Fprint(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(feed)
+}
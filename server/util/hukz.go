@@ -0,0 +1,58 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/renderx"
+	"github.com/factly/x/requestx"
+	"github.com/spf13/viper"
+)
+
+// CheckHukz checks if webhooks are to be delivered by hukz, which is the case
+// when hukz is enabled and dega-server does not deliver them itself
+func CheckHukz() bool {
+	return viper.IsSet("enable_hukz") && viper.GetBool("enable_hukz") && !CheckWebhooks()
+}
+
+// HukzRequest forwards a request of the user to hukz and renders its response
+// with the given status
+func HukzRequest(w http.ResponseWriter, method, path string, body interface{}, uID, status int) {
+	resp, err := requestx.Request(method, viper.GetString("hukz_url")+path, body, map[string]string{
+		"X-User": fmt.Sprint(uID),
+	})
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		errorx.Render(w, errorx.Parser(errorx.RecordNotFound()))
+		return
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		errorx.Render(w, errorx.Parser(errorx.CannotSaveChanges()))
+		return
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	var result interface{}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil && err != io.EOF {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	renderx.JSON(w, status, result)
+}
@@ -0,0 +1,77 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/rwcarlsen/goexif/tiff"
+)
+
+// exif fields stored with the media, gps fields are left out so that uploads
+// do not publish where they were taken
+var exifFields = []exif.FieldName{
+	exif.Make,
+	exif.Model,
+	exif.LensModel,
+	exif.Software,
+	exif.Artist,
+	exif.Copyright,
+	exif.DateTimeOriginal,
+	exif.ExposureTime,
+	exif.FNumber,
+	exif.ISOSpeedRatings,
+	exif.FocalLength,
+	exif.Flash,
+	exif.Orientation,
+}
+
+// EXIF returns the exif fields of an image, nil when it has none
+func EXIF(data []byte) map[string]interface{} {
+	x, err := exif.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	for _, name := range exifFields {
+		tag, err := x.Get(name)
+		if err != nil || tag.Count == 0 {
+			continue
+		}
+
+		switch tag.Format() {
+		case tiff.StringVal:
+			if value, err := tag.StringVal(); err == nil && value != "" {
+				fields[string(name)] = value
+			}
+		case tiff.IntVal:
+			if value, err := tag.Int(0); err == nil {
+				fields[string(name)] = value
+			}
+		case tiff.RatVal:
+			num, den, err := tag.Rat2(0)
+			if err != nil || den == 0 {
+				continue
+			}
+			if name == exif.ExposureTime && num < den {
+				fields[string(name)] = fmt.Sprint(num, "/", den)
+			} else {
+				fields[string(name)] = float64(num) / float64(den)
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// orientation returns the exif Orientation of an image, 1 when it has none
+func orientation(fields map[string]interface{}) int {
+	if value, ok := fields[string(exif.Orientation)].(int); ok {
+		return value
+	}
+	return 1
+}
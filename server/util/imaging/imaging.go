@@ -0,0 +1,147 @@
+// Package imaging processes uploaded images: it reads their real dimensions
+// and type, turns them upright, creates responsive renditions and lossless
+// webp variants, computes a blurhash placeholder and extracts exif data.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif" // registers the gif decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// RenditionWidths are the widths of the renditions of an image, only the
+// ones narrower than the image are created
+var RenditionWidths = []int{320, 640, 1024, 1600, 2048}
+
+// maxPixels limits the size of decoded images
+const maxPixels = 50000000
+
+// ErrTooLarge is returned for images with more than maxPixels pixels
+var ErrTooLarge = errors.New("image is too large")
+
+// Rendition is a copy of the image resized to a width
+type Rendition struct {
+	MIMEType string
+	Width    int
+	Height   int
+	Data     []byte
+	WebP     []byte
+}
+
+// Result of processing an uploaded file. Files that are not jpeg, png or
+// gif images only have their MIME type.
+type Result struct {
+	MIMEType   string
+	Width      int
+	Height     int
+	Blurhash   string
+	WebP       []byte
+	Renditions []Rendition
+	EXIF       map[string]interface{}
+}
+
+// IsImage says whether the MIME type is of an image that can be processed
+func IsImage(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Process processes an uploaded file
+func Process(data []byte) (*Result, error) {
+	result := &Result{
+		MIMEType: http.DetectContentType(data),
+	}
+	if !IsImage(result.MIMEType) {
+		return result, nil
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MIMEType == "image/jpeg" {
+		result.EXIF = EXIF(data)
+	}
+	upright := Orient(img, orientation(result.EXIF))
+
+	result.Width, result.Height = upright.Rect.Dx(), upright.Rect.Dy()
+	result.Blurhash = Blurhash(upright, 4, 3)
+
+	if result.Width <= maxWebPSize && result.Height <= maxWebPSize {
+		if result.WebP, err = WebP(upright); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, width := range RenditionWidths {
+		if width >= result.Width {
+			break
+		}
+
+		resized := Resize(upright, width)
+		rendition := Rendition{
+			MIMEType: "image/png",
+			Width:    resized.Rect.Dx(),
+			Height:   resized.Rect.Dy(),
+		}
+
+		// renditions of gifs are not animated, they are stored as png
+		var buf bytes.Buffer
+		if result.MIMEType == "image/jpeg" {
+			rendition.MIMEType = "image/jpeg"
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&buf, resized)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rendition.Data = buf.Bytes()
+
+		if rendition.WebP, err = WebP(resized); err != nil {
+			return nil, err
+		}
+
+		result.Renditions = append(result.Renditions, rendition)
+	}
+
+	return result, nil
+}
+
+// extensions of the MIME types of the files that can be uploaded, the types
+// browsers can run scripts of, like html and svg, are left out
+var extensions = map[string]string{
+	"image/jpeg":                ".jpg",
+	"image/png":                 ".png",
+	"image/gif":                 ".gif",
+	"image/webp":                ".webp",
+	"application/pdf":           ".pdf",
+	"audio/mpeg":                ".mp3",
+	"audio/wave":                ".wav",
+	"application/ogg":           ".ogg",
+	"video/mp4":                 ".mp4",
+	"video/webm":                ".webm",
+	"text/plain; charset=utf-8": ".txt",
+}
+
+// Extension returns the file extension of a MIME type detected by Process,
+// it is empty for the types which are not allowed to be uploaded
+func Extension(mimeType string) string {
+	return extensions[mimeType]
+}
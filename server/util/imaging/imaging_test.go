@@ -0,0 +1,154 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+// testImage draws gradients in the colors, with transparent pixels when the
+// image has alpha
+func testImage(width, height int, alpha bool) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			a := uint8(0xff)
+			if alpha {
+				a = uint8((x * y) % 256)
+			}
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 7), G: uint8(y * 13), B: uint8((x + y) * 3), A: a})
+		}
+	}
+	return img
+}
+
+func TestWebP(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		alpha  bool
+	}{
+		{name: "single pixel", width: 1, height: 1},
+		{name: "opaque", width: 67, height: 45},
+		{name: "transparent", width: 45, height: 67, alpha: true},
+		{name: "wider than the predictor blocks", width: 300, height: 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img := testImage(test.width, test.height, test.alpha)
+
+			data, err := WebP(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded, err := webp.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if decoded.Bounds() != img.Bounds() {
+				t.Fatalf("expected bounds %v, got %v", img.Bounds(), decoded.Bounds())
+			}
+
+			// the encoding is lossless
+			for y := 0; y < test.height; y++ {
+				for x := 0; x < test.width; x++ {
+					expected := img.NRGBAAt(x, y)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if expected.A == 0 {
+						expected, got.R, got.G, got.B = color.NRGBA{}, 0, 0, 0
+					}
+					if got != expected {
+						t.Fatalf("pixel %d,%d: expected %v, got %v", x, y, expected, got)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("image too large", func(t *testing.T) {
+		if _, err := WebP(image.NewNRGBA(image.Rect(0, 0, maxWebPSize+1, 1))); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name           string
+		width, height  int
+		to             int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "landscape", width: 700, height: 350, to: 320, expectedWidth: 320, expectedHeight: 160},
+		{name: "portrait", width: 350, height: 700, to: 320, expectedWidth: 320, expectedHeight: 640},
+		{name: "rounded height", width: 1000, height: 333, to: 640, expectedWidth: 640, expectedHeight: 213},
+		{name: "thin image keeps a row", width: 2000, height: 1, to: 320, expectedWidth: 320, expectedHeight: 1},
+		{name: "width of at least a pixel", width: 10, height: 10, to: 0, expectedWidth: 1, expectedHeight: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resized := Resize(testImage(test.width, test.height, false), test.to)
+			if resized.Rect.Dx() != test.expectedWidth || resized.Rect.Dy() != test.expectedHeight {
+				t.Errorf("expected %dx%d, got %dx%d", test.expectedWidth, test.expectedHeight, resized.Rect.Dx(), resized.Rect.Dy())
+			}
+		})
+	}
+
+	t.Run("solid color is kept", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 100, 50))
+		for i := range img.Pix {
+			img.Pix[i] = 0x80
+		}
+
+		resized := Resize(img, 33)
+		for i, value := range resized.Pix {
+			if value != 0x80 {
+				t.Fatalf("byte %d: expected 0x80, got %#x", i, value)
+			}
+		}
+	})
+}
+
+func TestProcess(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(700, 350, false), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Process(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.MIMEType != "image/jpeg" || result.Width != 700 || result.Height != 350 {
+		t.Fatalf("unexpected result %s %dx%d", result.MIMEType, result.Width, result.Height)
+	}
+
+	// renditions are only created for the widths narrower than the image
+	if len(result.Renditions) != 2 {
+		t.Fatalf("expected 2 renditions, got %d", len(result.Renditions))
+	}
+	for i, width := range []int{320, 640} {
+		rendition := result.Renditions[i]
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(rendition.Data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rendition.Width != width || cfg.Width != width || cfg.Height != width/2 {
+			t.Errorf("rendition %d: expected %dx%d, got %dx%d", i, width, width/2, cfg.Width, cfg.Height)
+		}
+		if _, err = webp.DecodeConfig(bytes.NewReader(rendition.WebP)); err != nil {
+			t.Errorf("rendition %d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Resize scales the image to the width keeping its aspect ratio. Every pixel
+// is the weighted average of the source pixels it covers.
+func Resize(img image.Image, width int) *image.NRGBA {
+	src := toNRGBA(img)
+	bounds := src.Rect
+	if width < 1 {
+		width = 1
+	}
+	height := int(math.Round(float64(bounds.Dy()) * float64(width) / float64(bounds.Dx())))
+	if height < 1 {
+		height = 1
+	}
+
+	horizontal := resample(src.Pix, src.Stride, bounds.Dx(), bounds.Dy(), width, true)
+	vertical := resample(horizontal, 4*width, width, bounds.Dy(), height, false)
+
+	return &image.NRGBA{Pix: vertical, Stride: 4 * width, Rect: image.Rect(0, 0, width, height)}
+}
+
+// resample scales the rows or the columns of the pixels with a triangle
+// filter as wide as the scale, colors are weighted by their alpha
+func resample(pix []uint8, stride, width, height, size int, horizontal bool) []uint8 {
+	length, lines := height, width
+	if horizontal {
+		length, lines = width, height
+	}
+
+	dstStride, dstRows := 4*size, height
+	if !horizontal {
+		dstStride, dstRows = 4*width, size
+	}
+	dst := make([]uint8, dstStride*dstRows)
+
+	scale := float64(length) / float64(size)
+	radius := math.Max(scale, 1)
+
+	for i := 0; i < size; i++ {
+		center := (float64(i)+0.5)*scale - 0.5
+		start := int(math.Max(0, math.Ceil(center-radius)))
+		end := int(math.Min(float64(length-1), math.Floor(center+radius)))
+
+		weights := make([]float64, 0, end-start+1)
+		total := 0.0
+		for j := start; j <= end; j++ {
+			weight := 1 - math.Abs(float64(j)-center)/radius
+			if weight < 0 {
+				weight = 0
+			}
+			weights = append(weights, weight)
+			total += weight
+		}
+		if total == 0 {
+			weights[0], total = 1, 1
+		}
+
+		for line := 0; line < lines; line++ {
+			var r, g, b, a float64
+			for k, weight := range weights {
+				var p int
+				if horizontal {
+					p = line*stride + 4*(start+k)
+				} else {
+					p = (start+k)*stride + 4*line
+				}
+				alpha := float64(pix[p+3]) * weight
+				r += float64(pix[p]) * alpha
+				g += float64(pix[p+1]) * alpha
+				b += float64(pix[p+2]) * alpha
+				a += alpha
+			}
+
+			var q int
+			if horizontal {
+				q = line*dstStride + 4*i
+			} else {
+				q = i*dstStride + 4*line
+			}
+			if a > 0 {
+				dst[q] = clamp(r / a)
+				dst[q+1] = clamp(g / a)
+				dst[q+2] = clamp(b / a)
+			}
+			dst[q+3] = clamp(a / total)
+		}
+	}
+	return dst
+}
+
+func clamp(v float64) uint8 {
+	v = math.Round(v)
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	return nrgba
+}
@@ -0,0 +1,554 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"sort"
+)
+
+// the maximum width and height of a webp image
+const maxWebPSize = 1 << 14
+
+// size in bits of the blocks sharing a predictor
+const predictorBits = 4
+
+// order in which the lengths of the code length code are written
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// EncodeWebP writes the image in the lossless webp format. The pixels are
+// transformed with the subtract green and predictor transforms and written
+// as literals with a single group of prefix codes.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > maxWebPSize || height > maxWebPSize {
+		return errors.New("image size not supported by webp")
+	}
+
+	nrgba := toNRGBA(img)
+
+	argb := make([]uint32, width*height)
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		row := nrgba.Pix[y*nrgba.Stride:]
+		for x := 0; x < width; x++ {
+			r, g, b, a := row[4*x], row[4*x+1], row[4*x+2], row[4*x+3]
+			if a != 0xff {
+				hasAlpha = true
+			}
+			argb[y*width+x] = uint32(a)<<24 | uint32(r)<<16 | uint32(g)<<8 | uint32(b)
+		}
+	}
+
+	bw := &bitWriter{}
+	bw.write(0x2f, 8)
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	if hasAlpha {
+		bw.write(1, 1)
+	} else {
+		bw.write(0, 1)
+	}
+	bw.write(0, 3)
+
+	// subtract green transform
+	bw.write(1, 1)
+	bw.write(2, 2)
+	subtractGreen(argb)
+
+	// predictor transform
+	bw.write(1, 1)
+	bw.write(0, 2)
+	bw.write(predictorBits-2, 3)
+	modes, residuals := predict(argb, width, height)
+	writePixels(bw, modes, false)
+
+	bw.write(0, 1)
+	writePixels(bw, residuals, true)
+
+	data := bw.bytes()
+	chunkSize := len(data)
+	if len(data)%2 == 1 {
+		data = append(data, 0)
+	}
+
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(12+len(data)))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(chunkSize))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// WebP returns the image in the lossless webp format
+func WebP(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := EncodeWebP(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func subtractGreen(argb []uint32) {
+	for i, p := range argb {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		b := (p - g) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | b
+	}
+}
+
+// predict chooses the predictor of every block and returns the image of the
+// chosen modes and the residuals of the pixels
+func predict(argb []uint32, width, height int) ([]uint32, []uint32) {
+	size := 1 << predictorBits
+	tilesX := (width + size - 1) >> predictorBits
+	tilesY := (height + size - 1) >> predictorBits
+
+	modes := make([]uint32, tilesX*tilesY)
+	residuals := make([]uint32, len(argb))
+
+	for ty := 0; ty < tilesY; ty++ {
+		for tx := 0; tx < tilesX; tx++ {
+			bestMode, bestCost := 0, -1
+			for mode := 0; mode < 14; mode++ {
+				cost := 0
+				for y := ty * size; y < (ty+1)*size && y < height; y++ {
+					for x := tx * size; x < (tx+1)*size && x < width; x++ {
+						cost += residualCost(sub(argb[y*width+x], predictor(argb, width, x, y, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					bestMode, bestCost = mode, cost
+				}
+			}
+			modes[ty*tilesX+tx] = 0xff000000 | uint32(bestMode)<<8
+
+			for y := ty * size; y < (ty+1)*size && y < height; y++ {
+				for x := tx * size; x < (tx+1)*size && x < width; x++ {
+					residuals[y*width+x] = sub(argb[y*width+x], predictor(argb, width, x, y, bestMode))
+				}
+			}
+		}
+	}
+
+	return modes, residuals
+}
+
+// predictor returns the prediction of a pixel. The first pixel is predicted
+// as opaque black, the rest of the first row by the left pixel and the first
+// column by the top pixel.
+func predictor(argb []uint32, width, x, y, mode int) uint32 {
+	i := y*width + x
+	switch {
+	case x == 0 && y == 0:
+		return 0xff000000
+	case y == 0:
+		return argb[i-1]
+	case x == 0:
+		return argb[i-width]
+	}
+
+	// the top right pixel of the last column is the first pixel of the row
+	l, t, tl, tr := argb[i-1], argb[i-width], argb[i-width-1], argb[i-width+1]
+	switch mode {
+	case 0:
+		return 0xff000000
+	case 1:
+		return l
+	case 2:
+		return t
+	case 3:
+		return tr
+	case 4:
+		return tl
+	case 5:
+		return average(average(l, tr), t)
+	case 6:
+		return average(l, tl)
+	case 7:
+		return average(l, t)
+	case 8:
+		return average(tl, t)
+	case 9:
+		return average(t, tr)
+	case 10:
+		return average(average(l, tl), average(t, tr))
+	case 11:
+		return selectPixel(l, t, tl)
+	case 12:
+		return perChannel(l, t, tl, func(a, b, c int) int { return a + b - c })
+	default:
+		return perChannel(average(l, t), tl, 0, func(a, b, _ int) int { return a + (a-b)/2 })
+	}
+}
+
+func channel(p uint32, shift uint) int {
+	return int((p >> shift) & 0xff)
+}
+
+func average(a, b uint32) uint32 {
+	return (((a ^ b) & 0xfefefefe) >> 1) + (a & b)
+}
+
+func selectPixel(l, t, tl uint32) uint32 {
+	pl, pt := 0, 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		pl += abs(channel(tl, shift) - channel(t, shift))
+		pt += abs(channel(tl, shift) - channel(l, shift))
+	}
+	if pl < pt {
+		return l
+	}
+	return t
+}
+
+// perChannel combines the channels of the pixels clamping the results
+func perChannel(a, b, c uint32, f func(a, b, c int) int) uint32 {
+	var p uint32
+	for shift := uint(0); shift < 32; shift += 8 {
+		v := f(channel(a, shift), channel(b, shift), channel(c, shift))
+		if v < 0 {
+			v = 0
+		} else if v > 255 {
+			v = 255
+		}
+		p |= uint32(v) << shift
+	}
+	return p
+}
+
+// sub subtracts the channels modulo 256
+func sub(a, b uint32) uint32 {
+	var p uint32
+	for shift := uint(0); shift < 32; shift += 8 {
+		p |= uint32((channel(a, shift)-channel(b, shift))&0xff) << shift
+	}
+	return p
+}
+
+func residualCost(p uint32) int {
+	cost := 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		v := channel(p, shift)
+		if v > 128 {
+			v = 256 - v
+		}
+		cost += v
+	}
+	return cost
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// writePixels writes an entropy coded image without a color cache. Only the
+// main image says whether it uses meta prefix codes.
+func writePixels(bw *bitWriter, argb []uint32, main bool) {
+	bw.write(0, 1)
+	if main {
+		bw.write(0, 1)
+	}
+
+	green := make([]uint32, 256+24)
+	red := make([]uint32, 256)
+	blue := make([]uint32, 256)
+	alpha := make([]uint32, 256)
+	distance := make([]uint32, 40)
+	for _, p := range argb {
+		green[(p>>8)&0xff]++
+		red[(p>>16)&0xff]++
+		blue[p&0xff]++
+		alpha[p>>24]++
+	}
+
+	codes := make([]prefixCode, 0, 5)
+	for _, histogram := range [][]uint32{green, red, blue, alpha, distance} {
+		codes = append(codes, writePrefixCode(bw, histogram))
+	}
+
+	for _, p := range argb {
+		codes[0].write(bw, (p>>8)&0xff)
+		codes[1].write(bw, (p>>16)&0xff)
+		codes[2].write(bw, p&0xff)
+		codes[3].write(bw, p>>24)
+	}
+}
+
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+}
+
+func (c prefixCode) write(bw *bitWriter, symbol uint32) {
+	if n := c.lengths[symbol]; n > 0 {
+		bw.write(c.codes[symbol], uint(n))
+	}
+}
+
+// writePrefixCode writes the code of the histogram. Codes of one or two
+// symbols below 256 are written as simple codes, a single symbol takes no
+// bits.
+func writePrefixCode(bw *bitWriter, histogram []uint32) prefixCode {
+	used := make([]uint32, 0, 2)
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, uint32(symbol))
+			if len(used) > 2 {
+				break
+			}
+		}
+	}
+
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < 256) {
+		if len(used) == 0 {
+			used = append(used, 0)
+		}
+
+		bw.write(1, 1)
+		bw.write(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.write(0, 1)
+			bw.write(used[0], 1)
+		} else {
+			bw.write(1, 1)
+			bw.write(used[0], 8)
+		}
+
+		lengths := make([]uint8, len(histogram))
+		if len(used) == 2 {
+			bw.write(used[1], 8)
+			lengths[used[0]], lengths[used[1]] = 1, 1
+		}
+		return prefixCode{lengths: lengths, codes: canonicalCodes(lengths)}
+	}
+
+	lengths := codeLengths(histogram, 15)
+	bw.write(0, 1)
+	writeCodeLengths(bw, lengths)
+	return prefixCode{lengths: lengths, codes: canonicalCodes(lengths)}
+}
+
+type codeLengthToken struct {
+	symbol, extra uint32
+}
+
+// writeCodeLengths writes the lengths of a code with the code length code,
+// runs of zeros are written with the symbols 17 and 18
+func writeCodeLengths(bw *bitWriter, lengths []uint8) {
+	tokens := make([]codeLengthToken, 0, len(lengths))
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, codeLengthToken{symbol: uint32(lengths[i])})
+			i++
+			continue
+		}
+
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == 0 && run < 138 {
+			run++
+		}
+		switch {
+		case run < 3:
+			for j := 0; j < run; j++ {
+				tokens = append(tokens, codeLengthToken{symbol: 0})
+			}
+		case run <= 10:
+			tokens = append(tokens, codeLengthToken{symbol: 17, extra: uint32(run - 3)})
+		default:
+			tokens = append(tokens, codeLengthToken{symbol: 18, extra: uint32(run - 11)})
+		}
+		i += run
+	}
+
+	histogram := make([]uint32, 19)
+	for _, token := range tokens {
+		histogram[token.symbol]++
+	}
+	// a code length code needs two symbols
+	used := 0
+	for _, count := range histogram {
+		if count > 0 {
+			used++
+		}
+	}
+	for symbol := 0; used < 2; symbol++ {
+		if histogram[symbol] == 0 {
+			histogram[symbol] = 1
+			used++
+		}
+	}
+
+	clLengths := codeLengths(histogram, 7)
+	clCodes := canonicalCodes(clLengths)
+
+	n := len(codeLengthCodeOrder)
+	for n > 4 && clLengths[codeLengthCodeOrder[n-1]] == 0 {
+		n--
+	}
+	bw.write(uint32(n-4), 4)
+	for _, symbol := range codeLengthCodeOrder[:n] {
+		bw.write(uint32(clLengths[symbol]), 3)
+	}
+
+	// the lengths of all the symbols are written
+	bw.write(0, 1)
+
+	for _, token := range tokens {
+		bw.write(clCodes[token.symbol], uint(clLengths[token.symbol]))
+		switch token.symbol {
+		case 17:
+			bw.write(token.extra, 3)
+		case 18:
+			bw.write(token.extra, 7)
+		}
+	}
+}
+
+// codeLengths returns the lengths of a huffman code of the histogram no
+// longer than maxLength. Small counts are raised until the code fits.
+func codeLengths(histogram []uint32, maxLength int) []uint8 {
+	for minCount := uint32(1); ; minCount *= 2 {
+		counts := make([]uint32, len(histogram))
+		for symbol, count := range histogram {
+			if count > 0 && count < minCount {
+				count = minCount
+			}
+			counts[symbol] = count
+		}
+
+		lengths, longest := huffmanLengths(counts)
+		if longest <= maxLength {
+			return lengths
+		}
+	}
+}
+
+type huffmanNode struct {
+	count       uint64
+	symbol      int
+	left, right *huffmanNode
+}
+
+func huffmanLengths(counts []uint32) ([]uint8, int) {
+	lengths := make([]uint8, len(counts))
+
+	nodes := make([]*huffmanNode, 0)
+	for symbol, count := range counts {
+		if count > 0 {
+			nodes = append(nodes, &huffmanNode{count: uint64(count), symbol: symbol})
+		}
+	}
+	if len(nodes) == 1 {
+		lengths[nodes[0].symbol] = 1
+		return lengths, 1
+	}
+
+	// merge the two lightest nodes taking leaves from one queue and
+	// internal nodes from the other
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].count < nodes[j].count })
+	merged := make([]*huffmanNode, 0, len(nodes))
+	lightest := func() *huffmanNode {
+		if len(merged) == 0 || (len(nodes) > 0 && nodes[0].count <= merged[0].count) {
+			node := nodes[0]
+			nodes = nodes[1:]
+			return node
+		}
+		node := merged[0]
+		merged = merged[1:]
+		return node
+	}
+	for len(nodes)+len(merged) > 1 {
+		a := lightest()
+		b := lightest()
+		merged = append(merged, &huffmanNode{count: a.count + b.count, symbol: -1, left: a, right: b})
+	}
+
+	longest := 0
+	var walk func(node *huffmanNode, depth int)
+	walk = func(node *huffmanNode, depth int) {
+		if node.left == nil {
+			lengths[node.symbol] = uint8(depth)
+			if depth > longest {
+				longest = depth
+			}
+			return
+		}
+		walk(node.left, depth+1)
+		walk(node.right, depth+1)
+	}
+	walk(merged[0], 0)
+
+	return lengths, longest
+}
+
+// canonicalCodes returns the canonical codes of the lengths with their bits
+// reversed, as codes are read from the most significant bit
+func canonicalCodes(lengths []uint8) []uint32 {
+	var count [16]uint32
+	for _, n := range lengths {
+		if n > 0 {
+			count[n]++
+		}
+	}
+
+	var next [16]uint32
+	code := uint32(0)
+	for n := 1; n < 16; n++ {
+		code = (code + count[n-1]) << 1
+		next[n] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for symbol, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		code := next[n]
+		next[n]++
+
+		reversed := uint32(0)
+		for i := uint8(0); i < n; i++ {
+			reversed = reversed<<1 | (code>>i)&1
+		}
+		codes[symbol] = reversed
+	}
+	return codes
+}
+
+// bitWriter writes bits starting from the least significant bit of each byte
+type bitWriter struct {
+	buf   []byte
+	bits  uint64
+	nBits uint
+}
+
+func (bw *bitWriter) write(value uint32, n uint) {
+	bw.bits |= uint64(value&(1<<n-1)) << bw.nBits
+	bw.nBits += n
+	for bw.nBits >= 8 {
+		bw.buf = append(bw.buf, byte(bw.bits))
+		bw.bits >>= 8
+		bw.nBits -= 8
+	}
+}
+
+func (bw *bitWriter) bytes() []byte {
+	if bw.nBits > 0 {
+		bw.buf = append(bw.buf, byte(bw.bits))
+		bw.bits, bw.nBits = 0, 0
+	}
+	return bw.buf
+}
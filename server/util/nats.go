@@ -22,5 +22,10 @@ func ConnectNats() {
 
 // CheckNats checks if nats to be included
 func CheckNats() bool {
-	return viper.IsSet("enable_hukz") && viper.GetBool("enable_hukz")
+	return (viper.IsSet("enable_hukz") && viper.GetBool("enable_hukz")) || CheckWebhooks()
+}
+
+// CheckWebhooks checks if webhooks are to be delivered by dega-server itself
+func CheckWebhooks() bool {
+	return viper.IsSet("enable_webhooks") && viper.GetBool("enable_webhooks")
 }
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"encoding/json"
+
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+// Outbox statuses
+const (
+	OutboxPending   = "pending"
+	OutboxPublished = "published"
+)
+
+// Outbox writes the event to the outbox table using the given transaction.
+// The relay publishes it to NATS once the transaction is committed.
+func Outbox(tx *gorm.DB, subject string, payload interface{}) error {
+	byteArr, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(&model.OutboxEvent{}).Create(&model.OutboxEvent{
+		Subject: subject,
+		Payload: postgres.Jsonb{RawMessage: byteArr},
+		Status:  OutboxPending,
+	}).Error
+}
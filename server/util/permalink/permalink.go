@@ -0,0 +1,253 @@
+package permalink
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-server/service/core/model"
+	podcastModel "github.com/factly/dega-server/service/podcast/model"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// Patterns of the urls of the entities of a space, relative to its site
+// address, e.g. /{format}/{yyyy}/{mm}/{slug}
+type Patterns struct {
+	Post     string `json:"post,omitempty"`
+	Page     string `json:"page,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Category string `json:"category,omitempty"`
+	Format   string `json:"format,omitempty"`
+	Author   string `json:"author,omitempty"`
+	Podcast  string `json:"podcast,omitempty"`
+	Episode  string `json:"episode,omitempty"`
+}
+
+// Default patterns, for the kinds of entities a space has no pattern for
+var Default = Patterns{
+	Post:     "/{slug}",
+	Page:     "/{slug}",
+	Tag:      "/tag/{slug}",
+	Category: "/category/{slug}",
+	Format:   "/format/{slug}",
+	Author:   "/author/{slug}",
+	Podcast:  "/podcasts/{slug}",
+	Episode:  "/podcasts/{podcast}/episodes/{slug}",
+}
+
+// placeholders allowed in the patterns of each kind
+var placeholders = map[string][]string{
+	"post":     {"id", "slug", "locale", "format", "yyyy", "mm", "dd"},
+	"page":     {"id", "slug", "locale", "format", "yyyy", "mm", "dd"},
+	"tag":      {"id", "slug", "locale"},
+	"category": {"id", "slug", "locale"},
+	"format":   {"id", "slug"},
+	"author":   {"id", "slug"},
+	"podcast":  {"id", "slug", "locale"},
+	"episode":  {"id", "slug", "podcast", "yyyy", "mm", "dd"},
+}
+
+var placeholder = regexp.MustCompile(`{([^{}/]*)}`)
+
+// Validate checks that the patterns are paths holding the slug or the id of
+// the entity, with the placeholders known for its kind
+func (p Patterns) Validate() error {
+	for kind, pattern := range p.byKind() {
+		if pattern == "" {
+			continue
+		}
+		if !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("%s permalink must start with /", kind)
+		}
+		if !strings.Contains(pattern, "{slug}") && !strings.Contains(pattern, "{id}") {
+			return fmt.Errorf("%s permalink must contain {slug} or {id}", kind)
+		}
+		for _, match := range placeholder.FindAllStringSubmatch(pattern, -1) {
+			if !contains(placeholders[kind], match[1]) {
+				return fmt.Errorf("unknown placeholder {%s} in %s permalink", match[1], kind)
+			}
+		}
+	}
+	return nil
+}
+
+// IsZero reports whether none of the patterns are set
+func (p Patterns) IsZero() bool {
+	return p == Patterns{}
+}
+
+func (p Patterns) byKind() map[string]string {
+	return map[string]string{
+		"post":     p.Post,
+		"page":     p.Page,
+		"tag":      p.Tag,
+		"category": p.Category,
+		"format":   p.Format,
+		"author":   p.Author,
+		"podcast":  p.Podcast,
+		"episode":  p.Episode,
+	}
+}
+
+// Parse returns the patterns stored on a space
+func Parse(permalinks postgres.Jsonb) (Patterns, error) {
+	result := Patterns{}
+	if len(permalinks.RawMessage) == 0 {
+		return result, nil
+	}
+	if err := json.Unmarshal(permalinks.RawMessage, &result); err != nil {
+		return result, errors.New("invalid permalinks")
+	}
+	return result, nil
+}
+
+// Builder builds the urls of the entities of a space
+type Builder struct {
+	address  string
+	locale   string
+	patterns Patterns
+}
+
+// New returns the url builder of the space, its site address joined with its
+// patterns and the default ones
+func New(space model.Space) Builder {
+	patterns, _ := Parse(space.Permalinks)
+	withDefault(&patterns.Post, Default.Post)
+	withDefault(&patterns.Page, Default.Page)
+	withDefault(&patterns.Tag, Default.Tag)
+	withDefault(&patterns.Category, Default.Category)
+	withDefault(&patterns.Format, Default.Format)
+	withDefault(&patterns.Author, Default.Author)
+	withDefault(&patterns.Podcast, Default.Podcast)
+	withDefault(&patterns.Episode, Default.Episode)
+
+	return Builder{
+		address:  strings.TrimSuffix(space.SiteAddress, "/"),
+		locale:   space.DefaultLocale,
+		patterns: patterns,
+	}
+}
+
+func withDefault(pattern *string, value string) {
+	if *pattern == "" {
+		*pattern = value
+	}
+}
+
+// Post returns the url of a post or a page, its format is only in the url
+// when it is loaded along with the post
+func (b Builder) Post(post model.Post) string {
+	pattern := b.patterns.Post
+	if post.IsPage {
+		pattern = b.patterns.Page
+	}
+
+	date := post.CreatedAt
+	if post.PublishedDate != nil {
+		date = *post.PublishedDate
+	}
+
+	format := ""
+	if post.Format != nil {
+		format = post.Format.Slug
+	}
+
+	return b.build(pattern, values(post.ID, post.Slug, b.localeOf(post.Locale)), dated(date), map[string]string{
+		"format": format,
+	})
+}
+
+// Tag returns the url of a tag
+func (b Builder) Tag(tag model.Tag) string {
+	return b.build(b.patterns.Tag, values(tag.ID, tag.Slug, b.localeOf(tag.Locale)))
+}
+
+// Category returns the url of a category
+func (b Builder) Category(category model.Category) string {
+	return b.build(b.patterns.Category, values(category.ID, category.Slug, b.localeOf(category.Locale)))
+}
+
+// Format returns the url of a format
+func (b Builder) Format(format model.Format) string {
+	return b.build(b.patterns.Format, values(format.ID, format.Slug, ""))
+}
+
+// Author returns the url of an author
+func (b Builder) Author(author model.Author) string {
+	return b.build(b.patterns.Author, values(author.ID, author.Slug, ""))
+}
+
+// Podcast returns the url of a podcast
+func (b Builder) Podcast(podcast podcastModel.Podcast) string {
+	return b.build(b.patterns.Podcast, values(podcast.ID, podcast.Slug, b.localeOf(podcast.Language)))
+}
+
+// Episode returns the url of an episode of the podcast
+func (b Builder) Episode(episode podcastModel.Episode, podcast podcastModel.Podcast) string {
+	date := episode.CreatedAt
+	if episode.PublishedDate != nil {
+		date = *episode.PublishedDate
+	}
+
+	return b.build(b.patterns.Episode, values(episode.ID, episode.Slug, ""), dated(date), map[string]string{
+		"podcast": podcast.Slug,
+	})
+}
+
+func (b Builder) localeOf(locale string) string {
+	if locale == "" {
+		return b.locale
+	}
+	return locale
+}
+
+// build fills the placeholders of the pattern in, the path segments left
+// empty are dropped
+func (b Builder) build(pattern string, fields ...map[string]string) string {
+	path := placeholder.ReplaceAllStringFunc(pattern, func(match string) string {
+		name := match[1 : len(match)-1]
+		for _, each := range fields {
+			if value, found := each[name]; found {
+				return value
+			}
+		}
+		return ""
+	})
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return b.address + "/" + strings.Join(segments, "/")
+}
+
+func values(id uint, slug, locale string) map[string]string {
+	return map[string]string{
+		"id":     fmt.Sprint(id),
+		"slug":   slug,
+		"locale": locale,
+	}
+}
+
+func dated(date time.Time) map[string]string {
+	return map[string]string{
+		"yyyy": date.Format("2006"),
+		"mm":   date.Format("01"),
+		"dd":   date.Format("02"),
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, each := range list {
+		if each == value {
+			return true
+		}
+	}
+	return false
+}
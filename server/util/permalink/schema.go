@@ -0,0 +1,27 @@
+package permalink
+
+import (
+	"github.com/factly/dega-server/service/core/model"
+	"github.com/factly/x/schemax"
+)
+
+// Schemas points the urls in the schemas generated for a post to the
+// permalinks of the post and its authors, the authors are in the order the
+// schemas were generated with
+func (b Builder) Schemas(schemas []interface{}, post model.Post, authors []model.Author) []interface{} {
+	for i, each := range schemas {
+		switch schema := each.(type) {
+		case schemax.ArticleSchema:
+			for j := range schema.Author {
+				if j < len(authors) && authors[j].Slug != "" {
+					schema.Author[j].URL = b.Author(authors[j])
+				}
+			}
+			schemas[i] = schema
+		case schemax.FactCheckSchema:
+			schema.URL = b.Post(post)
+			schemas[i] = schema
+		}
+	}
+	return schemas
+}
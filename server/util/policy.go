@@ -69,6 +69,19 @@ func CheckKetoPolicy(entity, action string) func(h http.Handler) http.Handler {
 	}
 }
 
+// CheckPublishPermission checks keto policy for publishing the entities of the space
+func CheckPublishPermission(entity string, oID, sID, uID int) (int, error) {
+	commonString := fmt.Sprint(":org:", oID, ":app:dega:space:", sID, ":")
+
+	result := KetoAllowed{}
+
+	result.Action = fmt.Sprint("actions", commonString, entity, ":publish")
+	result.Resource = fmt.Sprint("resources", commonString, entity)
+	result.Subject = fmt.Sprint(uID)
+
+	return IsAllowed(result)
+}
+
 // CheckSpaceKetoPermission checks keto policy for operations on space
 func CheckSpaceKetoPermission(action string, oID, uID uint) error {
 	commonString := fmt.Sprint(":org:", oID, ":app:dega:spaces")
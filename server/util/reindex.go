@@ -7,7 +7,7 @@ import (
 	"github.com/factly/dega-server/service/core/model"
 	factCheckModel "github.com/factly/dega-server/service/fact-check/model"
 	podcastModel "github.com/factly/dega-server/service/podcast/model"
-	"github.com/factly/x/meilisearchx"
+	"github.com/factly/dega-server/util/search"
 )
 
 func ReindexAllEntities(spaceID uint) error {
@@ -45,6 +45,9 @@ func ReindexAllEntities(spaceID uint) error {
 	if err = AddEpisode(spaceID); err != nil {
 		return err
 	}
+	if err = AddVideo(spaceID); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -138,7 +141,7 @@ func AddPosts(spaceID uint) error {
 		meiliPostObjects = append(meiliPostObjects, meiliObj)
 	}
 
-	_, err = meilisearchx.Client.Documents("dega").AddOrUpdate(meiliPostObjects)
+	err = search.AddDocuments(meiliPostObjects)
 	tx.Commit();
 	return err
 }
@@ -166,7 +169,7 @@ func AddCategories(spaceID uint) error {
 		meiliCategoryObjects = append(meiliCategoryObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliCategoryObjects)
+	err := search.AddDocuments(meiliCategoryObjects)
 
 	return err
 }
@@ -193,7 +196,34 @@ func AddTags(spaceID uint) error {
 		meiliTagObjects = append(meiliTagObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliTagObjects)
+	err := search.AddDocuments(meiliTagObjects)
+
+	return err
+}
+
+func AddFormats(spaceID uint) error {
+	formats := make([]model.Format, 0)
+	tx := config.DB.Model(&model.Format{})
+	if spaceID > 0 {
+		tx.Where("space_id IN (?)", spaceID)
+	}
+	tx.Find(&formats)
+
+	meiliFormatObjects := make([]map[string]interface{}, 0)
+	for _, f := range formats {
+		meiliObj := map[string]interface{}{
+			"object_id":   fmt.Sprint("format_", f.ID),
+			"id":          f.ID,
+			"kind":        "format",
+			"name":        f.Name,
+			"slug":        f.Slug,
+			"description": f.Description,
+			"space_id":    f.SpaceID,
+		}
+		meiliFormatObjects = append(meiliFormatObjects, meiliObj)
+	}
+
+	err := search.AddDocuments(meiliFormatObjects)
 
 	return err
 }
@@ -226,7 +256,7 @@ func AddMedium(spaceID uint) error {
 		meiliMediumObjects = append(meiliMediumObjects, meiliObj)
 	}
 
-	_, err = meilisearchx.Client.Documents("dega").AddOrUpdate(meiliMediumObjects)
+	err = search.AddDocuments(meiliMediumObjects)
 	tx.Commit()
 	return err
 }
@@ -253,7 +283,7 @@ func AddMenu(spaceID uint) error {
 		meiliMenuObjects = append(meiliMenuObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliMenuObjects)
+	err := search.AddDocuments(meiliMenuObjects)
 
 	return err
 }
@@ -284,7 +314,7 @@ func AddSpace(spaceID uint) error {
 		meiliSpaceObjects = append(meiliSpaceObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliSpaceObjects)
+	err := search.AddDocuments(meiliSpaceObjects)
 
 	return err
 }
@@ -327,7 +357,7 @@ func AddClaim(spaceID uint) error {
 		meiliClaimObjects = append(meiliClaimObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliClaimObjects)
+	err := search.AddDocuments(meiliClaimObjects)
 
 	return err
 }
@@ -355,7 +385,7 @@ func AddClaimant(spaceID uint) error {
 		meiliClaimantObjects = append(meiliClaimantObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliClaimantObjects)
+	err := search.AddDocuments(meiliClaimantObjects)
 
 	return err
 }
@@ -385,7 +415,7 @@ func AddRating(spaceID uint) error {
 		meiliRatingObjects = append(meiliRatingObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliRatingObjects)
+	err := search.AddDocuments(meiliRatingObjects)
 
 	return err
 }
@@ -421,7 +451,7 @@ func AddPodcast(spaceID uint) error {
 		meiliPodcastObjects = append(meiliPodcastObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliPodcastObjects)
+	err := search.AddDocuments(meiliPodcastObjects)
 
 	return err
 }
@@ -461,7 +491,56 @@ func AddEpisode(spaceID uint) error {
 		meiliEpisodeObjects = append(meiliEpisodeObjects, meiliObj)
 	}
 
-	_, err := meilisearchx.Client.Documents("dega").AddOrUpdate(meiliEpisodeObjects)
+	err := search.AddDocuments(meiliEpisodeObjects)
+
+	return err
+}
+
+func AddVideo(spaceID uint) error {
+	videos := make([]factCheckModel.Video, 0)
+	tx := config.DB.Model(&factCheckModel.Video{}).Preload("Tags").Preload("Categories")
+	if spaceID > 0 {
+		tx.Where("space_id IN (?)", spaceID)
+	}
+	tx.Find(&videos)
+
+	meiliVideoObjects := make([]map[string]interface{}, 0)
+	for _, v := range videos {
+		var publishedDate int64
+		if v.PublishedDate != nil {
+			publishedDate = v.PublishedDate.Unix()
+		}
+
+		tagIDs := make([]uint, 0)
+		for _, t := range v.Tags {
+			tagIDs = append(tagIDs, t.ID)
+		}
+
+		categoryIDs := make([]uint, 0)
+		for _, c := range v.Categories {
+			categoryIDs = append(categoryIDs, c.ID)
+		}
+
+		meiliObj := map[string]interface{}{
+			"object_id":      fmt.Sprint("video_", v.ID),
+			"id":             v.ID,
+			"kind":           "video",
+			"title":          v.Title,
+			"slug":           v.Slug,
+			"url":            v.URL,
+			"summary":        v.Summary,
+			"video_type":     v.VideoType,
+			"status":         v.Status,
+			"total_duration": v.TotalDuration,
+			"published_date": publishedDate,
+			"space_id":       v.SpaceID,
+			"tag_ids":        tagIDs,
+			"category_ids":   categoryIDs,
+		}
+		meiliVideoObjects = append(meiliVideoObjects, meiliObj)
+	}
+
+	err := search.AddDocuments(meiliVideoObjects)
 
 	return err
 }
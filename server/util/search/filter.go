@@ -0,0 +1,203 @@
+package search
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var fieldName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// filterSQL converts a meilisearch filter expression into a condition on the
+// data of the search documents. It supports the =, !=, >, >=, < and <=
+// operators, AND, OR, NOT and parentheses. A field holding an array matches
+// = when any of its elements does.
+func filterSQL(filters string) (string, []interface{}, error) {
+	tokens, err := tokenize(filters)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(tokens) == 0 {
+		return "", nil, nil
+	}
+
+	p := &filterParser{tokens: tokens}
+	sql, err := p.or()
+	if err != nil {
+		return "", nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return "", nil, fmt.Errorf("unexpected %s in filters", p.tokens[p.pos].text)
+	}
+	return sql, p.args, nil
+}
+
+// facetSQL converts a meilisearch facet filter "field:value"
+func facetSQL(facet string) (string, []interface{}, error) {
+	parts := strings.SplitN(facet, ":", 2)
+	if len(parts) != 2 || !fieldName.MatchString(parts[0]) {
+		return "", nil, fmt.Errorf("invalid facet filter %s", facet)
+	}
+	return equalSQL(parts[0]), []interface{}{parts[1], parts[1]}, nil
+}
+
+func equalSQL(field string) string {
+	value := fmt.Sprint("data->'", field, "'")
+	return fmt.Sprint("(CASE jsonb_typeof(", value, ") WHEN 'array' THEN EXISTS (SELECT 1 FROM jsonb_array_elements_text(", value, ") WHERE value = ?) ELSE data->>'", field, "' = ? END)")
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+func tokenize(filters string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(filters); {
+		c := filters[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, token{text: string(c)})
+			i++
+		case c == '!' || c == '=' || c == '<' || c == '>':
+			op := string(c)
+			if i+1 < len(filters) && filters[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("invalid operator in filters")
+			}
+			tokens = append(tokens, token{text: op})
+			i += len(op)
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(filters[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string in filters")
+			}
+			tokens = append(tokens, token{text: filters[i+1 : i+1+end], quoted: true})
+			i += end + 2
+		default:
+			start := i
+			for i < len(filters) && !strings.ContainsRune(" \t\n()!=<>\"'", rune(filters[i])) {
+				i++
+			}
+			tokens = append(tokens, token{text: filters[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+	args   []interface{}
+}
+
+func (p *filterParser) keyword(word string) bool {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && p.tokens[p.pos].text == word {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) next() (token, error) {
+	if p.pos >= len(p.tokens) {
+		return token{}, fmt.Errorf("unexpected end of filters")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *filterParser) or() (string, error) {
+	sql, err := p.and()
+	if err != nil {
+		return "", err
+	}
+	for p.keyword("OR") {
+		right, err := p.and()
+		if err != nil {
+			return "", err
+		}
+		sql = fmt.Sprint(sql, " OR ", right)
+	}
+	return sql, nil
+}
+
+func (p *filterParser) and() (string, error) {
+	sql, err := p.not()
+	if err != nil {
+		return "", err
+	}
+	for p.keyword("AND") {
+		right, err := p.not()
+		if err != nil {
+			return "", err
+		}
+		sql = fmt.Sprint(sql, " AND ", right)
+	}
+	return sql, nil
+}
+
+func (p *filterParser) not() (string, error) {
+	if p.keyword("NOT") {
+		sql, err := p.not()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint("NOT COALESCE(", sql, ", false)"), nil
+	}
+	return p.primary()
+}
+
+func (p *filterParser) primary() (string, error) {
+	if p.keyword("(") {
+		sql, err := p.or()
+		if err != nil {
+			return "", err
+		}
+		if !p.keyword(")") {
+			return "", fmt.Errorf("missing ) in filters")
+		}
+		return fmt.Sprint("(", sql, ")"), nil
+	}
+
+	field, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if field.quoted || !fieldName.MatchString(field.text) {
+		return "", fmt.Errorf("invalid field %s in filters", field.text)
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	value, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	switch op.text {
+	case "=":
+		p.args = append(p.args, value.text, value.text)
+		return equalSQL(field.text), nil
+	case "!=":
+		p.args = append(p.args, value.text, value.text)
+		return fmt.Sprint("NOT COALESCE(", equalSQL(field.text), ", false)"), nil
+	case ">", ">=", "<", "<=":
+		number, err := strconv.ParseFloat(value.text, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s %s needs a number in filters", field.text, op.text)
+		}
+		p.args = append(p.args, number)
+		return fmt.Sprint("(CASE WHEN jsonb_typeof(data->'", field.text, "') = 'number' THEN (data->>'", field.text, "')::numeric ", op.text, " ? ELSE false END)"), nil
+	}
+	return "", fmt.Errorf("invalid operator %s in filters", op.text)
+}
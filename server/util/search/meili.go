@@ -0,0 +1,114 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/factly/x/meilisearchx"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Meili is the meilisearch backend
+type Meili struct{}
+
+// Setup creates the index and sets its searchable and facet attributes
+func (Meili) Setup() error {
+	err := meilisearchx.SetupMeiliSearch(IndexName, searchableAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = meilisearchx.Client.Settings(IndexName).UpdateAttributesForFaceting(facetAttributes)
+	return err
+}
+
+// Add adds the documents replacing the existing ones
+func (Meili) Add(docs []map[string]interface{}) error {
+	_, err := meilisearchx.Client.Documents(IndexName).AddOrReplace(docs)
+	return err
+}
+
+// Update adds the documents merging them with the existing ones
+func (Meili) Update(docs []map[string]interface{}) error {
+	_, err := meilisearchx.Client.Documents(IndexName).AddOrUpdate(docs)
+	return err
+}
+
+// Delete deletes the documents with the object ids
+func (Meili) Delete(objectIDs []string) error {
+	var err error
+	if len(objectIDs) == 1 {
+		_, err = meilisearchx.Client.Documents(IndexName).Delete(objectIDs[0])
+	} else {
+		_, err = meilisearchx.Client.Documents(IndexName).Deletes(objectIDs)
+	}
+	return err
+}
+
+// DeleteSpace deletes the documents of a space
+func (m Meili) DeleteSpace(spaceID uint) error {
+	hits, err := m.Search(Query{SpaceID: spaceID, Limit: 100000})
+	if err != nil {
+		return err
+	}
+
+	objectIDs := make([]string, 0)
+	for _, hit := range hits {
+		obj := hit.(map[string]interface{})
+		objectIDs = append(objectIDs, obj["object_id"].(string))
+	}
+	if len(objectIDs) == 0 {
+		return nil
+	}
+	return m.Delete(objectIDs)
+}
+
+// DeleteAll deletes all the documents
+func (Meili) DeleteAll() error {
+	_, err := meilisearchx.Client.Documents(IndexName).DeleteAllDocuments()
+	return err
+}
+
+// Search searches the index
+func (Meili) Search(query Query) ([]interface{}, error) {
+	filters := query.Filters
+	if query.SpaceID > 0 {
+		spaceFilter := fmt.Sprint("space_id=", query.SpaceID)
+		if filters != "" {
+			filters = fmt.Sprint("(", filters, ") AND ", spaceFilter)
+		} else {
+			filters = spaceFilter
+		}
+	}
+
+	facetFilters := make([]interface{}, 0)
+	for _, each := range query.FacetFilters {
+		facetFilters = append(facetFilters, each)
+	}
+	if len(query.Kinds) > 0 {
+		kinds := make([]string, 0)
+		for _, kind := range query.Kinds {
+			kinds = append(kinds, "kind:"+kind)
+		}
+		facetFilters = append(facetFilters, kinds)
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = 1000000
+	}
+
+	request := meilisearch.SearchRequest{
+		Query:             query.Text,
+		Filters:           filters,
+		Limit:             limit,
+		PlaceholderSearch: query.Text == "",
+	}
+	if len(facetFilters) > 0 {
+		request.FacetFilters = facetFilters
+	}
+
+	result, err := meilisearchx.Client.Search(IndexName).Search(request)
+	if err != nil {
+		return nil, err
+	}
+	return result.Hits, nil
+}
@@ -0,0 +1,295 @@
+package search
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/factly/dega-server/config"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Postgres is the backend built on the full text search of postgres. The
+// documents are stored in the search_documents table with a weighted
+// tsvector stemmed in the language of the document.
+type Postgres struct{}
+
+// languages maps ISO 639-1 codes to the postgres text search configurations
+var languages = map[string]string{
+	"da": "danish",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"hu": "hungarian",
+	"it": "italian",
+	"nl": "dutch",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"tr": "turkish",
+}
+
+// fields of the documents indexed with each weight
+var weightedFields = map[string][]string{
+	"A": {"title", "name", "claim"},
+	"B": {"subtitle", "excerpt", "tag_line", "fact", "site_title", "review", "review_tag_line"},
+	"C": {"description", "summary", "slug", "site_address"},
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// Setup creates the search_documents table and its indexes
+func (Postgres) Setup() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS search_documents (
+	object_id TEXT PRIMARY KEY,
+	kind TEXT NOT NULL,
+	entity_id TEXT NOT NULL,
+	space_id BIGINT,
+	language TEXT NOT NULL,
+	data JSONB NOT NULL,
+	document TSVECTOR NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+)`,
+		`CREATE INDEX IF NOT EXISTS idx_search_documents_document ON search_documents USING GIN (document)`,
+		`CREATE INDEX IF NOT EXISTS idx_search_documents_space_kind ON search_documents (space_id, kind)`,
+	}
+	for _, statement := range statements {
+		if err := config.DB.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add adds the documents replacing the existing ones
+func (Postgres) Add(docs []map[string]interface{}) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		for _, doc := range docs {
+			if err := upsert(tx, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Update adds the documents merging them with the existing ones
+func (Postgres) Update(docs []map[string]interface{}) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		for _, doc := range docs {
+			var existing []byte
+			err := tx.Raw(`SELECT data FROM search_documents WHERE object_id = ?`, doc["object_id"]).Row().Scan(&existing)
+			if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+			if err == nil {
+				merged := make(map[string]interface{})
+				if err = json.Unmarshal(existing, &merged); err != nil {
+					return err
+				}
+				for key, value := range doc {
+					merged[key] = value
+				}
+				doc = merged
+			}
+
+			if err = upsert(tx, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes the documents with the object ids
+func (Postgres) Delete(objectIDs []string) error {
+	return config.DB.Exec(`DELETE FROM search_documents WHERE object_id IN (?)`, objectIDs).Error
+}
+
+// DeleteSpace deletes the documents of a space
+func (Postgres) DeleteSpace(spaceID uint) error {
+	return config.DB.Exec(`DELETE FROM search_documents WHERE space_id = ?`, spaceID).Error
+}
+
+// DeleteAll deletes all the documents
+func (Postgres) DeleteAll() error {
+	return config.DB.Exec(`DELETE FROM search_documents`).Error
+}
+
+// Search returns the documents matching the query ranked by ts_rank_cd. The
+// text is parsed with websearch_to_tsquery in both the search language and
+// the simple configuration so that documents in languages postgres can not
+// stem are found too.
+func (Postgres) Search(query Query) ([]interface{}, error) {
+	tx := config.DB.Table("search_documents").Select("data")
+
+	if query.SpaceID > 0 {
+		tx = tx.Where("space_id = ?", query.SpaceID)
+	}
+	if len(query.Kinds) > 0 {
+		tx = tx.Where("kind IN (?)", query.Kinds)
+	}
+
+	if query.Filters != "" {
+		condition, args, err := filterSQL(query.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if condition != "" {
+			tx = tx.Where(fmt.Sprint("(", condition, ")"), args...)
+		}
+	}
+	for _, facet := range query.FacetFilters {
+		condition, args, err := facetSQL(facet)
+		if err != nil {
+			return nil, err
+		}
+		tx = tx.Where(condition, args...)
+	}
+
+	if strings.TrimSpace(query.Text) != "" {
+		tsQuery := "(websearch_to_tsquery(?::regconfig, ?) || websearch_to_tsquery('simple', ?))"
+		args := []interface{}{language(nil), query.Text, query.Text}
+		tx = tx.Where(fmt.Sprint("document @@ ", tsQuery), args...).
+			Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:  fmt.Sprint("ts_rank_cd(document, ", tsQuery, ") DESC"),
+				Vars: args,
+			}})
+	} else {
+		tx = tx.Order("updated_at DESC")
+	}
+
+	if query.Limit > 0 {
+		tx = tx.Limit(int(query.Limit))
+	}
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]interface{}, 0)
+	for rows.Next() {
+		var data []byte
+		if err = rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		hit := make(map[string]interface{})
+		if err = json.Unmarshal(data, &hit); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+func upsert(tx *gorm.DB, doc map[string]interface{}) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	lang := language(doc["language"])
+
+	var spaceID interface{}
+	if doc["space_id"] != nil {
+		spaceID = doc["space_id"]
+	}
+	if doc["kind"] == "space" {
+		spaceID = doc["id"]
+	}
+
+	vector := make([]string, 0)
+	args := []interface{}{doc["object_id"], doc["kind"], fmt.Sprint(doc["id"]), spaceID, lang, string(data)}
+	for _, weight := range []string{"A", "B", "C"} {
+		vector = append(vector, fmt.Sprint("setweight(to_tsvector(?::regconfig, ?), '", weight, "')"))
+		args = append(args, lang, weightedText(doc, weight))
+	}
+
+	return tx.Exec(fmt.Sprint(`INSERT INTO search_documents (object_id, kind, entity_id, space_id, language, data, document, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, `, strings.Join(vector, " || "), `, now())
+ON CONFLICT (object_id) DO UPDATE SET kind = EXCLUDED.kind, entity_id = EXCLUDED.entity_id, space_id = EXCLUDED.space_id,
+language = EXCLUDED.language, data = EXCLUDED.data, document = EXCLUDED.document, updated_at = EXCLUDED.updated_at`), args...).Error
+}
+
+// language returns the text search configuration for the language of a
+// document, the configured search language when it has none and simple
+// when postgres can not stem it
+func language(value interface{}) string {
+	lang, _ := value.(string)
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang == "" {
+		return config.SearchLanguage()
+	}
+	if len(lang) > 2 && (lang[2] == '-' || lang[2] == '_') {
+		lang = lang[:2]
+	}
+	if name, found := languages[lang]; found {
+		return name
+	}
+	for _, name := range languages {
+		if name == lang {
+			return name
+		}
+	}
+	return "simple"
+}
+
+// weightedText returns the text of the fields of a weight
+func weightedText(doc map[string]interface{}, weight string) string {
+	texts := make([]string, 0)
+	for _, field := range weightedFields[weight] {
+		if text := plainText(doc[field]); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+// plainText returns the text of a field which is either a string or an
+// editorjs document
+func plainText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(htmlTag.ReplaceAllString(v, " "))
+	case nil:
+		return ""
+	}
+
+	byteArr, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	editorjs := struct {
+		Blocks []struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"blocks"`
+	}{}
+	if err = json.Unmarshal(byteArr, &editorjs); err != nil {
+		return ""
+	}
+
+	texts := make([]string, 0)
+	for _, block := range editorjs.Blocks {
+		for _, key := range []string{"text", "caption", "title", "message"} {
+			if text, ok := block.Data[key].(string); ok {
+				texts = append(texts, plainText(text))
+			}
+		}
+		if items, ok := block.Data["items"].([]interface{}); ok {
+			for _, item := range items {
+				texts = append(texts, plainText(item))
+			}
+		}
+	}
+	return strings.Join(texts, " ")
+}
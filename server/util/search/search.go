@@ -0,0 +1,129 @@
+// Package search indexes the entities of a space and searches them with the
+// backend set by search_backend, either meilisearch or the full text search
+// of postgres.
+package search
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/factly/dega-server/config"
+)
+
+// IndexName is the name of the meilisearch index
+const IndexName = "dega"
+
+// searchable attributes of the documents
+var searchableAttributes = []string{"space_id", "name", "slug", "description", "title", "subtitle", "excerpt", "claim", "fact", "site_title", "site_address", "tag_line", "review", "review_tag_line"}
+
+// attributes of the documents used for facet filters and counts
+var facetAttributes = []string{"kind", "category_ids", "tag_ids", "format_id"}
+
+// Query for a search. Filters and FacetFilters use the meilisearch syntax,
+// e.g. "(tag_ids=1 OR tag_ids=2) AND status=publish" and "kind:post".
+type Query struct {
+	Text         string
+	SpaceID      uint
+	Kinds        []string
+	Filters      string
+	FacetFilters []string
+	Limit        int64
+}
+
+// Backend stores search documents and searches them. Documents are maps with
+// at least the kind and id of the entity.
+type Backend interface {
+	Setup() error
+	// Add adds the documents replacing the existing ones
+	Add(docs []map[string]interface{}) error
+	// Update adds the documents merging them with the existing ones
+	Update(docs []map[string]interface{}) error
+	Delete(objectIDs []string) error
+	// DeleteSpace deletes the documents of a space
+	DeleteSpace(spaceID uint) error
+	DeleteAll() error
+	// Search returns the matching documents ranked by relevance
+	Search(query Query) ([]interface{}, error)
+}
+
+// Current returns the configured backend
+func Current() Backend {
+	if config.SearchBackend() == config.SearchPostgres {
+		return Postgres{}
+	}
+	return Meili{}
+}
+
+// Setup prepares the configured backend
+func Setup() error {
+	return Current().Setup()
+}
+
+// AddDocument adds the document of an entity to the index
+func AddDocument(data map[string]interface{}) error {
+	if err := objectID(data); err != nil {
+		return err
+	}
+	return Current().Add([]map[string]interface{}{data})
+}
+
+// UpdateDocument updates the document of an entity in the index
+func UpdateDocument(data map[string]interface{}) error {
+	if err := objectID(data); err != nil {
+		return err
+	}
+	return Current().Update([]map[string]interface{}{data})
+}
+
+// AddDocuments adds or updates the documents in the index
+func AddDocuments(docs []map[string]interface{}) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	for _, each := range docs {
+		if err := objectID(each); err != nil {
+			return err
+		}
+	}
+	return Current().Update(docs)
+}
+
+// DeleteDocument deletes the document of an entity from the index
+func DeleteDocument(id uint, kind string) error {
+	return Current().Delete([]string{fmt.Sprint(kind, "_", id)})
+}
+
+// DeleteObjects deletes the documents with the object ids from the index
+func DeleteObjects(objectIDs ...string) error {
+	if len(objectIDs) == 0 {
+		return nil
+	}
+	return Current().Delete(objectIDs)
+}
+
+// DeleteSpace deletes all the documents of a space from the index
+func DeleteSpace(spaceID uint) error {
+	return Current().DeleteSpace(spaceID)
+}
+
+// DeleteAll deletes all the documents from the index
+func DeleteAll() error {
+	return Current().DeleteAll()
+}
+
+// Search searches the index
+func Search(query Query) ([]interface{}, error) {
+	return Current().Search(query)
+}
+
+func objectID(data map[string]interface{}) error {
+	if data["kind"] == nil || data["kind"] == "" {
+		return errors.New("no kind field in search document")
+	}
+	if data["id"] == nil || data["id"] == "" {
+		return errors.New("no id field in search document")
+	}
+
+	data["object_id"] = fmt.Sprint(data["kind"], "_", data["id"])
+	return nil
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Local stores the objects in a directory, served by Handler
+type Local struct {
+	Path      string
+	PublicURL string
+}
+
+// Put writes the object to its file in the directory
+func (l Local) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	file, err := l.file(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return "", err
+	}
+
+	if err = ioutil.WriteFile(file, body, 0644); err != nil {
+		return "", err
+	}
+
+	return publicURL(l.PublicURL, key), nil
+}
+
+// Delete removes the file of the object
+func (l Local) Delete(ctx context.Context, key string) error {
+	file, err := l.file(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (l Local) file(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned != "/"+strings.TrimPrefix(key, "/") {
+		return "", errors.New("invalid storage key")
+	}
+	return filepath.Join(l.Path, filepath.FromSlash(cleaned)), nil
+}
+
+// inline are the extensions of the raster images shown in the browser, the
+// other files are downloaded
+var inline = map[string]bool{
+	".jpg":  true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+}
+
+// Handler serves the files of the directory without listing its contents
+func (l Local) Handler() http.Handler {
+	files := http.FileServer(http.Dir(l.Path))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if !inline[strings.ToLower(path.Ext(r.URL.Path))] {
+			w.Header().Set("Content-Disposition", "attachment")
+		}
+		files.ServeHTTP(w, r)
+	})
+}
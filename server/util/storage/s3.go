@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3 stores the objects in a bucket of an s3 compatible object storage. The
+// requests are path style, e.g. http://minio:9000/dega/key, and signed with
+// AWS signature version 4.
+type S3 struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PublicURL string
+}
+
+// Put uploads the object to the bucket
+func (s S3) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	req, err := s.request(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, body, time.Now())
+
+	if err = do(req); err != nil {
+		return "", err
+	}
+
+	return publicURL(s.PublicURL, key), nil
+}
+
+// Delete deletes the object from the bucket
+func (s S3) Delete(ctx context.Context, key string) error {
+	req, err := s.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, nil, time.Now())
+
+	return do(req)
+}
+
+func (s S3) request(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(s.Endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Path = "/" + s.Bucket + "/" + key
+	endpoint.RawPath = "/" + uriEncode(s.Bucket) + "/" + uriEncode(key)
+
+	return http.NewRequestWithContext(ctx, method, endpoint.String(), bytes.NewReader(body))
+}
+
+func do(req *http.Request) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("storage %s %s: %s %s", req.Method, req.URL.Path, resp.Status, message)
+	}
+	return nil
+}
+
+// sign adds the authorization headers of AWS signature version 4
+func (s S3) sign(req *http.Request, body []byte, now time.Time) {
+	region := s.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := hexSHA256(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalHeaders, signedHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprint(date, "/", region, "/s3/aws4_request")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprint("AWS4-HMAC-SHA256 Credential=", s.AccessKey, "/", scope,
+		", SignedHeaders=", signedHeaders, ", Signature=", signature))
+}
+
+// canonicalHeaders returns the headers which are signed, the host, the
+// content type, date and range and the x-amz headers, and their names
+func canonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || name == "date" || name == "range" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return b.String(), strings.Join(names, ";")
+}
+
+// canonicalQuery returns the query parameters sorted by name, with their
+// names and values escaped
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	encoded := make(map[string][]string, len(query))
+	for name, values := range query {
+		name = queryEncode(name)
+		names = append(names, name)
+		for _, value := range values {
+			encoded[name] = append(encoded[name], queryEncode(value))
+		}
+		sort.Strings(encoded[name])
+	}
+	sort.Strings(names)
+
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		for _, value := range encoded[name] {
+			params = append(params, name+"="+value)
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func queryEncode(value string) string {
+	return strings.ReplaceAll(uriEncode(value), "/", "%2F")
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncode escapes everything but the unreserved characters and slashes
+func uriEncode(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+// Package storage stores uploaded media in the backend set by storage_backend,
+// either the local filesystem or an s3 compatible object storage like minio.
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/factly/dega-server/config"
+	"github.com/spf13/viper"
+)
+
+// Backend stores objects under keys like "1/2021/4/image.jpg"
+type Backend interface {
+	// Put stores the object replacing the existing one and returns its url
+	Put(ctx context.Context, key string, body []byte, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Current returns the configured backend
+func Current() Backend {
+	if config.StorageBackend() == config.StorageS3 {
+		return S3{
+			Endpoint:  viper.GetString("s3_endpoint"),
+			Region:    viper.GetString("s3_region"),
+			Bucket:    viper.GetString("s3_bucket"),
+			AccessKey: viper.GetString("s3_access_key"),
+			SecretKey: viper.GetString("s3_secret_key"),
+			PublicURL: config.StoragePublicURL(),
+		}
+	}
+	return Local{
+		Path:      config.StorageLocalPath(),
+		PublicURL: config.StoragePublicURL(),
+	}
+}
+
+// Put stores an object in the configured backend
+func Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	return Current().Put(ctx, key, body, contentType)
+}
+
+// Delete deletes an object from the configured backend
+func Delete(ctx context.Context, key string) error {
+	return Current().Delete(ctx, key)
+}
+
+// Key returns the key of an object from its url, urls not served from the
+// storage like the ones of media added by url have no key
+func Key(url string) (string, bool) {
+	prefix := strings.TrimSuffix(config.StoragePublicURL(), "/") + "/"
+	if !strings.HasPrefix(url, prefix) || len(url) == len(prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(url, prefix), true
+}
+
+func publicURL(base, key string) string {
+	return strings.TrimSuffix(base, "/") + "/" + key
+}
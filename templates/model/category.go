@@ -15,4 +15,5 @@ type Category struct {
 	SpaceID     uint           `gorm:"column:space_id" json:"space_id"`
 	Posts       []*Post        `gorm:"many2many:post_categories;" json:"posts"`
 	MetaFields  postgres.Jsonb `gorm:"column:meta_fields" json:"meta_fields" swaggertype:"primitive,string"`
+	Locale      string         `gorm:"column:locale" json:"locale"`
 }
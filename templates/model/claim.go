@@ -10,6 +10,8 @@ import (
 type Claim struct {
 	Base
 	Title         string         `gorm:"column:title" json:"title"`
+	Claim         string         `gorm:"column:claim" json:"claim"`
+	Fact          string         `gorm:"column:fact" json:"fact"`
 	Slug          string         `gorm:"column:slug" json:"slug"`
 	ClaimDate     time.Time      `gorm:"column:claim_date" json:"claim_date" sql:"DEFAULT:NULL"`
 	CheckedDate   time.Time      `gorm:"column:checked_date" json:"checked_date" sql:"DEFAULT:NULL"`
@@ -24,6 +24,7 @@ type Post struct {
 	Format           *Format        `json:"format"`
 	PublishedDate    time.Time      `gorm:"column:published_date" json:"published_date"`
 	SpaceID          uint           `gorm:"column:space_id" json:"space_id"`
+	Locale           string         `gorm:"column:locale" json:"locale"`
 	Tags             []Tag          `gorm:"many2many:post_tags;" json:"tags"`
 	Categories       []Category     `gorm:"many2many:post_categories;" json:"categories"`
 }
@@ -5,12 +5,14 @@ import "github.com/jinzhu/gorm/dialects/postgres"
 // Rating rating model
 type Rating struct {
 	Base
-	Name         string         `gorm:"column:name" json:"name"`
-	Slug         string         `gorm:"column:slug" json:"slug"`
-	Colour       postgres.Jsonb `gorm:"column:colour" json:"colour" swaggertype:"primitive,string"`
-	Description  postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
-	NumericValue int            `gorm:"column:numeric_value" json:"numeric_value"`
-	MediumID     *uint          `gorm:"column:medium_id;default=NULL" json:"medium_id"`
-	Medium       *Medium        `json:"medium"`
-	SpaceID      uint           `gorm:"column:space_id" json:"space_id"`
+	Name             string         `gorm:"column:name" json:"name"`
+	Slug             string         `gorm:"column:slug" json:"slug"`
+	Colour           postgres.Jsonb `gorm:"column:colour" json:"colour" swaggertype:"primitive,string"`
+	BackgroundColour postgres.Jsonb `gorm:"column:background_colour" json:"background_colour" swaggertype:"primitive,string"`
+	TextColour       postgres.Jsonb `gorm:"column:text_colour" json:"text_colour" swaggertype:"primitive,string"`
+	Description      postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
+	NumericValue     int            `gorm:"column:numeric_value" json:"numeric_value"`
+	MediumID         *uint          `gorm:"column:medium_id;default=NULL" json:"medium_id"`
+	Medium           *Medium        `json:"medium"`
+	SpaceID          uint           `gorm:"column:space_id" json:"space_id"`
 }
@@ -23,4 +23,7 @@ type Space struct {
 	SocialMediaURLs   postgres.Jsonb `gorm:"column:social_media_urls" json:"social_media_urls" swaggertype:"primitive,string"`
 	ContactInfo       postgres.Jsonb `gorm:"column:contact_info" json:"contact_info" swaggertype:"primitive,string"`
 	OrganisationID    int            `gorm:"column:organisation_id" json:"organisation_id"`
+	DefaultLocale     string         `gorm:"column:default_locale" json:"default_locale"`
+	Permalinks        postgres.Jsonb `gorm:"column:permalinks" json:"permalinks" swaggertype:"primitive,string"`
+	Theme             string         `gorm:"column:theme" json:"theme"`
 }
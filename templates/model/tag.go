@@ -12,5 +12,6 @@ type Tag struct {
 	Description postgres.Jsonb `gorm:"column:description" json:"description" swaggertype:"primitive,string"`
 	IsFeatured  bool           `gorm:"column:is_featured" json:"is_featured"`
 	SpaceID     uint           `gorm:"column:space_id" json:"space_id"`
+	Locale      string         `gorm:"column:locale" json:"locale"`
 	Posts       []*Post        `gorm:"many2many:post_tags;" json:"posts"`
 }
@@ -61,7 +61,7 @@ func details(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if _, found := authors[fmt.Sprint(id)]; found {
-		err = util.Template.ExecuteTemplate(w, "author.gohtml", authors[fmt.Sprint(id)])
+		err = util.ExecuteTemplate(w, uint(sID), "author.gohtml", authors[fmt.Sprint(id)])
 		if err != nil {
 			loggerx.Error(err)
 			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
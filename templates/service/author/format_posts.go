@@ -130,7 +130,7 @@ func postList(w http.ResponseWriter, r *http.Request) {
 		prevURL = ""
 	}
 
-	err = util.Template.ExecuteTemplate(w, "postlist.gohtml", map[string]interface{}{
+	err = util.ExecuteTemplate(w, uint(sID), "postlist.gohtml", map[string]interface{}{
 		"postList":    result,
 		"author":      authors[fmt.Sprint(id)],
 		"from_author": true,
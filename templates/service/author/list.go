@@ -54,7 +54,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 		authorList = append(authorList, v)
 	}
 
-	err = util.Template.ExecuteTemplate(w, "authorlist.gohtml", authorList)
+	err = util.ExecuteTemplate(w, uint(sID), "authorlist.gohtml", authorList)
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
@@ -39,7 +39,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = util.Template.ExecuteTemplate(w, "categorylist.gohtml", categoryList)
+	err = util.ExecuteTemplate(w, uint(sID), "categorylist.gohtml", categoryList)
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
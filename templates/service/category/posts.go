@@ -124,7 +124,7 @@ func allPosts(w http.ResponseWriter, r *http.Request) {
 		prevURL = ""
 	}
 
-	err = util.Template.ExecuteTemplate(w, "postlist.gohtml", map[string]interface{}{
+	err = util.ExecuteTemplate(w, uint(sID), "postlist.gohtml", map[string]interface{}{
 		"postList":      result,
 		"category":      category,
 		"from_category": true,
@@ -113,7 +113,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 		prevURL = ""
 	}
 
-	err = util.Template.ExecuteTemplate(w, "postlist.gohtml", map[string]interface{}{
+	err = util.ExecuteTemplate(w, uint(sID), "postlist.gohtml", map[string]interface{}{
 		"postList": result,
 		"nextURL":  nextURL,
 		"prevURL":  prevURL,
@@ -107,7 +107,7 @@ func home(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = util.Template.ExecuteTemplate(w, "homepage.gohtml", map[string]interface{}{
+	err = util.ExecuteTemplate(w, uint(sID), "homepage.gohtml", map[string]interface{}{
 		"factchecks": resultFactchecks,
 		"articles":   resultArticles,
 		"categories": categories,
@@ -77,7 +77,7 @@ func PostDetails(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err = util.Template.ExecuteTemplate(w, "post.gohtml", map[string]interface{}{
+	err = util.ExecuteTemplate(w, uint(sID), "post.gohtml", map[string]interface{}{
 		"post": result,
 	})
 	if err != nil {
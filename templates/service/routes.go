@@ -12,6 +12,7 @@ import (
 	"github.com/factly/dega-vito/service/format"
 	"github.com/factly/dega-vito/service/post"
 	"github.com/factly/dega-vito/service/tag"
+	"github.com/factly/dega-vito/service/theme"
 	"github.com/factly/x/healthx"
 	"github.com/factly/x/loggerx"
 	"github.com/factly/x/middlewarex"
@@ -46,6 +47,7 @@ func RegisterRoutes() http.Handler {
 		r.Mount("/category", category.Router())
 		r.Mount("/tag", tag.Router())
 		r.Mount("/format", format.Router())
+		r.Mount("/themes", theme.Router())
 	})
 
 	FileServer(r, "/", filesDir)
@@ -39,7 +39,7 @@ func list(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = util.Template.ExecuteTemplate(w, "taglist.gohtml", result)
+	err = util.ExecuteTemplate(w, uint(sID), "taglist.gohtml", result)
 	if err != nil {
 		loggerx.Error(err)
 		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
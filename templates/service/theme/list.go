@@ -0,0 +1,35 @@
+package theme
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-vito/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+)
+
+// list returns the themes available to the space
+func list(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	themes, err := util.ListThemes(uint(sID))
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	result := make([]themeData, 0)
+	for _, theme := range themes {
+		result = append(result, newThemeData(theme, theme.UploadedBy(uint(sID))))
+	}
+
+	renderx.JSON(w, http.StatusOK, result)
+}
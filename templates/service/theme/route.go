@@ -0,0 +1,74 @@
+package theme
+
+import (
+	"net/http"
+
+	"github.com/factly/dega-vito/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/go-chi/chi"
+)
+
+// themeData - a theme available to the space
+type themeData struct {
+	util.Manifest
+	Templates []string `json:"templates"`
+	Uploaded  bool     `json:"uploaded"`
+}
+
+// uploads larger than this are rejected
+const maxUploadSize = 10 << 20
+
+// Router - themes router, for the owners of the organisation of the space
+func Router() chi.Router {
+	r := chi.NewRouter()
+
+	r.Use(middlewarex.CheckUser)
+	r.Use(checkOwner)
+
+	r.Get("/", list)
+	r.Post("/", upload)
+
+	return r
+}
+
+func checkOwner(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sID, err := middlewarex.GetSpace(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		uID, err := middlewarex.GetUser(r.Context())
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		isOwner, err := util.CheckOwner(uint(sID), uint(uID))
+		if err != nil {
+			loggerx.Error(err)
+			errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+			return
+		}
+
+		if !isOwner {
+			errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func newThemeData(theme *util.Theme, uploaded bool) themeData {
+	return themeData{
+		Manifest:  theme.Manifest,
+		Templates: theme.TemplateList(),
+		Uploaded:  uploaded,
+	}
+}
@@ -0,0 +1,72 @@
+package theme
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/factly/dega-vito/util"
+	"github.com/factly/x/errorx"
+	"github.com/factly/x/loggerx"
+	"github.com/factly/x/middlewarex"
+	"github.com/factly/x/renderx"
+)
+
+// upload validates a theme uploaded as a zip archive and stores it for the
+// space, in place of the uploaded theme of the same name
+func upload(w http.ResponseWriter, r *http.Request) {
+	sID, err := middlewarex.GetSpace(r.Context())
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.Unauthorized()))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	file, _, err := r.FormFile("theme")
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage("theme must be a zip archive of at most 10MB in the theme field", http.StatusUnprocessableEntity)))
+		return
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.DecodeError()))
+		return
+	}
+
+	theme, err := util.ReadThemeArchive(data)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	if theme.Name == util.DefaultTheme {
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(fmt.Sprintf("the %s theme cannot be replaced", util.DefaultTheme), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	// the templates must compile along with the ones they fall back to
+	chain, err := util.ThemeChain(uint(sID), theme)
+	if err == nil {
+		_, err = util.CompileThemes(chain)
+	}
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.GetMessage(err.Error(), http.StatusUnprocessableEntity)))
+		return
+	}
+
+	err = util.SaveTheme(uint(sID), theme.Name, data)
+	if err != nil {
+		loggerx.Error(err)
+		errorx.Render(w, errorx.Parser(errorx.InternalServerError()))
+		return
+	}
+
+	renderx.JSON(w, http.StatusCreated, newThemeData(theme, true))
+}
@@ -0,0 +1,164 @@
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strconv"
+
+	"github.com/factly/dega-vito/config"
+	"github.com/factly/dega-vito/model"
+	"github.com/factly/dega-vito/util/editorjs"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"gorm.io/gorm"
+)
+
+var colour = regexp.MustCompile(`^(#[0-9a-fA-F]{3,8}|[a-zA-Z]{1,20})$`)
+
+// spaceBlocks renders the EditorJS documents of a space, with the block
+// templates of its theme and the claims and ratings of the space
+type spaceBlocks struct {
+	sID      uint
+	renderer *editorjs.Renderer
+}
+
+func newSpaceBlocks(sID uint, t *template.Template) *spaceBlocks {
+	b := &spaceBlocks{
+		sID:      sID,
+		renderer: editorjs.NewRenderer(t),
+	}
+	b.renderer.Register("claim", b.claimBlock)
+	b.renderer.Register("rating", b.ratingBlock)
+	return b
+}
+
+func (b *spaceBlocks) funcs() template.FuncMap {
+	return template.FuncMap{
+		"editorjs": b.renderer.Render,
+		"claim":    b.claim,
+		"rating":   b.rating,
+	}
+}
+
+// claim returns the claim of the space with the id, nil when there is none
+func (b *spaceBlocks) claim(id interface{}) (*model.Claim, error) {
+	claim := &model.Claim{}
+	err := config.DB.Model(&model.Claim{}).Preload("Claimant").Preload("Rating").Preload("Rating.Medium").Where(&model.Claim{
+		SpaceID: b.sID,
+	}).First(claim, entityID(id)).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// rating returns the rating of the space with the id, nil when there is none
+func (b *spaceBlocks) rating(id interface{}) (*model.Rating, error) {
+	rating := &model.Rating{}
+	err := config.DB.Model(&model.Rating{}).Preload("Medium").Where(&model.Rating{
+		SpaceID: b.sID,
+	}).First(rating, entityID(id)).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// claimBlock renders the claim embedded in a post by its id
+func (b *spaceBlocks) claimBlock(block editorjs.Block) (template.HTML, error) {
+	claim, err := b.claim(block.Data["id"])
+	if err != nil || claim == nil {
+		return "", err
+	}
+
+	claimant := ""
+	if claim.Claimant.Name != "" {
+		claimant = fmt.Sprintf(`<p class="claimant">%s</p>`, template.HTMLEscapeString(claim.Claimant.Name))
+	}
+
+	fact := ""
+	if claim.Fact != "" {
+		fact = fmt.Sprintf(`<p class="claim-fact">%s</p>`, template.HTMLEscapeString(claim.Fact))
+	}
+
+	return template.HTML(fmt.Sprintf(`<div class="claim"><p class="claim-text">%s</p>%s%s%s</div>`,
+		template.HTMLEscapeString(claim.Claim), claimant, ratingBadge(claim.Rating), fact)), nil
+}
+
+// ratingBlock renders the badge of a rating by its id
+func (b *spaceBlocks) ratingBlock(block editorjs.Block) (template.HTML, error) {
+	rating, err := b.rating(block.Data["id"])
+	if err != nil || rating == nil {
+		return "", err
+	}
+	return ratingBadge(*rating), nil
+}
+
+func ratingBadge(rating model.Rating) template.HTML {
+	if rating.ID == 0 {
+		return ""
+	}
+
+	style := ""
+	if background := colourOf(rating.BackgroundColour); background != "" {
+		style += "background-color: " + background + ";"
+	}
+	if text := colourOf(rating.TextColour); text != "" {
+		style += "color: " + text + ";"
+	}
+	if style != "" {
+		style = fmt.Sprintf(` style="%s"`, style)
+	}
+
+	image := ""
+	if rating.Medium != nil {
+		url := make(map[string]interface{})
+		_ = json.Unmarshal(rating.Medium.URL.RawMessage, &url)
+		if src := editorjs.SafeURL(editorjs.String(url, "raw")); src != "" {
+			image = fmt.Sprintf(`<img src="%s" alt="%s">`, template.HTMLEscapeString(src), template.HTMLEscapeString(rating.Name))
+		}
+	}
+
+	return template.HTML(fmt.Sprintf(`<span class="rating-badge rating-%s"%s>%s%s</span>`,
+		template.HTMLEscapeString(rating.Slug), style, image, template.HTMLEscapeString(rating.Name)))
+}
+
+// colourOf returns the colour of a rating, stored either as the colour or as
+// an object with its hex code
+func colourOf(value postgres.Jsonb) string {
+	var result string
+	if err := json.Unmarshal(value.RawMessage, &result); err != nil {
+		hex := struct {
+			Hex string `json:"hex"`
+		}{}
+		_ = json.Unmarshal(value.RawMessage, &hex)
+		result = hex.Hex
+	}
+
+	if !colour.MatchString(result) {
+		return ""
+	}
+	return result
+}
+
+// entityID returns the id given as a number or a string in a block
+func entityID(value interface{}) uint {
+	switch id := value.(type) {
+	case float64:
+		return uint(id)
+	case string:
+		value, _ := strconv.ParseUint(id, 10, 64)
+		return uint(value)
+	}
+	return 0
+}
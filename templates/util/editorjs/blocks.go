@@ -0,0 +1,243 @@
+package editorjs
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	Register("paragraph", paragraph)
+	Register("header", header)
+	Register("list", list)
+	Register("quote", quote)
+	Register("code", code)
+	Register("delimiter", delimiter)
+	Register("raw", raw)
+	Register("table", table)
+	Register("image", image)
+	Register("uppy", uppy)
+	Register("embed", embed)
+	Register("checklist", checklist)
+	Register("warning", warning)
+	Register("linkTool", linkTool)
+}
+
+var className = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func paragraph(block Block) (template.HTML, error) {
+	return markup("<p>%s</p>", Inline(String(block.Data, "text"))), nil
+}
+
+func header(block Block) (template.HTML, error) {
+	level := int(Number(block.Data, "level"))
+	if level < 1 || level > 6 {
+		level = 2
+	}
+	return markup("<h%d>%s</h%d>", level, Inline(String(block.Data, "text")), level), nil
+}
+
+func list(block Block) (template.HTML, error) {
+	items, _ := block.Data["items"].([]interface{})
+	return listItems(items, String(block.Data, "style") == "ordered"), nil
+}
+
+// listItems renders the items of flat lists, given as text, and of nested
+// lists, given as objects with their content and their items
+func listItems(items []interface{}, ordered bool) template.HTML {
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	var result strings.Builder
+	result.WriteString("<" + tag + ">")
+	for _, item := range items {
+		switch value := item.(type) {
+		case string:
+			result.WriteString(string(markup("<li>%s</li>", Inline(value))))
+		case map[string]interface{}:
+			nested, _ := value["items"].([]interface{})
+			content := Inline(String(value, "content"))
+			if len(nested) > 0 {
+				content += listItems(nested, ordered)
+			}
+			result.WriteString(string(markup("<li>%s</li>", content)))
+		}
+	}
+	result.WriteString("</" + tag + ">")
+
+	return template.HTML(result.String())
+}
+
+func quote(block Block) (template.HTML, error) {
+	caption := ""
+	if text := String(block.Data, "caption"); text != "" {
+		caption = string(markup("<cite>%s</cite>", Inline(text)))
+	}
+	return markup("<blockquote><p>%s</p>%s</blockquote>", Inline(String(block.Data, "text")), caption), nil
+}
+
+func code(block Block) (template.HTML, error) {
+	return markup("<pre><code>%s</code></pre>", template.HTMLEscapeString(String(block.Data, "code"))), nil
+}
+
+func delimiter(block Block) (template.HTML, error) {
+	return "<hr>", nil
+}
+
+func raw(block Block) (template.HTML, error) {
+	return Sanitize(String(block.Data, "html")), nil
+}
+
+func table(block Block) (template.HTML, error) {
+	rows, _ := block.Data["content"].([]interface{})
+
+	// the first row is a heading unless the table says otherwise
+	headings := true
+	if value, found := block.Data["withHeadings"].(bool); found {
+		headings = value
+	}
+
+	var result strings.Builder
+	result.WriteString("<table>")
+	for i, row := range rows {
+		cells, _ := row.([]interface{})
+		cell := "td"
+		if i == 0 && headings {
+			cell = "th"
+		}
+
+		result.WriteString("<tr>")
+		for _, each := range cells {
+			text, _ := each.(string)
+			result.WriteString(string(markup("<%s>%s</%s>", cell, Inline(text), cell)))
+		}
+		result.WriteString("</tr>")
+	}
+	result.WriteString("</table>")
+
+	return template.HTML(result.String()), nil
+}
+
+func image(block Block) (template.HTML, error) {
+	classes := []string{"image"}
+	for _, option := range []string{"withBorder", "withBackground", "stretched"} {
+		if Bool(block.Data, option) {
+			classes = append(classes, "image-"+strings.ToLower(option))
+		}
+	}
+
+	caption := String(block.Data, "caption")
+	return figure(strings.Join(classes, " "), String(Map(block.Data, "file"), "url"), caption, caption), nil
+}
+
+// uppy - the images uploaded through Dega
+func uppy(block Block) (template.HTML, error) {
+	url := Map(block.Data, "url")
+	src := String(url, "proxy")
+	if src == "" {
+		src = String(url, "raw")
+	}
+	return figure("image", src, String(block.Data, "alt_text"), String(block.Data, "caption")), nil
+}
+
+func figure(class string, src string, alt string, caption string) template.HTML {
+	src = SafeURL(src)
+	if src == "" {
+		return ""
+	}
+
+	figcaption := ""
+	if caption != "" {
+		figcaption = string(markup("<figcaption>%s</figcaption>", Inline(caption)))
+	}
+
+	return markup(`<figure class="%s"><img src="%s" alt="%s">%s</figure>`,
+		class, template.HTMLEscapeString(src), plain(alt), figcaption)
+}
+
+// embed renders the embedded page in a frame, the HTML of the services is
+// left out as it cannot be sanitized
+func embed(block Block) (template.HTML, error) {
+	src := SafeURL(String(block.Data, "embed"))
+	if src == "" {
+		return "", nil
+	}
+
+	class := "embed"
+	if service := String(block.Data, "service"); className.MatchString(service) {
+		class += " embed-" + service
+	}
+
+	size := ""
+	if width := int(Number(block.Data, "width")); width > 0 {
+		size += fmt.Sprintf(` width="%d"`, width)
+	}
+	if height := int(Number(block.Data, "height")); height > 0 {
+		size += fmt.Sprintf(` height="%d"`, height)
+	}
+
+	figcaption := ""
+	if caption := String(block.Data, "caption"); caption != "" {
+		figcaption = string(markup("<figcaption>%s</figcaption>", Inline(caption)))
+	}
+
+	return markup(`<figure class="%s"><iframe src="%s"%s frameborder="0" allowfullscreen></iframe>%s</figure>`,
+		class, template.HTMLEscapeString(src), size, figcaption), nil
+}
+
+func checklist(block Block) (template.HTML, error) {
+	items, _ := block.Data["items"].([]interface{})
+
+	var result strings.Builder
+	result.WriteString(`<ul class="checklist">`)
+	for _, each := range items {
+		item, _ := each.(map[string]interface{})
+		class := ""
+		if Bool(item, "checked") {
+			class = ` class="checked"`
+		}
+		result.WriteString(string(markup("<li%s>%s</li>", class, Inline(String(item, "text")))))
+	}
+	result.WriteString("</ul>")
+
+	return template.HTML(result.String()), nil
+}
+
+func warning(block Block) (template.HTML, error) {
+	return markup(`<div class="warning"><strong>%s</strong><p>%s</p></div>`,
+		Inline(String(block.Data, "title")), Inline(String(block.Data, "message"))), nil
+}
+
+func linkTool(block Block) (template.HTML, error) {
+	link := SafeURL(String(block.Data, "link"))
+	if link == "" {
+		return "", nil
+	}
+
+	meta := Map(block.Data, "meta")
+	title := String(meta, "title")
+	if title == "" {
+		title = link
+	}
+
+	image := ""
+	if src := SafeURL(String(Map(meta, "image"), "url")); src != "" {
+		image = string(markup(`<img src="%s" alt="">`, template.HTMLEscapeString(src)))
+	}
+
+	return markup(`<a class="link" href="%s" target="_blank" rel="noopener noreferrer">%s<strong>%s</strong><p>%s</p></a>`,
+		template.HTMLEscapeString(link), image, template.HTMLEscapeString(title), template.HTMLEscapeString(String(meta, "description"))), nil
+}
+
+// markup formats the already escaped values into HTML
+func markup(format string, values ...interface{}) template.HTML {
+	return template.HTML(fmt.Sprintf(format, values...))
+}
+
+// plain returns the escaped text of the HTML, for attributes
+func plain(text string) string {
+	return string(sanitize(text, policy{}))
+}
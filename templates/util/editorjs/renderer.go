@@ -0,0 +1,213 @@
+package editorjs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// TemplatePrefix prefixes the names of the templates rendering the blocks of
+// a type, a theme defining block/header renders the headers itself
+const TemplatePrefix = "block/"
+
+// Block is a block of an EditorJS document
+type Block struct {
+	ID   string                 `json:"id"`
+	Type string                 `json:"type"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// RenderFunc renders a block into HTML, sanitizing whatever it takes from the
+// block
+type RenderFunc func(block Block) (template.HTML, error)
+
+// renderers of the block types rendered by every renderer
+var renderers = make(map[string]RenderFunc)
+
+// Register registers the renderer of a block type for every renderer, it is
+// meant to be called from init
+func Register(blockType string, render RenderFunc) {
+	renderers[blockType] = render
+}
+
+// Renderer renders EditorJS documents. A block is rendered by the template of
+// its type when there is one and by the renderer registered for its type
+// otherwise, the blocks of the other types are left out.
+type Renderer struct {
+	renderers map[string]RenderFunc
+	templates *template.Template
+}
+
+// NewRenderer returns a renderer with the registered renderers and the block
+// templates among the given templates, which can be nil
+func NewRenderer(templates *template.Template) *Renderer {
+	r := &Renderer{
+		renderers: make(map[string]RenderFunc),
+		templates: templates,
+	}
+	for blockType, render := range renderers {
+		r.renderers[blockType] = render
+	}
+	return r
+}
+
+// Register registers the renderer of a block type for this renderer only
+func (r *Renderer) Register(blockType string, render RenderFunc) {
+	r.renderers[blockType] = render
+}
+
+// Render renders a document, given as JSON, as the JSON decoded into a map or
+// as the list of its blocks
+func (r *Renderer) Render(document interface{}) (template.HTML, error) {
+	blocks, err := Blocks(document)
+	if err != nil {
+		return "", err
+	}
+
+	var result strings.Builder
+	for i, block := range blocks {
+		html, err := r.RenderBlock(block)
+		if err != nil {
+			return "", fmt.Errorf("block #%d: %v", i, err)
+		}
+		if html != "" {
+			result.WriteString(string(html))
+			result.WriteString("\n")
+		}
+	}
+
+	return template.HTML(result.String()), nil
+}
+
+// RenderBlock renders a single block
+func (r *Renderer) RenderBlock(block Block) (template.HTML, error) {
+	if r.templates != nil && r.templates.Lookup(TemplatePrefix+block.Type) != nil {
+		var result bytes.Buffer
+		err := r.templates.ExecuteTemplate(&result, TemplatePrefix+block.Type, block)
+		if err != nil {
+			return "", err
+		}
+		return template.HTML(result.String()), nil
+	}
+
+	render, found := r.renderers[block.Type]
+	if !found {
+		return "", nil
+	}
+	return render(block)
+}
+
+// Blocks returns the blocks of a document, given as JSON, as the JSON decoded
+// into a map or as the list of its blocks
+func Blocks(document interface{}) ([]Block, error) {
+	switch value := document.(type) {
+	case nil:
+		return nil, nil
+	case postgres.Jsonb:
+		return blocksOfJSON(value.RawMessage)
+	case *postgres.Jsonb:
+		if value == nil {
+			return nil, nil
+		}
+		return blocksOfJSON(value.RawMessage)
+	case json.RawMessage:
+		return blocksOfJSON(value)
+	case []byte:
+		return blocksOfJSON(value)
+	case string:
+		return blocksOfJSON([]byte(value))
+	case map[string]interface{}:
+		return Blocks(value["blocks"])
+	case []interface{}:
+		blocks := make([]Block, 0)
+		for i, each := range value {
+			block, ok := each.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("type error for block #%d", i)
+			}
+			blocks = append(blocks, newBlock(block))
+		}
+		return blocks, nil
+	case []map[string]interface{}:
+		blocks := make([]Block, 0)
+		for _, each := range value {
+			blocks = append(blocks, newBlock(each))
+		}
+		return blocks, nil
+	case []Block:
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("cannot render %T as EditorJS blocks", document)
+}
+
+func blocksOfJSON(data []byte) ([]Block, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var document interface{}
+	err := json.Unmarshal(data, &document)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := document.(map[string]interface{}); !ok {
+		if _, ok := document.([]interface{}); !ok {
+			return nil, errors.New("EditorJS document must be an object or a list of blocks")
+		}
+	}
+
+	return Blocks(document)
+}
+
+func newBlock(block map[string]interface{}) Block {
+	data, _ := block["data"].(map[string]interface{})
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	return Block{
+		ID:   String(block, "id"),
+		Type: String(block, "type"),
+		Data: data,
+	}
+}
+
+// String returns the string value of the key, or an empty string
+func String(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
+
+// Number returns the number value of the key, or zero. Numbers given as
+// strings are parsed.
+func Number(data map[string]interface{}, key string) float64 {
+	switch value := data[key].(type) {
+	case float64:
+		return value
+	case string:
+		var number float64
+		_, _ = fmt.Sscan(value, &number)
+		return number
+	}
+	return 0
+}
+
+// Bool returns the boolean value of the key, or false
+func Bool(data map[string]interface{}, key string) bool {
+	value, _ := data[key].(bool)
+	return value
+}
+
+// Map returns the object value of the key, or an empty map
+func Map(data map[string]interface{}, key string) map[string]interface{} {
+	value, _ := data[key].(map[string]interface{})
+	if value == nil {
+		return make(map[string]interface{})
+	}
+	return value
+}
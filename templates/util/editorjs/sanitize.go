@@ -0,0 +1,229 @@
+package editorjs
+
+import (
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// policy - the tags kept by the sanitizer and their attributes, the class
+// attribute is kept on all of them
+type policy map[string][]string
+
+var inlinePolicy = policy{
+	"a":      {"href", "title", "target"},
+	"b":      nil,
+	"br":     nil,
+	"code":   nil,
+	"del":    nil,
+	"em":     nil,
+	"i":      nil,
+	"mark":   nil,
+	"s":      nil,
+	"span":   nil,
+	"strong": nil,
+	"sub":    nil,
+	"sup":    nil,
+	"u":      nil,
+}
+
+var blockPolicy = withInline(policy{
+	"blockquote": nil,
+	"cite":       nil,
+	"div":        nil,
+	"figcaption": nil,
+	"figure":     nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"img":        {"src", "alt", "title", "width", "height"},
+	"li":         nil,
+	"ol":         nil,
+	"p":          nil,
+	"pre":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"colspan", "rowspan"},
+	"th":         {"colspan", "rowspan"},
+	"thead":      nil,
+	"tr":         nil,
+	"ul":         nil,
+})
+
+// the elements dropped along with their content
+var dropped = map[string]bool{
+	"embed":    true,
+	"head":     true,
+	"iframe":   true,
+	"math":     true,
+	"noscript": true,
+	"object":   true,
+	"script":   true,
+	"select":   true,
+	"style":    true,
+	"svg":      true,
+	"template": true,
+	"textarea": true,
+	"title":    true,
+}
+
+var void = map[string]bool{
+	"br":  true,
+	"hr":  true,
+	"img": true,
+}
+
+var (
+	comment   = regexp.MustCompile(`(?s)<!--.*?(-->|$)`)
+	tag       = regexp.MustCompile("<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:\\s+[^\\s\"'>/=]+(?:\\s*=\\s*(?:\"[^\"]*\"|'[^']*'|[^\\s\"'=<>`]+))?)*)\\s*/?>")
+	attribute = regexp.MustCompile("([^\\s\"'>/=]+)(?:\\s*=\\s*(?:\"([^\"]*)\"|'([^']*)'|([^\\s\"'=<>`]+)))?")
+)
+
+func withInline(tags policy) policy {
+	for name, attributes := range inlinePolicy {
+		tags[name] = attributes
+	}
+	return tags
+}
+
+// Inline sanitizes the text of a block, keeping only the formatting of the
+// inline tools such as bold, italic, links and inline code
+func Inline(text string) template.HTML {
+	return sanitize(text, inlinePolicy)
+}
+
+// Sanitize sanitizes the HTML of raw blocks, keeping only the tags for the
+// structure of the content and dropping scripts, styles, frames and the
+// event handler attributes
+func Sanitize(text string) template.HTML {
+	return sanitize(text, blockPolicy)
+}
+
+func sanitize(text string, allowed policy) template.HTML {
+	text = comment.ReplaceAllString(text, "")
+
+	var result strings.Builder
+	for text != "" {
+		match := tag.FindStringSubmatchIndex(text)
+		if match == nil {
+			result.WriteString(escape(text))
+			break
+		}
+		result.WriteString(escape(text[:match[0]]))
+
+		closing := match[3] > match[2]
+		name := strings.ToLower(text[match[4]:match[5]])
+		rest := text[match[1]:]
+
+		if dropped[name] && !closing {
+			text = rest[elementEnd(rest, name):]
+			continue
+		}
+
+		if attributes, found := allowed[name]; found {
+			if !closing {
+				result.WriteString(openingTag(name, text[match[6]:match[7]], attributes))
+			} else if !void[name] {
+				result.WriteString("</" + name + ">")
+			}
+		}
+		text = rest
+	}
+
+	return template.HTML(result.String())
+}
+
+// escape escapes the text between the tags, the entities already in it are
+// kept as they are
+func escape(text string) string {
+	return template.HTMLEscapeString(html.UnescapeString(text))
+}
+
+// elementEnd returns the index right after the closing tag of the element,
+// or the end of the text when it is not closed
+func elementEnd(text string, name string) int {
+	start := strings.Index(strings.ToLower(text), "</"+name)
+	if start < 0 {
+		return len(text)
+	}
+	end := strings.Index(text[start:], ">")
+	if end < 0 {
+		return len(text)
+	}
+	return start + end + 1
+}
+
+func openingTag(name string, attributes string, allowed []string) string {
+	var result strings.Builder
+	result.WriteString("<" + name)
+
+	blank := false
+	for _, match := range attribute.FindAllStringSubmatch(attributes, -1) {
+		key := strings.ToLower(match[1])
+		if key != "class" && !contains(allowed, key) {
+			continue
+		}
+
+		value := html.UnescapeString(match[2] + match[3] + match[4])
+		switch key {
+		case "href", "src":
+			value = SafeURL(value)
+			if value == "" {
+				continue
+			}
+		case "target":
+			if value != "_blank" {
+				continue
+			}
+			blank = true
+		}
+
+		result.WriteString(" " + key + `="` + template.HTMLEscapeString(value) + `"`)
+	}
+
+	if blank {
+		result.WriteString(` rel="noopener noreferrer"`)
+	}
+	result.WriteString(">")
+
+	return result.String()
+}
+
+func contains(values []string, value string) bool {
+	for _, each := range values {
+		if each == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SafeURL returns the URL when it is relative or its scheme is http, https,
+// mailto or tel, and an empty string otherwise
+func SafeURL(url string) string {
+	url = strings.TrimSpace(url)
+
+	// browsers ignore the whitespace and the control characters in schemes
+	scheme := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, url)
+
+	end := strings.IndexAny(scheme, ":/?#")
+	if end < 0 || scheme[end] != ':' {
+		return url
+	}
+
+	switch strings.ToLower(scheme[:end]) {
+	case "http", "https", "mailto", "tel":
+		return url
+	}
+	return ""
+}
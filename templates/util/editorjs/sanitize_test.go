@@ -0,0 +1,119 @@
+package editorjs
+
+import (
+	"html/template"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want template.HTML
+	}{
+		{name: "text", text: "fact & check", want: "fact &amp; check"},
+		{name: "entities are kept", text: "fact &amp; check &lt;b&gt;", want: "fact &amp; check &lt;b&gt;"},
+		{name: "structure", text: `<p class="lead">a</p><ul><li>b</li></ul><hr/>`, want: `<p class="lead">a</p><ul><li>b</li></ul><hr>`},
+		{name: "uppercase tags", text: `<P>a</P>`, want: `<p>a</p>`},
+		{name: "unknown tags", text: `<form action="/"><input name="a">a</form>`, want: `a`},
+		{name: "comments", text: `a<!-- <script>alert(1)</script> -->b`, want: `ab`},
+		{name: "script", text: `a<script>alert(1)</script>b`, want: `ab`},
+		{name: "uppercase script", text: `a<SCRIPT src="/x.js"></ScRiPt>b`, want: `ab`},
+		{name: "script which is not closed", text: `a<script>alert(1)`, want: `a`},
+		{name: "script in script", text: `<scr<script>ipt>alert(1)</script>`, want: `&lt;scr`},
+		{name: "iframe", text: `a<iframe src="https://example.com"></iframe>b`, want: `ab`},
+		{name: "style", text: `<style>p { color: red }</style>a`, want: `a`},
+		{name: "svg", text: `<svg onload="alert(1)"><script>alert(1)</script></svg>a`, want: `a`},
+		{name: "event handlers", text: `<p onclick="alert(1)" ONMOUSEOVER='alert(1)'>a</p>`, want: `<p>a</p>`},
+		{name: "event handler of image", text: `<img src=x onerror=alert(1)>`, want: `<img src="x">`},
+		{name: "attributes which are not allowed", text: `<p style="color: red" id="a">a</p>`, want: `<p>a</p>`},
+		{name: "quotes in attributes", text: `<img alt='"><script>alert(1)</script>' src="/a.png">`, want: `<img alt="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;" src="/a.png">`},
+		{name: "image", text: `<img src="https://example.com/a.png" alt="a" width="10">`, want: `<img src="https://example.com/a.png" alt="a" width="10">`},
+		{name: "javascript image", text: `<img src="javascript:alert(1)" alt="a">`, want: `<img alt="a">`},
+		{name: "data image", text: `<img src="data:image/svg+xml;base64,PHN2Zz4=">`, want: `<img>`},
+		{name: "javascript link", text: `<a href="javascript:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "data link", text: `<a href="data:text/html,<script>alert(1)</script>">a</a>`, want: `<a>a</a>`},
+		{name: "mixed case scheme", text: `<a href="JaVaScRiPt:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "entity encoded scheme", text: `<a href="&#106;avascript:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "hex entity encoded scheme", text: `<a href="&#x6A;&#x61;vascript:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "encoded tab in scheme", text: `<a href="java&#x09;script:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "encoded colon", text: `<a href="javascript&colon;alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "leading whitespace", text: `<a href=" &#x20;javascript:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "unquoted scheme", text: `<a href=javascript:alert(1)>a</a>`, want: `<a>a</a>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Sanitize(test.text); got != test.want {
+				t.Errorf("Sanitize(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestInline(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want template.HTML
+	}{
+		{name: "formatting", text: `<b>a</b> <i>b</i> <code class="inline-code">c</code>`, want: `<b>a</b> <i>b</i> <code class="inline-code">c</code>`},
+		{name: "link", text: `<a href="https://example.com/?a=1&amp;b=2" title="a">a</a>`, want: `<a href="https://example.com/?a=1&amp;b=2" title="a">a</a>`},
+		{name: "relative link", text: `<a href="/posts/a">a</a>`, want: `<a href="/posts/a">a</a>`},
+		{name: "mailto link", text: `<a href="mailto:a@example.com">a</a>`, want: `<a href="mailto:a@example.com">a</a>`},
+		{name: "link in new tab", text: `<a href="/a" target="_blank">a</a>`, want: `<a href="/a" target="_blank" rel="noopener noreferrer">a</a>`},
+		{name: "link with rel", text: `<a href="/a" target="_blank" rel="opener">a</a>`, want: `<a href="/a" target="_blank" rel="noopener noreferrer">a</a>`},
+		{name: "link in other frame", text: `<a href="/a" target="main">a</a>`, want: `<a href="/a">a</a>`},
+		{name: "block tags", text: `<p>a</p><h1>b</h1>`, want: `ab`},
+		{name: "image", text: `a<img src=x onerror=alert(1)>`, want: `a`},
+		{name: "event handler", text: `<b onmouseover="alert(1)">a</b>`, want: `<b>a</b>`},
+		{name: "script", text: `<b>a</b><script>alert(1)</script>`, want: `<b>a</b>`},
+		{name: "iframe", text: `a<iframe src="javascript:alert(1)"></iframe>`, want: `a`},
+		{name: "javascript link", text: `<a href="jAvAsCrIpT:alert(1)">a</a>`, want: `<a>a</a>`},
+		{name: "entity encoded scheme", text: `<a href="&#0000106&#0000097&#0000118&#0000097&#0000115&#0000099&#0000114&#0000105&#0000112&#0000116&#0000058alert(1)">a</a>`, want: `<a>a</a>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Inline(test.text); got != test.want {
+				t.Errorf("Inline(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSafeURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://example.com/a.png", want: "https://example.com/a.png"},
+		{url: "HTTP://example.com", want: "HTTP://example.com"},
+		{url: " http://example.com ", want: "http://example.com"},
+		{url: "mailto:a@example.com", want: "mailto:a@example.com"},
+		{url: "tel:+911234567890", want: "tel:+911234567890"},
+		{url: "/posts/a", want: "/posts/a"},
+		{url: "//example.com/a.png", want: "//example.com/a.png"},
+		{url: "posts/a?b=c:d", want: "posts/a?b=c:d"},
+		{url: "#a", want: "#a"},
+		{url: "", want: ""},
+		{url: "javascript:alert(1)", want: ""},
+		{url: "JavaScript:alert(1)", want: ""},
+		{url: " javascript:alert(1)", want: ""},
+		{url: "java\tscript:alert(1)", want: ""},
+		{url: "java\nscript:alert(1)", want: ""},
+		{url: "\x00javascript:alert(1)", want: ""},
+		{url: "vbscript:msgbox(1)", want: ""},
+		{url: "data:text/html;base64,PHNjcmlwdD4=", want: ""},
+		{url: "DATA:image/png;base64,iVBORw0K", want: ""},
+		{url: "file:///etc/passwd", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			if got := SafeURL(test.url); got != test.want {
+				t.Errorf("SafeURL(%q) = %q, want %q", test.url, got, test.want)
+			}
+		})
+	}
+}
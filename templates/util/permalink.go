@@ -0,0 +1,164 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/factly/dega-vito/config"
+	"github.com/factly/dega-vito/model"
+	"github.com/factly/x/loggerx"
+)
+
+// permalinks of the entities of a space, as set on the space in the server
+type permalinks struct {
+	Post     string `json:"post"`
+	Tag      string `json:"tag"`
+	Category string `json:"category"`
+	Author   string `json:"author"`
+}
+
+// the patterns of the routes of the templates
+var defaultPermalinks = permalinks{
+	Post:     "/{slug}",
+	Tag:      "/tag/{slug}",
+	Category: "/category/{slug}",
+	Author:   "/author/{slug}",
+}
+
+var placeholder = regexp.MustCompile(`{([^{}/]*)}`)
+
+// Permalink builds the paths of the entities of a space, relative to its
+// site address
+type Permalink struct {
+	locale   string
+	patterns permalinks
+}
+
+// NewPermalink returns the path builder of the space
+func NewPermalink(space model.Space) Permalink {
+	patterns := permalinks{}
+	_ = json.Unmarshal(space.Permalinks.RawMessage, &patterns)
+	withDefault(&patterns.Post, defaultPermalinks.Post)
+	withDefault(&patterns.Tag, defaultPermalinks.Tag)
+	withDefault(&patterns.Category, defaultPermalinks.Category)
+	withDefault(&patterns.Author, defaultPermalinks.Author)
+
+	return Permalink{
+		locale:   space.DefaultLocale,
+		patterns: patterns,
+	}
+}
+
+func withDefault(pattern *string, value string) {
+	if *pattern == "" {
+		*pattern = value
+	}
+}
+
+// Post returns the path of a post
+func (p Permalink) Post(post model.Post) string {
+	format := ""
+	if post.Format != nil {
+		format = post.Format.Slug
+	}
+
+	return p.build(p.patterns.Post, values(post.ID, post.Slug, p.localeOf(post.Locale)), dated(post.PublishedDate), map[string]string{
+		"format": format,
+	})
+}
+
+// Tag returns the path of a tag
+func (p Permalink) Tag(tag model.Tag) string {
+	return p.build(p.patterns.Tag, values(tag.ID, tag.Slug, p.localeOf(tag.Locale)))
+}
+
+// Category returns the path of a category
+func (p Permalink) Category(category model.Category) string {
+	return p.build(p.patterns.Category, values(category.ID, category.Slug, p.localeOf(category.Locale)))
+}
+
+// Author returns the path of an author
+func (p Permalink) Author(author model.Author) string {
+	return p.build(p.patterns.Author, values(author.ID, author.Slug, ""))
+}
+
+func (p Permalink) localeOf(locale string) string {
+	if locale == "" {
+		return p.locale
+	}
+	return locale
+}
+
+// build fills the placeholders of the pattern in, the path segments left
+// empty are dropped
+func (p Permalink) build(pattern string, fields ...map[string]string) string {
+	path := placeholder.ReplaceAllStringFunc(pattern, func(match string) string {
+		name := match[1 : len(match)-1]
+		for _, each := range fields {
+			if value, found := each[name]; found {
+				return value
+			}
+		}
+		return ""
+	})
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func (p Permalink) funcs() template.FuncMap {
+	return template.FuncMap{
+		"postURL":     p.Post,
+		"tagURL":      p.Tag,
+		"categoryURL": p.Category,
+		"authorURL":   p.Author,
+	}
+}
+
+func values(id uint, slug, locale string) map[string]string {
+	return map[string]string{
+		"id":     fmt.Sprint(id),
+		"slug":   slug,
+		"locale": locale,
+	}
+}
+
+func dated(date time.Time) map[string]string {
+	return map[string]string{
+		"yyyy": date.Format("2006"),
+		"mm":   date.Format("01"),
+		"dd":   date.Format("02"),
+	}
+}
+
+// ExecuteTemplate renders the template of the theme of the space with the
+// permalinks of the space, the EditorJS blocks are rendered by the block
+// templates of the theme
+func ExecuteTemplate(w io.Writer, sID uint, name string, data interface{}) error {
+	space := model.Space{}
+	config.DB.Model(&model.Space{}).Where("id = ?", sID).First(&space)
+
+	spaceTemplate, err := SpaceTemplate(sID, space.Theme)
+	if err != nil {
+		loggerx.Error(err)
+		spaceTemplate = Template
+	}
+
+	t, err := spaceTemplate.Clone()
+	if err != nil {
+		return err
+	}
+
+	return t.Funcs(NewPermalink(space).funcs()).Funcs(newSpaceBlocks(sID, t).funcs()).ExecuteTemplate(w, name, data)
+}
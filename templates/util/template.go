@@ -6,17 +6,21 @@ import (
 	"html/template"
 	"time"
 
+	"github.com/factly/dega-vito/model"
 	"github.com/factly/dega-vito/util/editorjs"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/spf13/viper"
 )
 
-// Template template object
+// Template template object, the default theme the spaces fall back to
 var Template *template.Template
 
+// the functions available to the templates of every theme
+var templateFuncs template.FuncMap
+
 // SetupTemplates setups the templates
 func SetupTemplates() {
-	Template = template.Must(template.New("").Funcs(template.FuncMap{
+	funcs := template.FuncMap{
 		"unmar":     unmarshal,
 		"bmap":      editorjs.BlockMap,
 		"dateFmt":   formatDate,
@@ -24,7 +28,18 @@ func SetupTemplates() {
 		"noesc":     noescape,
 		"publicURL": publicURL,
 		"sub": sub,
-	}).ParseGlob("web/themes/default/*"))
+		"inline":    editorjs.Inline,
+		"sanitize":  editorjs.Sanitize,
+	}
+	// the permalinks and the blocks of the space are swapped in on rendering
+	for name, fn := range NewPermalink(model.Space{}).funcs() {
+		funcs[name] = fn
+	}
+	for name, fn := range newSpaceBlocks(0, nil).funcs() {
+		funcs[name] = fn
+	}
+	templateFuncs = funcs
+	Template = template.Must(SpaceTemplate(0, DefaultTheme))
 }
 
 func unmarshal(data postgres.Jsonb) map[string]interface{} {
@@ -0,0 +1,497 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultTheme is the theme bundled with the templates, every theme falls
+// back to it for the templates it does not have
+const DefaultTheme = "default"
+
+// ManifestName is the file describing a theme
+const ManifestName = "theme.json"
+
+// TemplateNames are the templates rendered by the routes, the only ones a
+// theme can have
+var TemplateNames = []string{
+	"author.gohtml",
+	"authorlist.gohtml",
+	"blocks.gohtml",
+	"categorylist.gohtml",
+	"description.gohtml",
+	"homepage.gohtml",
+	"navbar.gohtml",
+	"post.gohtml",
+	"postlist.gohtml",
+	"taglist.gohtml",
+}
+
+// maxThemeFileSize is the largest file read from the zip archive of a theme
+const maxThemeFileSize = 1 << 20
+
+// at most this many parents are followed from a theme before the default one
+const maxParents = 5
+
+var themeName = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Manifest describes a theme
+type Manifest struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+	Parent      string `json:"parent,omitempty"`
+}
+
+// Theme is a theme as read from its directory or zip archive
+type Theme struct {
+	Manifest
+	Templates map[string]string `json:"-"`
+	path      string
+}
+
+// TemplateList returns the names of the templates of the theme
+func (t *Theme) TemplateList() []string {
+	names := make([]string, 0)
+	for name := range t.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UploadedBy checks if the theme was uploaded by the space
+func (t *Theme) UploadedBy(sID uint) bool {
+	return strings.HasPrefix(t.path, SpaceThemesPath(sID)+string(filepath.Separator))
+}
+
+type compiledTheme struct {
+	template *template.Template
+	files    []string
+	stamp    string
+}
+
+var themes = struct {
+	sync.RWMutex
+	compiled map[string]*compiledTheme
+}{compiled: make(map[string]*compiledTheme)}
+
+// ThemesPath returns the directory of the themes shared by all the spaces
+func ThemesPath() string {
+	if viper.IsSet("themes_path") {
+		return viper.GetString("themes_path")
+	}
+	return "web/themes"
+}
+
+// SpaceThemesPath returns the directory of the themes uploaded by a space
+func SpaceThemesPath(sID uint) string {
+	return filepath.Join(ThemesPath(), "spaces", fmt.Sprint(sID))
+}
+
+// themeLocations returns where a theme may be found for a space, in the order
+// they are looked in. The uploaded zip archives take precedence over the
+// directories.
+func themeLocations(sID uint, name string) []string {
+	locations := make([]string, 0)
+	if name != DefaultTheme {
+		locations = append(locations,
+			filepath.Join(SpaceThemesPath(sID), name+".zip"),
+			filepath.Join(SpaceThemesPath(sID), name),
+		)
+	}
+	return append(locations,
+		filepath.Join(ThemesPath(), name+".zip"),
+		filepath.Join(ThemesPath(), name),
+	)
+}
+
+// FindTheme returns the theme of the given name available to the space
+func FindTheme(sID uint, name string) (*Theme, error) {
+	if !themeName.MatchString(name) {
+		return nil, fmt.Errorf("invalid theme name %q", name)
+	}
+
+	for _, location := range themeLocations(sID, name) {
+		info, err := os.Stat(location)
+		if err != nil {
+			continue
+		}
+		if info.IsDir() {
+			return readThemeDir(location)
+		}
+		return readThemeArchive(location)
+	}
+
+	return nil, fmt.Errorf("theme %q not found", name)
+}
+
+func readThemeDir(dir string) (*Theme, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		return nil, err
+	}
+
+	theme, err := newTheme(data, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range TemplateNames {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		theme.Templates[name] = string(content)
+	}
+
+	return theme, nil
+}
+
+func readThemeArchive(file string) (*Theme, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	theme, err := ReadThemeArchive(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	theme.path = file
+
+	return theme, nil
+}
+
+// ReadThemeArchive reads a theme from a zip archive. The manifest and the
+// templates are either at the root of the archive or in a single folder, and
+// the templates must be among the TemplateNames.
+func ReadThemeArchive(data []byte) (*Theme, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest *zip.File
+	for _, file := range archive.File {
+		if !safeName(file.Name) {
+			return nil, fmt.Errorf("invalid file name %s", file.Name)
+		}
+		if path.Base(file.Name) == ManifestName && (manifest == nil || len(file.Name) < len(manifest.Name)) {
+			manifest = file
+		}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%s not found", ManifestName)
+	}
+
+	content, err := readZipFile(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	theme, err := newTheme(content, "")
+	if err != nil {
+		return nil, err
+	}
+
+	root := path.Dir(manifest.Name)
+	for _, file := range archive.File {
+		if path.Ext(file.Name) != ".gohtml" {
+			continue
+		}
+
+		name := path.Base(file.Name)
+		if path.Dir(file.Name) != root || !isTemplateName(name) {
+			return nil, fmt.Errorf("unknown template %s, the templates of a theme are %s", file.Name, strings.Join(TemplateNames, ", "))
+		}
+
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		theme.Templates[name] = string(content)
+	}
+
+	if len(theme.Templates) == 0 {
+		return nil, errors.New("the theme has no templates")
+	}
+
+	return theme, nil
+}
+
+// readZipFile reads a file of the archive, the sizes in its header are not
+// trusted and at most maxThemeFileSize bytes are decompressed
+func readZipFile(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > maxThemeFileSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", file.Name, maxThemeFileSize)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(io.LimitReader(reader, maxThemeFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxThemeFileSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", file.Name, maxThemeFileSize)
+	}
+	return content, nil
+}
+
+// safeName checks that the name of a file of an archive is relative and
+// stays inside the archive
+func safeName(name string) bool {
+	if name == "" || strings.Contains(name, `\`) || path.IsAbs(name) {
+		return false
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func newTheme(manifest []byte, location string) (*Theme, error) {
+	theme := &Theme{
+		Templates: make(map[string]string),
+		path:      location,
+	}
+
+	err := json.Unmarshal(manifest, &theme.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", ManifestName, err)
+	}
+
+	if !themeName.MatchString(theme.Name) {
+		return nil, fmt.Errorf("invalid theme name %q, it must be lowercase letters, digits and hyphens", theme.Name)
+	}
+	if theme.Version == "" {
+		return nil, fmt.Errorf("version of theme %q is missing", theme.Name)
+	}
+	if theme.Parent != "" && !themeName.MatchString(theme.Parent) {
+		return nil, fmt.Errorf("invalid parent theme name %q", theme.Parent)
+	}
+
+	return theme, nil
+}
+
+func isTemplateName(name string) bool {
+	for _, each := range TemplateNames {
+		if each == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ThemeChain returns the theme followed by its parents and the default
+// theme, in the order their templates are looked in
+func ThemeChain(sID uint, theme *Theme) ([]*Theme, error) {
+	chain := []*Theme{theme}
+
+	for theme.Name != DefaultTheme {
+		parent := theme.Parent
+		if parent == "" {
+			parent = DefaultTheme
+		}
+
+		for _, each := range chain {
+			if each.Name == parent {
+				return nil, fmt.Errorf("theme %q is its own parent", parent)
+			}
+		}
+		if len(chain) > maxParents {
+			return nil, fmt.Errorf("theme %q has more than %d parents", chain[0].Name, maxParents)
+		}
+
+		var err error
+		theme, err = FindTheme(sID, parent)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, theme)
+	}
+
+	return chain, nil
+}
+
+// CompileThemes parses each template from the first theme of the chain
+// having it
+func CompileThemes(chain []*Theme) (*template.Template, error) {
+	t := template.New("").Funcs(templateFuncs)
+
+	for _, name := range TemplateNames {
+		for _, theme := range chain {
+			content, found := theme.Templates[name]
+			if !found {
+				continue
+			}
+			if _, err := t.New(name).Parse(content); err != nil {
+				return nil, fmt.Errorf("theme %q: %v", theme.Name, err)
+			}
+			break
+		}
+	}
+
+	return t, nil
+}
+
+// SpaceTemplate returns the templates of the theme of the space. Themes are
+// compiled once and compiled again when any of their files changes.
+func SpaceTemplate(sID uint, name string) (*template.Template, error) {
+	if name == "" {
+		name = DefaultTheme
+	}
+	key := fmt.Sprint(sID, "/", name)
+
+	themes.RLock()
+	cached, found := themes.compiled[key]
+	themes.RUnlock()
+
+	if found && cached.stamp == stamp(cached.files) {
+		return cached.template, nil
+	}
+
+	theme, err := FindTheme(sID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := ThemeChain(sID, theme)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := CompileThemes(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	files := watchedFiles(sID, chain)
+	themes.Lock()
+	themes.compiled[key] = &compiledTheme{
+		template: t,
+		files:    files,
+		stamp:    stamp(files),
+	}
+	themes.Unlock()
+
+	return t, nil
+}
+
+// watchedFiles returns the files a change in which changes the compiled
+// chain, including the locations where a theme would take the place of
+// the one found
+func watchedFiles(sID uint, chain []*Theme) []string {
+	files := make([]string, 0)
+	for _, theme := range chain {
+		files = append(files, themeLocations(sID, theme.Name)...)
+
+		if info, err := os.Stat(theme.path); err == nil && info.IsDir() {
+			files = append(files, filepath.Join(theme.path, ManifestName))
+			for _, name := range TemplateNames {
+				files = append(files, filepath.Join(theme.path, name))
+			}
+		}
+	}
+	return files
+}
+
+func stamp(files []string) string {
+	var result strings.Builder
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			result.WriteString("-;")
+			continue
+		}
+		fmt.Fprint(&result, info.ModTime().UnixNano(), ":", info.Size(), ";")
+	}
+	return result.String()
+}
+
+// ListThemes returns the themes available to the space, the uploaded ones
+// in place of the shared ones of the same name
+func ListThemes(sID uint) ([]*Theme, error) {
+	result := make([]*Theme, 0)
+	found := make(map[string]bool)
+
+	for _, dir := range []string{SpaceThemesPath(sID), ThemesPath()} {
+		entries, err := ioutil.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), ".zip")
+			if found[name] || !themeName.MatchString(name) || (!entry.IsDir() && name == entry.Name()) {
+				continue
+			}
+
+			theme, err := FindTheme(sID, name)
+			if err != nil {
+				continue
+			}
+			found[name] = true
+			result = append(result, theme)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// SaveTheme stores the zip archive of a theme uploaded by the space, in
+// place of the uploaded theme of the same name
+func SaveTheme(sID uint, name string, data []byte) error {
+	dir := SpaceThemesPath(sID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filepath.Join(dir, name+".zip"))
+}
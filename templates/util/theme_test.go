@@ -0,0 +1,172 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+const testManifest = `{"name":"paper","version":"1.0.0"}`
+
+type zipEntry struct {
+	name    string
+	content string
+}
+
+func testArchive(t *testing.T, entries ...zipEntry) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		f, err := w.Create(entry.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// understatedArchive has a template whose header claims it is smaller than
+// it is once decompressed, which the zip reader stops reading at its size
+func understatedArchive(t *testing.T, size int) []byte {
+	content := bytes.Repeat([]byte("a"), size)
+
+	var compressed bytes.Buffer
+	fw, _ := flate.NewWriter(&compressed, flate.BestCompression)
+	_, _ = fw.Write(content)
+	_ = fw.Close()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create(ManifestName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte(testManifest))
+
+	raw, err := w.CreateRaw(&zip.FileHeader{
+		Name:               "post.gohtml",
+		Method:             zip.Deflate,
+		CRC32:              crc32.ChecksumIEEE(content),
+		CompressedSize64:   uint64(compressed.Len()),
+		UncompressedSize64: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = raw.Write(compressed.Bytes())
+
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadThemeArchive(t *testing.T) {
+	manifest := zipEntry{ManifestName, testManifest}
+	post := zipEntry{"post.gohtml", `{{ define "post" }}post{{ end }}`}
+
+	tests := []struct {
+		name  string
+		data  []byte
+		error string
+	}{
+		{name: "theme at the root", data: testArchive(t, manifest, post)},
+		{name: "theme in a folder", data: testArchive(t, zipEntry{"paper/" + ManifestName, testManifest}, zipEntry{"paper/post.gohtml", post.content})},
+		{name: "not a zip archive", data: []byte("theme"), error: "zip"},
+		{name: "without manifest", data: testArchive(t, post), error: "theme.json not found"},
+		{name: "without templates", data: testArchive(t, manifest), error: "no templates"},
+		{name: "unknown template", data: testArchive(t, manifest, zipEntry{"admin.gohtml", ""}), error: "unknown template admin.gohtml"},
+		{name: "template outside the theme folder", data: testArchive(t, manifest, zipEntry{"partials/post.gohtml", ""}), error: "unknown template"},
+		{name: "zip slip template", data: testArchive(t, manifest, zipEntry{"../post.gohtml", ""}), error: "invalid file name ../post.gohtml"},
+		{name: "zip slip theme", data: testArchive(t, zipEntry{"../../" + ManifestName, testManifest}, zipEntry{"../../post.gohtml", ""}), error: "invalid file name"},
+		{name: "absolute name", data: testArchive(t, manifest, post, zipEntry{"/etc/post.gohtml", ""}), error: "invalid file name /etc/post.gohtml"},
+		{name: "windows name", data: testArchive(t, manifest, post, zipEntry{`..\post.gohtml`, ""}), error: "invalid file name"},
+		{name: "oversized template", data: testArchive(t, manifest, zipEntry{"post.gohtml", strings.Repeat("a", maxThemeFileSize+1)}), error: "larger than"},
+		{name: "oversized template with understated size", data: understatedArchive(t, maxThemeFileSize+1), error: "not a valid zip file"},
+		{name: "invalid theme name", data: testArchive(t, zipEntry{ManifestName, `{"name":"Paper Theme","version":"1.0.0"}`}, post), error: "invalid theme name"},
+		{name: "theme name with a path", data: testArchive(t, zipEntry{ManifestName, `{"name":"../paper","version":"1.0.0"}`}, post), error: "invalid theme name"},
+		{name: "invalid parent name", data: testArchive(t, zipEntry{ManifestName, `{"name":"paper","version":"1.0.0","parent":"../default"}`}, post), error: "invalid parent theme name"},
+		{name: "without version", data: testArchive(t, zipEntry{ManifestName, `{"name":"paper"}`}, post), error: "version"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			theme, err := ReadThemeArchive(test.data)
+			if test.error == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if theme.Name != "paper" || len(theme.Templates) != 1 || theme.Templates["post.gohtml"] != post.content {
+					t.Fatalf("unexpected theme %+v", theme)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.error) {
+				t.Fatalf("expected error containing %q, got %v", test.error, err)
+			}
+		})
+	}
+}
+
+func TestFindTheme(t *testing.T) {
+	dir, err := ioutil.TempDir("", "themes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	viper.Set("themes_path", dir)
+	defer viper.Set("themes_path", nil)
+
+	if err = os.MkdirAll(filepath.Join(dir, "paper"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "paper", ManifestName), []byte(testManifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		theme string
+		error string
+	}{
+		{name: "shared theme", theme: "paper"},
+		{name: "missing theme", theme: "ink", error: "not found"},
+		{name: "empty name", theme: "", error: "invalid theme name"},
+		{name: "uppercase name", theme: "Paper", error: "invalid theme name"},
+		{name: "name with a path", theme: "../paper", error: "invalid theme name"},
+		{name: "name of another space", theme: "spaces/2/paper", error: "invalid theme name"},
+		{name: "name of an archive", theme: "paper.zip", error: "invalid theme name"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			theme, err := FindTheme(1, test.theme)
+			if test.error == "" {
+				if err != nil || theme.Name != test.theme {
+					t.Fatalf("expected theme %s, got %v %v", test.theme, theme, err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.error) {
+				t.Fatalf("expected error containing %q, got %v", test.error, err)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package util
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/factly/dega-vito/config"
 	"github.com/factly/dega-vito/model"
@@ -49,3 +51,47 @@ func AllAuthors(ctx context.Context, sID uint, uID uint) (map[string]model.Autho
 
 	return authors, nil
 }
+
+type organisationRole struct {
+	ID         uint `json:"id"`
+	Permission struct {
+		Role string `json:"role"`
+	} `json:"permission"`
+}
+
+// CheckOwner checks if the user is an owner of the organisation of the space
+func CheckOwner(sID uint, uID uint) (bool, error) {
+	space := &model.Space{}
+	space.ID = sID
+
+	err := config.DB.First(&space).Error
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := requestx.Request("GET", viper.GetString("kavach_url")+"/organisations/my", nil, map[string]string{
+		"X-User": fmt.Sprint(uID),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.New("error from kavach server")
+	}
+
+	organisations := []organisationRole{}
+	err = json.NewDecoder(resp.Body).Decode(&organisations)
+	if err != nil {
+		return false, err
+	}
+
+	for _, each := range organisations {
+		if each.ID == uint(space.OrganisationID) && each.Permission.Role == "owner" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}